@@ -14,6 +14,30 @@ func main() {
 		env = "development"
 	}
 
+	// 마이그레이션 서브커맨드: server migrate up | down [steps] | status
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := app.Migrate("config.yaml", os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	// 보관 기간 정리 1회 실행: server purge
+	if len(os.Args) > 1 && os.Args[1] == "purge" {
+		if err := app.Purge("config.yaml"); err != nil {
+			log.Fatalf("Purge failed: %v", err)
+		}
+		return
+	}
+
+	// 결제 웹훅 테스트용 서명 생성: server billing sign <payload.json>
+	if len(os.Args) > 1 && os.Args[1] == "billing" {
+		if err := app.Billing("config.yaml", os.Args[2:]); err != nil {
+			log.Fatalf("Billing command failed: %v", err)
+		}
+		return
+	}
+
 	// 애플리케이션 초기화 (Config 로드 -> DB 연결 -> 컴포넌트 조립)
 	// config.yaml 파일이 루트 혹은 실행 위치에 있어야 합니다.
 	application, err := app.New("config.yaml", env)
@@ -2,19 +2,25 @@ package app
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/billing"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/gemini"
 	grpcHandler "github.com/vanillaturtlechips/silver-guardian/backend/internal/grpc"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/s3"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/scheduler"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/worker"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
@@ -30,7 +36,14 @@ type App struct {
 	grpcServer *grpc.Server
 	store      *storage.PostgresStore
 	redis      *redis.Client
+	jwtKeys    *auth.KeySet
 	listener   net.Listener
+
+	watchScheduler *scheduler.WatchScheduler // nil이면 채널 구독 스캔 비활성화
+	purger         *scheduler.Purger         // nil이면 보관 기간 정리 비활성화
+	expirer        *scheduler.SubscriptionExpirer
+	billingWebhook http.Handler // nil이면 결제 웹훅 비활성화 (webhook_secret 미설정)
+	stopJobs       context.CancelFunc
 }
 
 // New initializes the application
@@ -49,14 +62,21 @@ func New(configPath, env string) (*App, error) {
 	log.Printf("Initializing App (Env: %s)", env)
 
 	// 2. DB 연결 (PostgreSQL)
-	// config.go의 DSN() 메소드 활용
-	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode)
-
-	store, err := storage.NewPostgresStore(dsn, 25, 25)
+	// 풀 크기/커넥션 수명은 DatabaseConfig에서, Postgres가 아직 뜨는 중이면 백오프로 재시도
+	store, err := storage.OpenPostgresStore(context.Background(), cfg.Database)
 	if err != nil {
 		return nil, fmt.Errorf("database connection failed: %w", err)
 	}
+	// 커넥션 풀 상태를 /debug/vars (expvar)로 노출
+	expvar.Publish("db_pool", expvar.Func(func() interface{} { return store.Stats() }))
+
+	// 2-1. 스키마 마이그레이션 (설정 시에만, 기본은 `server migrate up`으로 별도 실행)
+	if cfg.Database.AutoMigrate {
+		if err := migrateUp(context.Background(), store); err != nil {
+			store.Close()
+			return nil, fmt.Errorf("database migration failed: %w", err)
+		}
+	}
 
 	// 3. Redis 연결
 	// config.yaml에 값이 없으면 기본값 사용
@@ -78,32 +98,57 @@ func New(configPath, env string) (*App, error) {
 	// YouTube
 	ytClient := youtube.NewClient(cfg.YouTube.APIKey)
 
+	// 영상 플랫폼 레지스트리 (새 플랫폼은 여기에 Provider를 등록)
+	sources := source.NewRegistry(
+		source.NewYouTube(ytClient),
+	)
+
 	// Gemini
 	geminiClient, err := gemini.NewClient(context.Background(), cfg.Gemini.APIKey, "gemini-2.0-flash")
 	if err != nil {
 		return nil, fmt.Errorf("gemini client init failed: %w", err)
 	}
 
-	// 5. Worker (Analyzer) 초기화
-	// 이제 타입이 정확히 맞습니다 (*youtube.Client, *gemini.Client, *storage.PostgresStore, *redis.Client)
-	analyzer := worker.NewAnalyzer(ytClient, geminiClient, store, rdb)
-
-	// 6. S3 클라이언트 초기화
-	awsRegion := os.Getenv("AWS_REGION")
-	if awsRegion == "" {
-		awsRegion = "ap-northeast-2"
+	// 5. S3 클라이언트 초기화
+	s3Client, err := newS3Client(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("s3 client init failed: %w", err)
 	}
-	s3BucketName := os.Getenv("S3_BUCKET_NAME")
-	if s3BucketName == "" {
-		s3BucketName = "silver-guardian-uploads"
+
+	// 6. Worker (Analyzer) 초기화
+	// 썸네일 미러링이 켜져 있을 때만 S3 클라이언트를 넘깁니다.
+	// 버킷은 퍼블릭 액세스가 차단되어 있으므로 공개 URL(CDN)이 없으면 미러링하지 않습니다.
+	var thumbMirror *s3.Mirror
+	if cfg.YouTube.MirrorThumbnails {
+		if cfg.YouTube.ThumbnailBaseURL == "" {
+			log.Println("Warning: youtube.mirror_thumbnails requires youtube.thumbnail_base_url; thumbnail mirroring disabled")
+		} else {
+			thumbMirror = s3.NewMirror(s3Client, cfg.YouTube.ThumbnailBaseURL)
+		}
 	}
+	analyzer := worker.NewAnalyzer(sources, ytClient, geminiClient, store, rdb, thumbMirror, cfg.Live)
 
-	s3Client, err := s3.NewClient(context.Background(), s3BucketName, awsRegion)
+	// 7. JWT 서명 키 (설정/키 파일, 교체 중인 이전 키는 검증용으로 유지)
+	jwtKeys, err := auth.NewKeySet(cfg.JWT)
 	if err != nil {
-		return nil, fmt.Errorf("s3 client init failed: %w", err)
+		return nil, fmt.Errorf("jwt key init failed: %w", err)
+	}
+
+	// 로그인 제공자: 구글은 허용된 클라이언트 ID(웹/Android/iOS)로 발급된 ID 토큰만 수락,
+	// 카카오/네이버는 client_id가 설정된 경우에만 등록
+	loginProviders := auth.NewProviderRegistry(auth.NewGoogleVerifier(cfg.Google))
+	if len(cfg.Google.ClientIDs()) == 0 {
+		log.Printf("WARNING: no Google client IDs configured; Google login is disabled")
+	}
+	if cfg.Kakao.ClientID != "" {
+		loginProviders.Register(auth.NewKakaoProvider(cfg.Kakao))
 	}
+	if cfg.Naver.ClientID != "" {
+		loginProviders.Register(auth.NewNaverProvider(cfg.Naver))
+	}
+	log.Printf("Login providers: %v", loginProviders.Names())
 
-	// 7. gRPC 서버 설정
+	// 8. gRPC 서버 설정
 	port := cfg.Server.GRPCPort
 	if port == 0 {
 		port = 50051 // 기본값
@@ -114,30 +159,96 @@ func New(configPath, env string) (*App, error) {
 		return nil, fmt.Errorf("failed to listen on port %d: %w", port, err)
 	}
 
-	grpcServer := grpc.NewServer()
-	analysisHandler := grpcHandler.NewAnalysisServer(store, analyzer, s3Client)
+	// JWT 인증: 메서드별 정책(public/optional/required)에 따라 토큰 검증 후 Claims를 context에 저장
+	// 로그아웃한 토큰은 Redis denylist(jti)로 만료 전에 거부
+	tokens := auth.NewTokenService(jwtKeys, auth.NewRedisDenylist(rdb), time.Duration(cfg.JWT.RefreshTTLDays)*24*time.Hour)
+	// 비회원 한도는 클라이언트 IP 단위: ingress(신뢰하는 프록시)를 거친 요청만 x-forwarded-for 사용
+	trustedProxies, err := grpcHandler.ParseTrustedProxies(cfg.Server.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("server.trusted_proxies: %w", err)
+	}
+	authInterceptor := grpcHandler.NewAuthInterceptor(tokens, store, trustedProxies)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(authInterceptor.Unary),
+		grpc.StreamInterceptor(authInterceptor.Stream),
+	)
+	analysisHandler := grpcHandler.NewAnalysisServer(store, analyzer, s3Client, sources, cfg.Batch, cfg.Plans, tokens, loginProviders)
 	pb.RegisterAnalysisServiceServer(grpcServer, analysisHandler)
+	pb.RegisterAdminServiceServer(grpcServer, grpcHandler.NewAdminServer(store, analyzer, sources, tokens, cfg.Plans))
 	reflection.Register(grpcServer)
 
+	// 9. 채널 구독 스케줄러 (새 업로드 분석 및 알림)
+	var watchScheduler *scheduler.WatchScheduler
+	if cfg.Watchlist.Enabled {
+		watchScheduler = scheduler.NewWatchScheduler(store, analyzer, ytClient, cfg.Watchlist)
+	}
+
+	// 10. 보관 기간 정리 (만료된 자막/댓글/업로드 삭제)
+	var purger *scheduler.Purger
+	if cfg.Retention.Enabled {
+		purger = scheduler.NewPurger(store, s3Client, cfg.Retention)
+	}
+
+	// 11. 구독 만료 처리와 결제사 웹훅 (웹훅은 서명 키가 있을 때만)
+	expirer := scheduler.NewSubscriptionExpirer(store, cfg.Billing)
+	var billingWebhook http.Handler
+	if cfg.Billing.WebhookSecret != "" {
+		billingWebhook = billing.NewWebhookHandler(store, cfg.Billing, cfg.Plans)
+	} else {
+		log.Printf("WARNING: billing.webhook_secret is not set; billing webhooks are disabled")
+	}
+
 	return &App{
-		cfg:        cfg,
-		grpcServer: grpcServer,
-		store:      store,
-		redis:      rdb,
-		listener:   lis,
+		cfg:            cfg,
+		grpcServer:     grpcServer,
+		store:          store,
+		redis:          rdb,
+		jwtKeys:        jwtKeys,
+		listener:       lis,
+		watchScheduler: watchScheduler,
+		purger:         purger,
+		expirer:        expirer,
+		billingWebhook: billingWebhook,
 	}, nil
 }
 
+// newS3Client: 환경변수(AWS_REGION, S3_BUCKET_NAME)로 S3 클라이언트 생성
+func newS3Client(ctx context.Context) (*s3.Client, error) {
+	awsRegion := os.Getenv("AWS_REGION")
+	if awsRegion == "" {
+		awsRegion = "ap-northeast-2"
+	}
+	s3BucketName := os.Getenv("S3_BUCKET_NAME")
+	if s3BucketName == "" {
+		s3BucketName = "silver-guardian-uploads"
+	}
+	return s3.NewClient(ctx, s3BucketName, awsRegion)
+}
+
 // Run starts the gRPC server
 func (a *App) Run() error {
     wrappedGrpc := grpcweb.WrapServer(a.grpcServer,
         grpcweb.WithOriginFunc(func(origin string) bool { return true }),
     )
 
+    // gRPC-Web 요청이 아니면 헬스체크/모니터링 엔드포인트로 라우팅
+    mux := http.NewServeMux()
+    mux.HandleFunc("/healthz", a.handleHealth)
+    // 다른 서비스(Lambda, ML 서비스)가 우리 JWT를 검증할 공개 키
+    mux.Handle("/.well-known/jwks.json", a.jwtKeys.JWKSHandler())
+    // 결제사 웹훅 (HMAC 서명 확인, 이벤트 ID로 중복 처리 방지)
+    if a.billingWebhook != nil {
+        mux.Handle("/webhooks/billing", a.billingWebhook)
+    }
+
     httpServer := &http.Server{
         Addr: ":8080",
         Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-            wrappedGrpc.ServeHTTP(w, r)
+            if wrappedGrpc.IsGrpcWebRequest(r) || wrappedGrpc.IsAcceptableGrpcCorsRequest(r) {
+                wrappedGrpc.ServeHTTP(w, r)
+                return
+            }
+            mux.ServeHTTP(w, r)
         }),
     }
 
@@ -148,12 +259,51 @@ func (a *App) Run() error {
         }
     }()
 
+    // 내부 지표(커넥션 풀 등)는 CORS가 열린 공개 포트가 아닌 별도 주소로만 노출
+    if a.cfg.Server.DebugAddr != "" {
+        debugMux := http.NewServeMux()
+        debugMux.Handle("/debug/vars", expvar.Handler())
+        go func() {
+            log.Printf("Debug server listening on %s", a.cfg.Server.DebugAddr)
+            if err := http.ListenAndServe(a.cfg.Server.DebugAddr, debugMux); err != nil {
+                log.Printf("Debug server stopped: %v", err)
+            }
+        }()
+    }
+
+    // 백그라운드 작업 (Stop에서 종료)
+    jobsCtx, cancel := context.WithCancel(context.Background())
+    a.stopJobs = cancel
+    if a.watchScheduler != nil {
+        go a.watchScheduler.Run(jobsCtx)
+    }
+    if a.purger != nil {
+        go a.purger.Run(jobsCtx)
+    }
+    go a.expirer.Run(jobsCtx)
+
     log.Printf("Starting gRPC server on port :%d", a.cfg.Server.GRPCPort)
     return a.grpcServer.Serve(a.listener)
 }
 
+// handleHealth: DB 연결 확인 (k8s liveness/readiness probe용)
+func (a *App) handleHealth(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+	defer cancel()
+
+	if err := a.store.Ping(ctx); err != nil {
+		http.Error(w, fmt.Sprintf("database unavailable: %v", err), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
 // Stop cleans up resources
 func (a *App) Stop() {
+	if a.stopJobs != nil {
+		a.stopJobs()
+	}
 	a.grpcServer.GracefulStop()
 	if a.store != nil {
 		a.store.Close()
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/billing"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+// Billing runs the `billing` subcommand. `billing sign <payload.json>`
+// prints the signature header for a locally written webhook payload, signed
+// with billing.webhook_secret, so the endpoint can be tried with curl:
+//
+//	curl -X POST localhost:8080/webhooks/billing -H "$(server billing sign event.json)" --data-binary @event.json
+func Billing(configPath string, args []string) error {
+	if len(args) != 2 || args[0] != "sign" {
+		return fmt.Errorf("usage: server billing sign <payload.json>")
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return fmt.Errorf("config load failed: %w", err)
+	}
+	if cfg.Billing.WebhookSecret == "" {
+		return fmt.Errorf("billing.webhook_secret is not set")
+	}
+
+	body, err := os.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: %s\n", billing.SignatureHeader, billing.Sign([]byte(cfg.Billing.WebhookSecret), body, time.Now()))
+	return nil
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/migrate"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/migrations"
+)
+
+const migrateUsage = "usage: server migrate up | down [steps] | status"
+
+// Migrate runs the `migrate` subcommand: up, down [steps] or status.
+func Migrate(configPath string, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return fmt.Errorf("config load failed: %w", err)
+	}
+
+	// 마이그레이션은 커넥션 두 개면 충분 (advisory lock + 실행)
+	dbCfg := cfg.Database
+	dbCfg.MaxConnections, dbCfg.MaxIdleConnections = 2, 1
+	store, err := storage.OpenPostgresStore(context.Background(), dbCfg)
+	if err != nil {
+		return fmt.Errorf("database connection failed: %w", err)
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		return migrateUp(ctx, store)
+
+	case "down":
+		// 실수로 전체 스키마를 지우지 않도록 기본은 1단계만 되돌림
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps <= 0 {
+				return fmt.Errorf("invalid steps %q", args[1])
+			}
+		}
+		runner, err := migrate.New(store.DB(), migrations.FS)
+		if err != nil {
+			return err
+		}
+		reverted, err := runner.Down(ctx, steps)
+		for _, m := range reverted {
+			log.Printf("Reverted migration %03d_%s", m.Version, m.Name)
+		}
+		if err == nil && len(reverted) == 0 {
+			log.Printf("No applied migrations to revert")
+		}
+		return err
+
+	case "status":
+		runner, err := migrate.New(store.DB(), migrations.FS)
+		if err != nil {
+			return err
+		}
+		statuses, err := runner.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, st := range statuses {
+			state := "pending"
+			if st.Applied {
+				state = "applied " + st.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if st.Modified {
+				state += " (MODIFIED since applied)"
+			}
+			fmt.Printf("%03d_%-30s %s\n", st.Version, st.Name, state)
+		}
+		return nil
+	}
+
+	return errors.New(migrateUsage)
+}
+
+// migrateUp applies all pending migrations.
+func migrateUp(ctx context.Context, store *storage.PostgresStore) error {
+	runner, err := migrate.New(store.DB(), migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	applied, err := runner.Up(ctx)
+	for _, m := range applied {
+		log.Printf("Applied migration %03d_%s", m.Version, m.Name)
+	}
+	if err == nil && len(applied) == 0 {
+		log.Printf("Database schema is up to date")
+	}
+	return err
+}
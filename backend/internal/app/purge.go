@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/scheduler"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+// Purge runs the `purge` subcommand: one retention pass with the configured
+// periods, regardless of retention.enabled, printing what was removed.
+func Purge(configPath string) error {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return fmt.Errorf("config load failed: %w", err)
+	}
+
+	dbCfg := cfg.Database
+	dbCfg.MaxConnections, dbCfg.MaxIdleConnections = 2, 1
+	ctx := context.Background()
+	store, err := storage.OpenPostgresStore(ctx, dbCfg)
+	if err != nil {
+		return fmt.Errorf("database connection failed: %w", err)
+	}
+	defer store.Close()
+
+	s3Client, err := newS3Client(ctx)
+	if err != nil {
+		return fmt.Errorf("s3 client init failed: %w", err)
+	}
+
+	report, err := scheduler.NewPurger(store, s3Client, cfg.Retention).PurgeOnce(ctx)
+	printPurgeReport(cfg.Retention, report)
+	return err
+}
+
+func printPurgeReport(cfg config.RetentionConfig, r *scheduler.PurgeReport) {
+	retention := func(days int) string {
+		if days <= 0 {
+			return "kept forever"
+		}
+		return fmt.Sprintf("older than %d days", days)
+	}
+
+	fmt.Printf("%-10s %8d  (%s)\n", "captions", r.Captions, retention(cfg.CaptionsDays))
+	fmt.Printf("%-10s %8d  (%s)\n", "comments", r.Comments, retention(cfg.CommentsDays))
+	fmt.Printf("%-10s %8d  (%s)\n", "uploads", r.Uploads, retention(cfg.UploadsDays))
+	fmt.Printf("%-10s %8d  (failed: %d)\n", "s3 objects", r.Objects, r.FailedObjects)
+	fmt.Printf("finished in %s\n", r.Duration.Round(time.Millisecond))
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// API key scopes: what a partner system may call.
+const (
+	ScopeAnalysisRead  = "analysis:read"  // 결과/진행 상황 조회
+	ScopeAnalysisWrite = "analysis:write" // 분석 요청
+)
+
+// apiKeyPrefix marks our keys so leaked ones are easy to find in code scans.
+const apiKeyPrefix = "sg_"
+
+// apiKeyDisplayLen is how much of a key is kept in clear to tell keys apart.
+const apiKeyDisplayLen = len(apiKeyPrefix) + 8
+
+// ValidScope reports whether scope is one of the known scopes.
+func ValidScope(scope string) bool {
+	return scope == ScopeAnalysisRead || scope == ScopeAnalysisWrite
+}
+
+// NewAPIKey is a newly generated API key. Key goes to the partner once;
+// only Hash and Prefix are stored.
+type NewAPIKey struct {
+	Key    string
+	Prefix string
+	Hash   string
+}
+
+// GenerateAPIKey creates a random API key.
+func GenerateAPIKey() (*NewAPIKey, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(raw)
+	return &NewAPIKey{Key: key, Prefix: key[:apiKeyDisplayLen], Hash: HashAPIKey(key)}, nil
+}
+
+// HashAPIKey returns the hex SHA-256 of an API key, the form it is stored
+// and looked up in.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// LooksLikeAPIKey reports whether s has the API key format, to reject
+// obvious garbage before a database lookup.
+func LooksLikeAPIKey(s string) bool {
+	return strings.HasPrefix(s, apiKeyPrefix) && len(s) > apiKeyDisplayLen
+}
+
+// APIKeyCaller identifies a request authenticated with a partner API key.
+type APIKeyCaller struct {
+	KeyID      int64
+	Name       string
+	Scopes     []string
+	DailyQuota int // requests per UTC day, 0 = unlimited
+}
+
+// HasScope reports whether the key was granted scope.
+func (c *APIKeyCaller) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -1,33 +1,174 @@
 package auth
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
 )
 
+// 테스트용 PEM 키 생성
+func rsaKeyPEM(t *testing.T) (private, public string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}))
+}
+
+func ed25519KeyPEM(t *testing.T) string {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
 func TestJWTFlow(t *testing.T) {
 	// 1. 테스트용 데이터
 	userID := int64(123)
 	email := "test@example.com"
 
-	// 2. JWT 생성 테스트
-	tokenString, err := GenerateJWT(userID, email)
-	assert.NoError(t, err)
-	assert.NotEmpty(t, tokenString)
+	for _, alg := range []string{"RS256", "EdDSA", "HS256"} {
+		t.Run(alg, func(t *testing.T) {
+			kc := config.JWTKeyConfig{ID: "k1", Algorithm: alg}
+			switch alg {
+			case "RS256":
+				kc.PrivateKey, _ = rsaKeyPEM(t)
+			case "EdDSA":
+				kc.PrivateKey = ed25519KeyPEM(t)
+			case "HS256":
+				kc.Secret = "0123456789abcdef0123456789abcdef"
+			}
+			keys, err := NewKeySet(config.JWTConfig{Issuer: "silver-guardian", SigningKeyID: "k1", Keys: []config.JWTKeyConfig{kc}})
+			require.NoError(t, err)
 
-	// 3. JWT 검증 테스트
-	claims, err := ValidateJWT(tokenString)
-	assert.NoError(t, err)
-	assert.NotNil(t, claims)
+			// 2. JWT 생성 테스트
+			tokenString, err := keys.GenerateJWT(userID, email, RoleReviewer)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, tokenString)
+
+			// 3. JWT 검증 테스트
+			claims, err := keys.ValidateJWT(tokenString)
+			require.NoError(t, err)
 
-	// 4. 내용 일치 확인
-	assert.Equal(t, userID, claims.UserID)
-	assert.Equal(t, email, claims.Email)
+			// 4. 내용 일치 확인
+			assert.Equal(t, userID, claims.UserID)
+			assert.Equal(t, email, claims.Email)
+			assert.Equal(t, RoleReviewer, claims.Role)
+			assert.Equal(t, "silver-guardian", claims.Issuer)
+
+			_, err = keys.ValidateJWT(tokenString + "x")
+			assert.Error(t, err)
+		})
+	}
 }
 
-func TestInvalidJWT(t *testing.T) {
-	// 잘못된 토큰 검증
-	_, err := ValidateJWT("invalid-token-string")
+func TestKeyRotation(t *testing.T) {
+	oldPrivate, oldPublic := rsaKeyPEM(t)
+	dir := t.TempDir()
+	oldPublicFile := filepath.Join(dir, "old.pub.pem")
+	require.NoError(t, os.WriteFile(oldPublicFile, []byte(oldPublic), 0o600))
+
+	before, err := NewKeySet(config.JWTConfig{SigningKeyID: "old", Keys: []config.JWTKeyConfig{
+		{ID: "old", Algorithm: "RS256", PrivateKey: oldPrivate},
+	}})
+	require.NoError(t, err)
+	oldToken, err := before.GenerateJWT(1, "a@example.com", RoleUser)
+	require.NoError(t, err)
+
+	// 새 키로 서명, 이전 키는 공개 키만 남겨 검증용으로 유지
+	after, err := NewKeySet(config.JWTConfig{SigningKeyID: "new", Keys: []config.JWTKeyConfig{
+		{ID: "new", Algorithm: "EdDSA", PrivateKey: ed25519KeyPEM(t)},
+		{ID: "old", Algorithm: "RS256", PublicKeyFile: oldPublicFile},
+	}})
+	require.NoError(t, err)
+
+	claims, err := after.ValidateJWT(oldToken)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, claims.UserID)
+
+	newToken, err := after.GenerateJWT(2, "b@example.com", RoleUser)
+	require.NoError(t, err)
+	_, err = after.ValidateJWT(newToken)
+	assert.NoError(t, err)
+
+	// 이전 키만 아는 서비스는 새 토큰(kid=new)을 거부
+	_, err = before.ValidateJWT(newToken)
 	assert.Error(t, err)
-}
\ No newline at end of file
+
+	jwks := after.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "new", jwks.Keys[0].KeyID)
+	assert.Equal(t, "OKP", jwks.Keys[0].KeyType)
+	assert.Equal(t, "Ed25519", jwks.Keys[0].Curve)
+	assert.NotEmpty(t, jwks.Keys[0].X)
+	assert.Equal(t, "old", jwks.Keys[1].KeyID)
+	assert.Equal(t, "RSA", jwks.Keys[1].KeyType)
+	assert.Equal(t, "AQAB", jwks.Keys[1].E)
+}
+
+func TestNewKeySetErrors(t *testing.T) {
+	_, public := rsaKeyPEM(t)
+	dir := t.TempDir()
+	publicFile := filepath.Join(dir, "k.pub.pem")
+	require.NoError(t, os.WriteFile(publicFile, []byte(public), 0o600))
+
+	tests := map[string]config.JWTConfig{
+		"unknown signing key": {SigningKeyID: "missing", Keys: []config.JWTKeyConfig{
+			{ID: "k1", Algorithm: "HS256", Secret: "0123456789abcdef0123456789abcdef"},
+		}},
+		"verify-only signing key": {SigningKeyID: "k1", Keys: []config.JWTKeyConfig{
+			{ID: "k1", Algorithm: "RS256", PublicKeyFile: publicFile},
+		}},
+		"short secret": {SigningKeyID: "k1", Keys: []config.JWTKeyConfig{
+			{ID: "k1", Algorithm: "HS256", Secret: "short"},
+		}},
+		"unsupported algorithm": {SigningKeyID: "k1", Keys: []config.JWTKeyConfig{
+			{ID: "k1", Algorithm: "none", Secret: "0123456789abcdef0123456789abcdef"},
+		}},
+	}
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewKeySet(cfg)
+			assert.Error(t, err)
+		})
+	}
+
+	// 설정이 비어 있으면(환경변수 미설정) 임시 키, HMAC 키는 JWKS에 노출하지 않음
+	ephemeral, err := NewKeySet(config.JWTConfig{Keys: []config.JWTKeyConfig{{Algorithm: "RS256"}}})
+	require.NoError(t, err)
+	assert.Len(t, ephemeral.JWKS().Keys, 1)
+
+	hmac, err := NewKeySet(config.JWTConfig{SigningKeyID: "k1", Keys: []config.JWTKeyConfig{
+		{ID: "k1", Algorithm: "HS256", Secret: "0123456789abcdef0123456789abcdef"},
+	}})
+	require.NoError(t, err)
+	assert.Empty(t, hmac.JWKS().Keys)
+}
+
+func TestHasPermission(t *testing.T) {
+	assert.True(t, HasPermission(RoleAdmin, PermManageRoles))
+	assert.True(t, HasPermission(RoleReviewer, PermOverrideVerdict))
+	assert.False(t, HasPermission(RoleReviewer, PermManageRoles))
+	assert.False(t, HasPermission(RoleGuardian, PermViewUsers))
+	assert.False(t, HasPermission(RoleUser, PermReanalyze))
+
+	// 역할이 도입되기 전에 발급된 토큰은 일반 사용자
+	assert.False(t, (&Claims{UserID: 1}).Can(PermViewUsers))
+	assert.False(t, (*Claims)(nil).Can(PermViewUsers))
+
+	assert.True(t, ValidRole(RoleGuardian))
+	assert.False(t, ValidRole("root"))
+}
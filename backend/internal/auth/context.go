@@ -0,0 +1,29 @@
+package auth
+
+import "context"
+
+type claimsKey struct{}
+
+type apiKeyCallerKey struct{}
+
+// NewContext returns a context carrying the authenticated caller's claims.
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext returns the claims stored by NewContext, if any.
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
+
+// NewAPIKeyContext returns a context carrying the API key caller.
+func NewAPIKeyContext(ctx context.Context, caller *APIKeyCaller) context.Context {
+	return context.WithValue(ctx, apiKeyCallerKey{}, caller)
+}
+
+// APIKeyFromContext returns the caller stored by NewAPIKeyContext, if any.
+func APIKeyFromContext(ctx context.Context) (*APIKeyCaller, bool) {
+	caller, ok := ctx.Value(apiKeyCallerKey{}).(*APIKeyCaller)
+	return caller, ok && caller != nil
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Denylist revokes access tokens before they expire: single tokens by jti
+// (logout) and every token a user was issued before a point in time (log
+// out all devices). Entries only need to live as long as the tokens they
+// revoke.
+type Denylist interface {
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	RevokeUser(ctx context.Context, userID int64, issuedBefore time.Time, ttl time.Duration) error
+	IsRevoked(ctx context.Context, claims *Claims) (bool, error)
+}
+
+// RedisDenylist keeps revocations in Redis so every server instance sees them.
+type RedisDenylist struct {
+	rdb *redis.Client
+}
+
+func NewRedisDenylist(rdb *redis.Client) *RedisDenylist {
+	return &RedisDenylist{rdb: rdb}
+}
+
+func jtiKey(jti string) string    { return "auth:revoked:jti:" + jti }
+func userKey(userID int64) string { return fmt.Sprintf("auth:revoked:user:%d", userID) }
+
+func (d *RedisDenylist) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" {
+		return errors.New("token has no jti")
+	}
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil // 이미 만료된 토큰
+	}
+	return d.rdb.Set(ctx, jtiKey(jti), 1, ttl).Err()
+}
+
+func (d *RedisDenylist) RevokeUser(ctx context.Context, userID int64, issuedBefore time.Time, ttl time.Duration) error {
+	return d.rdb.Set(ctx, userKey(userID), issuedBefore.Unix(), ttl).Err()
+}
+
+func (d *RedisDenylist) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	values, err := d.rdb.MGet(ctx, jtiKey(claims.ID), userKey(claims.UserID)).Result()
+	if err != nil {
+		return false, err
+	}
+	if values[0] != nil {
+		return true, nil
+	}
+	if s, ok := values[1].(string); ok {
+		before, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return false, err
+		}
+		return issuedBefore(claims, before), nil
+	}
+	return false, nil
+}
+
+// issuedBefore: iat가 없거나 기준 시각 이전에 발급된 토큰
+// iat는 초 단위라 같은 초에 발급된 토큰도 폐기 대상에 포함한다.
+func issuedBefore(claims *Claims, unix int64) bool {
+	return claims.IssuedAt == nil || claims.IssuedAt.Unix() <= unix
+}
+
+// MemoryDenylist is a process-local Denylist for tests and single-instance
+// development.
+type MemoryDenylist struct {
+	mu     sync.Mutex
+	tokens map[string]time.Time // jti -> expiry
+	users  map[int64]memoryUserRevocation
+}
+
+type memoryUserRevocation struct {
+	before  int64
+	expires time.Time
+}
+
+func NewMemoryDenylist() *MemoryDenylist {
+	return &MemoryDenylist{
+		tokens: make(map[string]time.Time),
+		users:  make(map[int64]memoryUserRevocation),
+	}
+}
+
+func (d *MemoryDenylist) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" {
+		return errors.New("token has no jti")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.tokens[jti] = expiresAt
+	return nil
+}
+
+func (d *MemoryDenylist) RevokeUser(ctx context.Context, userID int64, issuedBefore time.Time, ttl time.Duration) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.users[userID] = memoryUserRevocation{before: issuedBefore.Unix(), expires: time.Now().Add(ttl)}
+	return nil
+}
+
+func (d *MemoryDenylist) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	if exp, ok := d.tokens[claims.ID]; ok && exp.After(now) {
+		return true, nil
+	}
+	if r, ok := d.users[claims.UserID]; ok && r.expires.After(now) {
+		return issuedBefore(claims, r.before), nil
+	}
+	return false, nil
+}
@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+const (
+	googleCertsURL      = "https://www.googleapis.com/oauth2/v3/certs"
+	defaultCertsMaxAge  = time.Hour
+	certsRefreshBackoff = time.Minute
+	googleClockSkew     = 30 * time.Second
+)
+
+// googleIssuers are the iss values Google puts in ID tokens.
+var googleIssuers = map[string]bool{
+	"accounts.google.com":         true,
+	"https://accounts.google.com": true,
+}
+
+// ErrGoogleNotConfigured is returned when no client IDs are configured,
+// since any Google-signed token would otherwise be accepted.
+var ErrGoogleNotConfigured = errors.New("google login is not configured")
+
+// GoogleVerifier validates Google ID tokens issued to one of our OAuth
+// clients (web, Android, iOS). Google's signing keys are fetched from the
+// certs endpoint and cached for as long as its Cache-Control allows.
+type GoogleVerifier struct {
+	clientIDs []string
+	certsURL  string
+	client    *http.Client
+	now       func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	expiresAt time.Time
+	fetchedAt time.Time
+}
+
+var _ Provider = (*GoogleVerifier)(nil)
+
+// NewGoogleVerifier creates a verifier accepting tokens whose audience is
+// one of the configured client IDs.
+func NewGoogleVerifier(cfg config.GoogleConfig) *GoogleVerifier {
+	certsURL := cfg.CertsURL
+	if certsURL == "" {
+		certsURL = googleCertsURL
+	}
+	return &GoogleVerifier{
+		clientIDs: cfg.ClientIDs(),
+		certsURL:  certsURL,
+		client:    &http.Client{Timeout: 10 * time.Second},
+		now:       time.Now,
+	}
+}
+
+func (v *GoogleVerifier) Name() string { return ProviderGoogle }
+
+// Authenticate verifies cred.IDToken.
+func (v *GoogleVerifier) Authenticate(ctx context.Context, cred Credential) (*Identity, error) {
+	return v.Verify(ctx, cred.IDToken)
+}
+
+// Verify checks the token's signature, issuer, audience and expiry, and
+// that its email is verified.
+func (v *GoogleVerifier) Verify(ctx context.Context, idToken string) (*Identity, error) {
+	if len(v.clientIDs) == 0 {
+		return nil, ErrGoogleNotConfigured
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithAudience(v.clientIDs...),
+		jwt.WithLeeway(googleClockSkew),
+		jwt.WithTimeFunc(v.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid google id token: %w", err)
+	}
+
+	if iss, _ := claims.GetIssuer(); !googleIssuers[iss] {
+		return nil, fmt.Errorf("invalid google id token: unexpected issuer %q", iss)
+	}
+
+	identity := &Identity{
+		Provider:      ProviderGoogle,
+		Subject:       stringClaim(claims, "sub"),
+		Email:         stringClaim(claims, "email"),
+		EmailVerified: boolClaim(claims, "email_verified"),
+		Name:          stringClaim(claims, "name"),
+		Picture:       stringClaim(claims, "picture"),
+	}
+	if identity.Subject == "" || identity.Email == "" {
+		return nil, errors.New("invalid google id token: missing sub or email claim")
+	}
+	if !identity.EmailVerified {
+		return nil, ErrEmailNotVerified
+	}
+	return identity, nil
+}
+
+// stringClaim returns claims[name] if it is a string, and "" otherwise.
+func stringClaim(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// boolClaim accepts both JSON booleans and "true"/"false" strings, which
+// some Google endpoints have used for email_verified.
+func boolClaim(claims jwt.MapClaims, name string) bool {
+	switch v := claims[name].(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	}
+	return false
+}
+
+// key returns the public key for kid, refreshing the cached set when it
+// has expired or does not contain kid (Google rotated its keys).
+func (v *GoogleVerifier) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	key, ok := v.keys[kid]
+	stale := now.After(v.expiresAt)
+	// 모르는 kid로 인증서를 계속 다시 받지 않도록 최소 간격을 둔다
+	if stale || (!ok && now.Sub(v.fetchedAt) > certsRefreshBackoff) {
+		if err := v.fetchKeys(ctx); err != nil {
+			if ok && !stale {
+				return key, nil
+			}
+			return nil, err
+		}
+		key, ok = v.keys[kid]
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown google key id %q", kid)
+	}
+	return key, nil
+}
+
+// fetchKeys downloads the JWKS at certsURL. Callers hold v.mu.
+func (v *GoogleVerifier) fetchKeys(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.certsURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch google certs: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch google certs: status %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("decode google certs: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.KeyType != "RSA" {
+			continue
+		}
+		pub, err := rsaPublicKey(jwk)
+		if err != nil {
+			return fmt.Errorf("google key %q: %w", jwk.KeyID, err)
+		}
+		keys[jwk.KeyID] = pub
+	}
+
+	now := v.now()
+	v.keys = keys
+	v.fetchedAt = now
+	v.expiresAt = now.Add(cacheMaxAge(resp.Header.Get("Cache-Control")))
+	return nil
+}
+
+func rsaPublicKey(jwk JWK) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("exponent: %w", err)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+// cacheMaxAge parses max-age from a Cache-Control header.
+func cacheMaxAge(header string) time.Duration {
+	for _, directive := range strings.Split(header, ",") {
+		value, ok := strings.CutPrefix(strings.TrimSpace(directive), "max-age=")
+		if !ok {
+			continue
+		}
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return defaultCertsMaxAge
+}
@@ -0,0 +1,105 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth/googletest"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+const (
+	webClientID     = "web.apps.googleusercontent.com"
+	androidClientID = "android.apps.googleusercontent.com"
+)
+
+func TestGoogleVerifier(t *testing.T) {
+	ctx := context.Background()
+	issuer := googletest.NewIssuer(t)
+	verifier := auth.NewGoogleVerifier(config.GoogleConfig{
+		WebClientID:     webClientID,
+		AndroidClientID: androidClientID,
+		CertsURL:        issuer.URL,
+	})
+
+	identity, err := verifier.Verify(ctx, issuer.Sign(t, googletest.Claims(webClientID)))
+	require.NoError(t, err)
+	assert.Equal(t, "110248495921238986420", identity.Subject)
+	assert.Equal(t, "senior@example.com", identity.Email)
+	assert.Equal(t, "Kim Senior", identity.Name)
+
+	// 모든 허용 클라이언트(앱)의 토큰 수락
+	_, err = verifier.Verify(ctx, issuer.Sign(t, googletest.Claims(androidClientID)))
+	assert.NoError(t, err)
+
+	// 이름/사진이 없거나 타입이 달라도 패닉 없이 빈 값
+	claims := googletest.Claims(webClientID)
+	delete(claims, "name")
+	claims["picture"] = 42
+	claims["email_verified"] = "true"
+	identity, err = verifier.Verify(ctx, issuer.Sign(t, claims))
+	require.NoError(t, err)
+	assert.Empty(t, identity.Name)
+	assert.Empty(t, identity.Picture)
+}
+
+func TestGoogleVerifierRejects(t *testing.T) {
+	ctx := context.Background()
+	issuer := googletest.NewIssuer(t)
+	verifier := auth.NewGoogleVerifier(config.GoogleConfig{WebClientID: webClientID, CertsURL: issuer.URL})
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	forged := jwt.NewWithClaims(jwt.SigningMethodRS256, googletest.Claims(webClientID))
+	forged.Header["kid"] = issuer.KeyID
+	forgedToken, err := forged.SignedString(otherKey)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		token string
+		want  error
+	}{
+		"other app's client id": {token: issuer.Sign(t, with(webClientID, "aud", "evil.apps.googleusercontent.com"))},
+		"wrong issuer":          {token: issuer.Sign(t, with(webClientID, "iss", "https://evil.example.com"))},
+		"expired":               {token: issuer.Sign(t, with(webClientID, "exp", time.Now().Add(-time.Hour).Unix()))},
+		"missing exp":           {token: issuer.Sign(t, with(webClientID, "exp", nil))},
+		"missing email":         {token: issuer.Sign(t, with(webClientID, "email", nil))},
+		"email as number":       {token: issuer.Sign(t, with(webClientID, "email", 7))},
+		"unverified email":      {token: issuer.Sign(t, with(webClientID, "email_verified", false)), want: auth.ErrEmailNotVerified},
+		"email_verified absent": {token: issuer.Sign(t, with(webClientID, "email_verified", nil)), want: auth.ErrEmailNotVerified},
+		"forged signature":      {token: forgedToken},
+		"garbage":               {token: "not-a-jwt"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := verifier.Verify(ctx, tt.token)
+			require.Error(t, err)
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+			}
+		})
+	}
+
+	// 클라이언트 ID가 하나도 없으면 aud 검사를 건너뛰지 않고 전부 거부
+	unconfigured := auth.NewGoogleVerifier(config.GoogleConfig{CertsURL: issuer.URL})
+	_, err = unconfigured.Verify(ctx, issuer.Sign(t, googletest.Claims("")))
+	assert.ErrorIs(t, err, auth.ErrGoogleNotConfigured)
+}
+
+// with returns valid claims for audience with one claim replaced (nil removes it).
+func with(audience, name string, value interface{}) jwt.MapClaims {
+	claims := googletest.Claims(audience)
+	if value == nil {
+		delete(claims, name)
+	} else {
+		claims[name] = value
+	}
+	return claims
+}
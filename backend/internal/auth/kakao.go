@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+const (
+	kakaoTokenURL    = "https://kauth.kakao.com/oauth/token"
+	kakaoUserInfoURL = "https://kapi.kakao.com/v2/user/me"
+)
+
+// KakaoProvider signs users in with Kakao Login (authorization code flow).
+type KakaoProvider struct {
+	oauth oauthClient
+}
+
+var _ Provider = (*KakaoProvider)(nil)
+
+// NewKakaoProvider creates the provider; cfg.ClientID is the app's REST API key.
+func NewKakaoProvider(cfg config.OAuthConfig) *KakaoProvider {
+	return &KakaoProvider{oauth: newOAuthClient(ProviderKakao, cfg, kakaoTokenURL, kakaoUserInfoURL)}
+}
+
+func (p *KakaoProvider) Name() string { return ProviderKakao }
+
+// kakaoUser is the part of /v2/user/me we use. Email and profile are only
+// present if the user agreed to share them.
+type kakaoUser struct {
+	ID           int64 `json:"id"`
+	KakaoAccount struct {
+		Email           string `json:"email"`
+		IsEmailValid    bool   `json:"is_email_valid"`
+		IsEmailVerified bool   `json:"is_email_verified"`
+		Profile         struct {
+			Nickname        string `json:"nickname"`
+			ProfileImageURL string `json:"profile_image_url"`
+		} `json:"profile"`
+	} `json:"kakao_account"`
+}
+
+// Authenticate exchanges cred.Code and loads the Kakao account.
+func (p *KakaoProvider) Authenticate(ctx context.Context, cred Credential) (*Identity, error) {
+	accessToken, err := p.oauth.exchange(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+	var user kakaoUser
+	if err := p.oauth.userInfo(ctx, accessToken, &user); err != nil {
+		return nil, err
+	}
+	if user.ID == 0 {
+		return nil, errors.New("kakao user info: missing id")
+	}
+
+	account := user.KakaoAccount
+	return &Identity{
+		Provider: ProviderKakao,
+		Subject:  strconv.FormatInt(user.ID, 10),
+		Email:    account.Email,
+		// 휴면/만료된 이메일(is_email_valid=false)은 본인 소유라고 볼 수 없음
+		EmailVerified: account.Email != "" && account.IsEmailValid && account.IsEmailVerified,
+		Name:          account.Profile.Nickname,
+		Picture:       account.Profile.ProfileImageURL,
+	}, nil
+}
@@ -0,0 +1,272 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+const defaultTokenTTL = 15 * time.Minute
+
+// signingKey is one configured key. Verify-only keys have no private part.
+type signingKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.PrivateKey // nil for verify-only keys
+	public  crypto.PublicKey  // HMAC keys use the secret for both
+}
+
+// KeySet signs tokens with the current key and verifies tokens signed by
+// any configured key, selected by the kid header. Keeping retired keys in
+// the set lets tokens they signed stay valid until they expire.
+type KeySet struct {
+	issuer  string
+	ttl     time.Duration
+	signing *signingKey
+	keys    map[string]*signingKey
+}
+
+// NewKeySet loads the keys described by cfg. Entries without an id and key
+// material (unset environment variables) are ignored; if none remain, an
+// ephemeral Ed25519 key is generated for development.
+func NewKeySet(cfg config.JWTConfig) (*KeySet, error) {
+	ks := &KeySet{
+		issuer: cfg.Issuer,
+		ttl:    defaultTokenTTL,
+		keys:   make(map[string]*signingKey),
+	}
+	if cfg.TTLMinutes > 0 {
+		ks.ttl = time.Duration(cfg.TTLMinutes) * time.Minute
+	}
+
+	for _, kc := range cfg.Keys {
+		if kc.ID == "" && kc.Secret == "" && kc.PrivateKey == "" && kc.PrivateKeyFile == "" && kc.PublicKeyFile == "" {
+			continue
+		}
+		key, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", kc.ID, err)
+		}
+		if _, dup := ks.keys[key.id]; dup {
+			return nil, fmt.Errorf("duplicate jwt key id %q", key.id)
+		}
+		ks.keys[key.id] = key
+	}
+
+	if len(ks.keys) == 0 {
+		key, err := ephemeralKey()
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("WARNING: no JWT keys configured; using ephemeral key %s (tokens are invalid after restart)", key.id)
+		ks.keys[key.id] = key
+		ks.signing = key
+		return ks, nil
+	}
+
+	signing, ok := ks.keys[cfg.SigningKeyID]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q is not configured", cfg.SigningKeyID)
+	}
+	if signing.private == nil {
+		return nil, fmt.Errorf("signing key %q has no private key", cfg.SigningKeyID)
+	}
+	ks.signing = signing
+	return ks, nil
+}
+
+func loadKey(kc config.JWTKeyConfig) (*signingKey, error) {
+	if kc.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	key := &signingKey{id: kc.ID}
+
+	privatePEM := []byte(kc.PrivateKey)
+	if kc.PrivateKeyFile != "" {
+		data, err := os.ReadFile(kc.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		privatePEM = data
+	}
+	var publicPEM []byte
+	if kc.PublicKeyFile != "" {
+		data, err := os.ReadFile(kc.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		publicPEM = data
+	}
+	if len(privatePEM) == 0 && len(publicPEM) == 0 && kc.Algorithm != jwt.SigningMethodHS256.Alg() {
+		return nil, errors.New("private_key, private_key_file or public_key_file is required")
+	}
+
+	var err error
+	switch kc.Algorithm {
+	case jwt.SigningMethodRS256.Alg():
+		key.method = jwt.SigningMethodRS256
+		if len(privatePEM) > 0 {
+			var priv *rsa.PrivateKey
+			if priv, err = jwt.ParseRSAPrivateKeyFromPEM(privatePEM); err == nil {
+				key.private, key.public = priv, &priv.PublicKey
+			}
+		} else {
+			key.public, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+		}
+
+	case jwt.SigningMethodEdDSA.Alg():
+		key.method = jwt.SigningMethodEdDSA
+		if len(privatePEM) > 0 {
+			var priv crypto.PrivateKey
+			if priv, err = jwt.ParseEdPrivateKeyFromPEM(privatePEM); err == nil {
+				key.private, key.public = priv, priv.(ed25519.PrivateKey).Public()
+			}
+		} else {
+			key.public, err = jwt.ParseEdPublicKeyFromPEM(publicPEM)
+		}
+
+	case jwt.SigningMethodHS256.Alg():
+		if len(kc.Secret) < 32 {
+			return nil, errors.New("HS256 secret must be at least 32 bytes")
+		}
+		key.method = jwt.SigningMethodHS256
+		key.private, key.public = []byte(kc.Secret), []byte(kc.Secret)
+
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q (use RS256, EdDSA or HS256)", kc.Algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s key: %w", kc.Algorithm, err)
+	}
+	return key, nil
+}
+
+func ephemeralKey() (*signingKey, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	id := make([]byte, 4)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	return &signingKey{
+		id:      "ephemeral-" + hex.EncodeToString(id),
+		method:  jwt.SigningMethodEdDSA,
+		private: priv,
+		public:  pub,
+	}, nil
+}
+
+// GenerateJWT issues an access token for a user, signed with the current key.
+func (ks *KeySet) GenerateJWT(userID int64, email, role string) (string, error) {
+	now := time.Now()
+	claims := &Claims{
+		UserID: userID,
+		Email:  email,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(), // jti: 로그아웃 시 denylist 키
+			Issuer:    ks.issuer,
+			ExpiresAt: jwt.NewNumericDate(now.Add(ks.ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	token := jwt.NewWithClaims(ks.signing.method, claims)
+	token.Header["kid"] = ks.signing.id
+	return token.SignedString(ks.signing.private)
+}
+
+// ValidateJWT verifies a token against the key named by its kid header and
+// returns its claims. The token's alg must match the key's algorithm.
+func (ks *KeySet) ValidateJWT(tokenString string) (*Claims, error) {
+	opts := []jwt.ParserOption{jwt.WithExpirationRequired()}
+	if ks.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(ks.issuer))
+	}
+
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := ks.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+		return key.public, nil
+	}, opts...)
+
+	if err != nil || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+// JWK is one public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`   // RSA modulus
+	E         string `json:"e,omitempty"`   // RSA exponent
+	Curve     string `json:"crv,omitempty"` // OKP curve
+	X         string `json:"x,omitempty"`   // OKP public key
+}
+
+// JWKS is a JSON Web Key Set document.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys other services need to verify our tokens.
+// HMAC keys are shared secrets and are never published.
+func (ks *KeySet) JWKS() JWKS {
+	set := JWKS{Keys: []JWK{}}
+	for _, key := range ks.keys {
+		jwk := JWK{KeyID: key.id, Use: "sig", Algorithm: key.method.Alg()}
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].KeyID < set.Keys[j].KeyID })
+	return set
+}
+
+// JWKSHandler serves JWKS() as application/json, for /.well-known/jwks.json.
+func (ks *KeySet) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// 키 교체가 반영되도록 짧게 캐시
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		json.NewEncoder(w).Encode(ks.JWKS())
+	})
+}
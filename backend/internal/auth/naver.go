@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+const (
+	naverTokenURL    = "https://nid.naver.com/oauth2.0/token"
+	naverUserInfoURL = "https://openapi.naver.com/v1/nid/me"
+)
+
+// NaverProvider signs users in with Naver Login (authorization code flow).
+type NaverProvider struct {
+	oauth oauthClient
+}
+
+var _ Provider = (*NaverProvider)(nil)
+
+// NewNaverProvider creates the provider for the app's client ID and secret.
+func NewNaverProvider(cfg config.OAuthConfig) *NaverProvider {
+	return &NaverProvider{oauth: newOAuthClient(ProviderNaver, cfg, naverTokenURL, naverUserInfoURL)}
+}
+
+func (p *NaverProvider) Name() string { return ProviderNaver }
+
+// naverUser is the /v1/nid/me response. Fields the user did not agree to
+// share are omitted.
+type naverUser struct {
+	ResultCode string `json:"resultcode"`
+	Message    string `json:"message"`
+	Response   struct {
+		ID           string `json:"id"`
+		Email        string `json:"email"`
+		Name         string `json:"name"`
+		Nickname     string `json:"nickname"`
+		ProfileImage string `json:"profile_image"`
+	} `json:"response"`
+}
+
+// Authenticate exchanges cred.Code (with cred.State, which Naver requires)
+// and loads the Naver account.
+func (p *NaverProvider) Authenticate(ctx context.Context, cred Credential) (*Identity, error) {
+	accessToken, err := p.oauth.exchange(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+	var user naverUser
+	if err := p.oauth.userInfo(ctx, accessToken, &user); err != nil {
+		return nil, err
+	}
+	if user.ResultCode != "00" {
+		return nil, fmt.Errorf("naver user info: %s %s", user.ResultCode, user.Message)
+	}
+	if user.Response.ID == "" {
+		return nil, errors.New("naver user info: missing id")
+	}
+
+	name := user.Response.Name
+	if name == "" {
+		name = user.Response.Nickname
+	}
+	return &Identity{
+		Provider: ProviderNaver,
+		Subject:  user.Response.ID,
+		Email:    user.Response.Email,
+		// 연락처 이메일은 사용자가 바꿀 수 있고 소유 확인을 보장하지 않는다.
+		// 네이버가 발급한 @naver.com 주소만 계정 소유가 확인된 것으로 본다.
+		EmailVerified: naverOwnedEmail(user.Response.Email),
+		Name:          name,
+		Picture:       user.Response.ProfileImage,
+	}, nil
+}
+
+// naverOwnedEmail reports whether email is a Naver mailbox, which belongs to
+// the signed-in Naver account.
+func naverOwnedEmail(email string) bool {
+	local, domain, ok := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	return ok && local != "" && domain == "naver.com"
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+// maxOAuthResponse bounds how much of a provider response is read.
+const maxOAuthResponse = 1 << 20
+
+// oauthClient runs the authorization code exchange and the user info call
+// shared by the Kakao and Naver providers.
+type oauthClient struct {
+	provider     string
+	clientID     string
+	clientSecret string
+	redirectURI  string
+	tokenURL     string
+	userInfoURL  string
+	http         *http.Client
+}
+
+func newOAuthClient(provider string, cfg config.OAuthConfig, tokenURL, userInfoURL string) oauthClient {
+	if cfg.TokenURL != "" {
+		tokenURL = cfg.TokenURL
+	}
+	if cfg.UserInfoURL != "" {
+		userInfoURL = cfg.UserInfoURL
+	}
+	return oauthClient{
+		provider:     provider,
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		redirectURI:  cfg.RedirectURI,
+		tokenURL:     tokenURL,
+		userInfoURL:  userInfoURL,
+		http:         &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// tokenResponse covers both providers; on failure Kakao answers 4xx and
+// Naver answers 200, both with error set.
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// exchange trades an authorization code for an access token.
+func (c *oauthClient) exchange(ctx context.Context, cred Credential) (string, error) {
+	if cred.Code == "" {
+		return "", fmt.Errorf("%s: authorization code is required", c.provider)
+	}
+	redirectURI := cred.RedirectURI
+	if redirectURI == "" {
+		redirectURI = c.redirectURI
+	}
+
+	form := url.Values{
+		"grant_type": {"authorization_code"},
+		"client_id":  {c.clientID},
+		"code":       {cred.Code},
+	}
+	if c.clientSecret != "" {
+		form.Set("client_secret", c.clientSecret)
+	}
+	if redirectURI != "" {
+		form.Set("redirect_uri", redirectURI)
+	}
+	if cred.State != "" {
+		form.Set("state", cred.State)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
+
+	var token tokenResponse
+	status, err := c.do(req, &token)
+	if err != nil {
+		return "", fmt.Errorf("%s token exchange: %w", c.provider, err)
+	}
+	if token.Error != "" {
+		return "", fmt.Errorf("%s token exchange: %s: %s", c.provider, token.Error, token.ErrorDescription)
+	}
+	if status != http.StatusOK || token.AccessToken == "" {
+		return "", fmt.Errorf("%s token exchange: status %d without access token", c.provider, status)
+	}
+	return token.AccessToken, nil
+}
+
+// userInfo fetches the profile for accessToken into out.
+func (c *oauthClient) userInfo(ctx context.Context, accessToken string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.userInfoURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	status, err := c.do(req, out)
+	if err != nil {
+		return fmt.Errorf("%s user info: %w", c.provider, err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("%s user info: status %d", c.provider, status)
+	}
+	return nil
+}
+
+// do sends req and decodes a JSON body into out, whatever the status.
+func (c *oauthClient) do(req *http.Request, out interface{}) (int, error) {
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxOAuthResponse))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return resp.StatusCode, errors.New(resp.Status)
+		}
+		return resp.StatusCode, fmt.Errorf("decode response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,132 @@
+package auth_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+// stubOAuth is a local token + user info endpoint. The code "good" is
+// exchanged for the token "access-1", which returns user.
+func stubOAuth(t *testing.T, tokenStatus int, tokenBody, user interface{}) (config.OAuthConfig, *http.Request) {
+	t.Helper()
+	var tokenReq http.Request
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		tokenReq = *r
+		if r.PostForm.Get("code") != "good" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant", "error_description": "authorization code not found"})
+			return
+		}
+		w.WriteHeader(tokenStatus)
+		json.NewEncoder(w).Encode(tokenBody)
+	})
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(user)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return config.OAuthConfig{
+		ClientID:     "client-1",
+		ClientSecret: "secret-1",
+		RedirectURI:  "https://silver-guardian.example/callback",
+		TokenURL:     srv.URL + "/token",
+		UserInfoURL:  srv.URL + "/me",
+	}, &tokenReq
+}
+
+func TestKakaoProvider(t *testing.T) {
+	ctx := context.Background()
+	user := map[string]interface{}{
+		"id": 4012345678,
+		"kakao_account": map[string]interface{}{
+			"email":             "senior@kakao.com",
+			"is_email_valid":    true,
+			"is_email_verified": true,
+			"profile":           map[string]interface{}{"nickname": "김할머니", "profile_image_url": "https://k.kakaocdn.net/p.jpg"},
+		},
+	}
+	cfg, tokenReq := stubOAuth(t, http.StatusOK, map[string]string{"access_token": "access-1", "token_type": "bearer"}, user)
+	kakao := auth.NewKakaoProvider(cfg)
+
+	identity, err := kakao.Authenticate(ctx, auth.Credential{Code: "good"})
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Identity{
+		Provider:      "kakao",
+		Subject:       "4012345678",
+		Email:         "senior@kakao.com",
+		EmailVerified: true,
+		Name:          "김할머니",
+		Picture:       "https://k.kakaocdn.net/p.jpg",
+	}, identity)
+	assert.Equal(t, "authorization_code", tokenReq.PostForm.Get("grant_type"))
+	assert.Equal(t, "client-1", tokenReq.PostForm.Get("client_id"))
+	assert.Equal(t, "secret-1", tokenReq.PostForm.Get("client_secret"))
+	assert.Equal(t, "https://silver-guardian.example/callback", tokenReq.PostForm.Get("redirect_uri"))
+
+	// 요청의 redirect_uri가 설정값보다 우선
+	_, err = kakao.Authenticate(ctx, auth.Credential{Code: "good", RedirectURI: "app://oauth"})
+	require.NoError(t, err)
+	assert.Equal(t, "app://oauth", tokenReq.PostForm.Get("redirect_uri"))
+
+	_, err = kakao.Authenticate(ctx, auth.Credential{Code: "expired"})
+	assert.ErrorContains(t, err, "invalid_grant")
+	_, err = kakao.Authenticate(ctx, auth.Credential{})
+	assert.Error(t, err)
+
+	// 유효하지 않은(휴면) 이메일은 인증되지 않은 것으로 취급
+	user["kakao_account"].(map[string]interface{})["is_email_valid"] = false
+	identity, err = kakao.Authenticate(ctx, auth.Credential{Code: "good"})
+	require.NoError(t, err)
+	assert.False(t, identity.EmailVerified)
+}
+
+func TestNaverProvider(t *testing.T) {
+	ctx := context.Background()
+	user := map[string]interface{}{
+		"resultcode": "00",
+		"message":    "success",
+		"response":   map[string]interface{}{"id": "naver-abc", "email": "senior@naver.com", "nickname": "할머니"},
+	}
+	cfg, tokenReq := stubOAuth(t, http.StatusOK, map[string]string{"access_token": "access-1"}, user)
+	naver := auth.NewNaverProvider(cfg)
+
+	identity, err := naver.Authenticate(ctx, auth.Credential{Code: "good", State: "xyz"})
+	require.NoError(t, err)
+	assert.Equal(t, "naver", identity.Provider)
+	assert.Equal(t, "naver-abc", identity.Subject)
+	assert.True(t, identity.EmailVerified)
+	assert.Equal(t, "할머니", identity.Name)
+	assert.Equal(t, "xyz", tokenReq.PostForm.Get("state"))
+
+	// 네이버 메일이 아닌 연락처 이메일은 소유 확인이 안 된 것으로 취급 (계정 자동 연결 안 함)
+	for email, verified := range map[string]bool{"Senior@Naver.com": true, "senior@gmail.com": false, "senior@naver.com.evil.kr": false, "": false} {
+		user["response"].(map[string]interface{})["email"] = email
+		identity, err = naver.Authenticate(ctx, auth.Credential{Code: "good", State: "xyz"})
+		require.NoError(t, err)
+		assert.Equal(t, verified, identity.EmailVerified, email)
+	}
+
+	user["resultcode"], user["message"] = "024", "Authentication failed"
+	_, err = naver.Authenticate(ctx, auth.Credential{Code: "good", State: "xyz"})
+	assert.ErrorContains(t, err, "024")
+
+	// 네이버는 실패해도 200으로 error를 돌려줌
+	cfg, _ = stubOAuth(t, http.StatusOK, map[string]string{"error": "invalid_request", "error_description": "no valid data in session key"}, user)
+	_, err = auth.NewNaverProvider(cfg).Authenticate(ctx, auth.Credential{Code: "good", State: "xyz"})
+	assert.ErrorContains(t, err, "invalid_request")
+}
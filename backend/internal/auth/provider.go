@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// Login provider names, stored in user_identities.provider.
+const (
+	ProviderGoogle = "google"
+	ProviderKakao  = "kakao"
+	ProviderNaver  = "naver"
+)
+
+// ErrEmailNotVerified is returned for accounts whose email the provider
+// has not verified.
+var ErrEmailNotVerified = errors.New("account email is not verified")
+
+// Identity is an account verified by a login provider.
+type Identity struct {
+	Provider      string
+	Subject       string // account ID at the provider, stable across email changes
+	Email         string // may be empty if the user declined to share it
+	EmailVerified bool
+	Name          string // may be empty
+	Picture       string // may be empty
+}
+
+// Credential is what the client obtained from the provider's login flow:
+// an ID token (Google) or an OAuth authorization code (Kakao, Naver).
+type Credential struct {
+	IDToken     string
+	Code        string
+	RedirectURI string // must match the one used for the authorization request
+	State       string
+}
+
+// Provider verifies a login credential and returns the account behind it.
+type Provider interface {
+	Name() string
+	Authenticate(ctx context.Context, cred Credential) (*Identity, error)
+}
+
+// ProviderRegistry holds the login providers the server accepts.
+type ProviderRegistry struct {
+	providers map[string]Provider
+}
+
+// NewProviderRegistry creates a registry with the given providers.
+func NewProviderRegistry(providers ...Provider) *ProviderRegistry {
+	r := &ProviderRegistry{providers: make(map[string]Provider)}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register adds a provider, replacing any with the same name.
+func (r *ProviderRegistry) Register(p Provider) {
+	r.providers[p.Name()] = p
+}
+
+// Get returns the provider with the given name.
+func (r *ProviderRegistry) Get(name string) (Provider, bool) {
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// Names returns the registered provider names, sorted.
+func (r *ProviderRegistry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,54 @@
+package auth
+
+// User roles, stored in users.role and carried in the access token.
+const (
+	RoleUser     = "user"
+	RoleGuardian = "guardian" // 보호자 계정 (가족 연결 권한은 family_links로 관리)
+	RoleReviewer = "reviewer"
+	RoleAdmin    = "admin"
+)
+
+// Permission is an operation that needs more than a logged-in user.
+type Permission string
+
+const (
+	PermViewUsers           Permission = "users:view"
+	PermManageRoles         Permission = "users:manage_roles"
+	PermReanalyze           Permission = "analysis:reanalyze"
+	PermOverrideVerdict     Permission = "analysis:override_verdict"
+	PermManageAPIKeys       Permission = "api_keys:manage"
+	PermManageSubscriptions Permission = "subscriptions:manage"
+)
+
+// rolePermissions: admin은 모든 권한을 가지므로 목록에 없다.
+var rolePermissions = map[string][]Permission{
+	RoleReviewer: {PermViewUsers, PermReanalyze, PermOverrideVerdict},
+}
+
+// ValidRole reports whether role is one of the known roles.
+func ValidRole(role string) bool {
+	switch role {
+	case RoleUser, RoleGuardian, RoleReviewer, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// HasPermission reports whether role grants p.
+func HasPermission(role string, p Permission) bool {
+	if role == RoleAdmin {
+		return true
+	}
+	for _, granted := range rolePermissions[role] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Can reports whether the token's role grants p. Tokens issued before
+// roles existed have no role and are treated as RoleUser.
+func (c *Claims) Can(p Permission) bool {
+	return c != nil && HasPermission(c.Role, p)
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+)
+
+const defaultRefreshTTL = 30 * 24 * time.Hour
+
+// TokenService issues short-lived access tokens and opaque refresh tokens,
+// and checks access tokens against the denylist.
+type TokenService struct {
+	keys       *KeySet
+	denylist   Denylist
+	refreshTTL time.Duration
+}
+
+// NewTokenService creates a token service; a zero refreshTTL uses 30 days.
+func NewTokenService(keys *KeySet, denylist Denylist, refreshTTL time.Duration) *TokenService {
+	if refreshTTL <= 0 {
+		refreshTTL = defaultRefreshTTL
+	}
+	return &TokenService{keys: keys, denylist: denylist, refreshTTL: refreshTTL}
+}
+
+// AccessTokenTTL is how long issued access tokens stay valid.
+func (s *TokenService) AccessTokenTTL() time.Duration {
+	return s.keys.ttl
+}
+
+// AccessToken issues an access token for a user with the given role.
+func (s *TokenService) AccessToken(userID int64, email, role string) (string, error) {
+	return s.keys.GenerateJWT(userID, email, role)
+}
+
+// Validate verifies an access token and rejects revoked ones. If the
+// denylist is unreachable the token is accepted: access tokens are short
+// lived, and failing closed would log out every user during an outage.
+func (s *TokenService) Validate(ctx context.Context, token string) (*Claims, error) {
+	claims, err := s.keys.ValidateJWT(token)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := s.denylist.IsRevoked(ctx, claims)
+	if err != nil {
+		log.Printf("Token denylist check failed (allowing jti %s): %v", claims.ID, err)
+		return claims, nil
+	}
+	if revoked {
+		return nil, errors.New("token revoked")
+	}
+	return claims, nil
+}
+
+// RevokeAccessToken denylists one access token until it expires.
+func (s *TokenService) RevokeAccessToken(ctx context.Context, claims *Claims) error {
+	var expiresAt time.Time
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+	return s.denylist.RevokeToken(ctx, claims.ID, expiresAt)
+}
+
+// RevokeUser denylists every access token issued to a user so far.
+func (s *TokenService) RevokeUser(ctx context.Context, userID int64) error {
+	return s.denylist.RevokeUser(ctx, userID, time.Now(), s.keys.ttl)
+}
+
+// RefreshToken is a newly generated refresh token. Token goes to the client;
+// only Hash is stored.
+type RefreshToken struct {
+	Token     string
+	Hash      string
+	ExpiresAt time.Time
+}
+
+// NewRefreshToken generates a random refresh token.
+func (s *TokenService) NewRefreshToken() (*RefreshToken, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	return &RefreshToken{
+		Token:     token,
+		Hash:      HashRefreshToken(token),
+		ExpiresAt: time.Now().Add(s.refreshTTL),
+	}, nil
+}
+
+// HashRefreshToken returns the hex SHA-256 of a refresh token, the form it
+// is stored and looked up in.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,54 @@
+package billing
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+// ErrNotCancelable is returned when canceling a subscription that is not an
+// active paid one.
+var ErrNotCancelable = errors.New("billing: no active paid subscription to cancel")
+
+// Activate starts or renews a paid plan until periodEnd, or indefinitely if
+// periodEnd is zero. Renewing the current plan keeps its start date.
+func Activate(sub *storage.Subscription, plan string, periodEnd time.Time, billingRef string, now time.Time) {
+	if sub.PlanType != plan || sub.Status == storage.SubscriptionExpired || !sub.StartDate.Valid {
+		sub.StartDate = sql.NullTime{Time: now, Valid: true}
+	}
+	sub.PlanType = plan
+	sub.Status = storage.SubscriptionActive
+	sub.EndDate = sql.NullTime{Time: periodEnd, Valid: !periodEnd.IsZero()}
+	sub.CanceledAt = sql.NullTime{}
+	if billingRef != "" {
+		sub.BillingRef = billingRef
+	}
+}
+
+// Cancel stops a paid plan from renewing. It stays usable until its end
+// date; a plan without one ends now.
+func Cancel(sub *storage.Subscription, now time.Time) error {
+	if sub.PlanType == config.DefaultPlan || sub.Status != storage.SubscriptionActive {
+		return ErrNotCancelable
+	}
+	if !sub.EndDate.Valid {
+		Expire(sub, now)
+		return nil
+	}
+	sub.Status = storage.SubscriptionCanceled
+	sub.CanceledAt = sql.NullTime{Time: now, Valid: true}
+	return nil
+}
+
+// Expire moves a subscription back to the free plan at now. The end date
+// records when the paid plan ended.
+func Expire(sub *storage.Subscription, now time.Time) {
+	if !sub.EndDate.Valid || sub.EndDate.Time.After(now) {
+		sub.EndDate = sql.NullTime{Time: now, Valid: true}
+	}
+	sub.PlanType = config.DefaultPlan
+	sub.Status = storage.SubscriptionExpired
+}
@@ -0,0 +1,81 @@
+// Package billing manages subscription plans: the lifecycle transitions
+// (upgrade, cancel, expiry) and the signed webhooks a billing provider
+// sends when a payment changes a plan.
+package billing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader carries the webhook signature:
+//
+//	t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">
+//
+// Several v1 values may be sent while the provider rotates its secret.
+const SignatureHeader = "X-Billing-Signature"
+
+var (
+	ErrInvalidSignature = errors.New("billing: invalid webhook signature")
+	ErrStaleSignature   = errors.New("billing: webhook signature timestamp outside tolerance")
+)
+
+// Sign returns the signature header value for body sent at t. The provider
+// does this on its side; we use it for tests and `server billing sign`.
+func Sign(secret, body []byte, t time.Time) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", ts, signature(secret, ts, body))
+}
+
+// VerifySignature checks header against body. The signed timestamp must be
+// within tolerance of now so a captured request cannot be replayed later.
+func VerifySignature(secret []byte, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts string
+	var sigs []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			sigs = append(sigs, value)
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return ErrInvalidSignature
+	}
+
+	expected := signature(secret, ts, body)
+	valid := false
+	for _, sig := range sigs {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			valid = true
+		}
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	if skew := now.Sub(time.Unix(unix, 0)); skew > tolerance || skew < -tolerance {
+		return ErrStaleSignature
+	}
+	return nil
+}
+
+func signature(secret []byte, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
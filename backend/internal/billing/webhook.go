@@ -0,0 +1,220 @@
+package billing
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+// Webhook event types sent by the billing provider.
+const (
+	EventActivated = "subscription.activated" // 신규 결제/업그레이드
+	EventRenewed   = "subscription.renewed"   // 정기 결제 갱신
+	EventCanceled  = "subscription.canceled"  // 해지 예약 (기간 끝까지 유지)
+	EventExpired   = "subscription.expired"   // 결제 실패/환불 등으로 즉시 종료
+)
+
+const (
+	defaultWebhookTolerance = 5 * time.Minute
+	maxWebhookBody          = 1 << 20
+)
+
+// Event is a billing webhook payload.
+type Event struct {
+	ID   string    `json:"id"`
+	Type string    `json:"type"`
+	Data EventData `json:"data"`
+}
+
+// EventData describes the subscription an event is about.
+type EventData struct {
+	UserID           int64     `json:"user_id"` // 결제 시 고객 참조로 넘긴 사용자 ID
+	Plan             string    `json:"plan"`
+	SubscriptionID   string    `json:"subscription_id"`
+	CurrentPeriodEnd time.Time `json:"current_period_end"` // RFC3339
+}
+
+// Results reported in the webhook response body.
+const (
+	resultApplied   = "applied"
+	resultIgnored   = "ignored"   // 알 수 없는 이벤트, 이미 반영된 상태 등
+	resultDuplicate = "duplicate" // 같은 이벤트 ID를 이미 처리함
+)
+
+// errUnprocessable marks events that are signed correctly but cannot be
+// applied (unknown user or plan); retrying them will not help.
+var errUnprocessable = errors.New("unprocessable event")
+
+// WebhookHandler applies signed billing events to subscriptions. Each event
+// is recorded with the change it makes in one transaction, so redelivered
+// events are applied once and failed ones can be retried.
+type WebhookHandler struct {
+	store     storage.Store
+	secret    []byte
+	plans     config.PlansConfig
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// NewWebhookHandler creates the handler for POST /webhooks/billing.
+func NewWebhookHandler(store storage.Store, cfg config.BillingConfig, plans config.PlansConfig) *WebhookHandler {
+	tolerance := time.Duration(cfg.WebhookToleranceSeconds) * time.Second
+	if tolerance <= 0 {
+		tolerance = defaultWebhookTolerance
+	}
+	return &WebhookHandler{
+		store:     store,
+		secret:    []byte(cfg.WebhookSecret),
+		plans:     plans,
+		tolerance: tolerance,
+		now:       time.Now,
+	}
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err := VerifySignature(h.secret, r.Header.Get(SignatureHeader), body, h.now(), h.tolerance); err != nil {
+		log.Printf("Rejected billing webhook: %v", err)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil || event.ID == "" || event.Type == "" {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.Handle(r.Context(), &event)
+	if errors.Is(err, errUnprocessable) {
+		log.Printf("Billing event %s (%s) not applied: %v", event.ID, event.Type, err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	if err != nil {
+		// 결제사가 재전송하도록 5xx
+		log.Printf("Failed to apply billing event %s (%s): %v", event.ID, event.Type, err)
+		http.Error(w, "failed to apply event", http.StatusInternalServerError)
+		return
+	}
+	log.Printf("Billing event %s (%s) for user %d: %s", event.ID, event.Type, event.Data.UserID, result)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": result})
+}
+
+// Handle applies a verified event and reports what happened to it.
+func (h *WebhookHandler) Handle(ctx context.Context, event *Event) (string, error) {
+	known := event.Type == EventActivated || event.Type == EventRenewed ||
+		event.Type == EventCanceled || event.Type == EventExpired
+	if known {
+		if err := h.validate(event); err != nil {
+			return "", err
+		}
+	}
+
+	var result string
+	err := h.store.WithTx(ctx, func(tx storage.Store) error {
+		var userID sql.NullInt64
+		if known {
+			if _, err := tx.GetUserByID(ctx, event.Data.UserID); errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w: user %d not found", errUnprocessable, event.Data.UserID)
+			} else if err != nil {
+				return err
+			}
+			userID = sql.NullInt64{Int64: event.Data.UserID, Valid: true}
+		}
+
+		recorded, err := tx.RecordBillingEvent(ctx, &storage.BillingEvent{EventID: event.ID, Type: event.Type, UserID: userID})
+		if err != nil {
+			return fmt.Errorf("record event: %w", err)
+		}
+		if !recorded {
+			result = resultDuplicate
+			return nil
+		}
+		if !known {
+			result = resultIgnored
+			return nil
+		}
+
+		sub, err := tx.GetSubscription(ctx, event.Data.UserID)
+		if err != nil {
+			return fmt.Errorf("load subscription: %w", err)
+		}
+		if !h.apply(sub, event) {
+			result = resultIgnored
+			return nil
+		}
+		if err := tx.SaveSubscription(ctx, sub); err != nil {
+			return fmt.Errorf("save subscription: %w", err)
+		}
+		result = resultApplied
+		return nil
+	})
+	return result, err
+}
+
+// validate checks the fields a known event type needs.
+func (h *WebhookHandler) validate(event *Event) error {
+	if event.Data.UserID <= 0 {
+		return fmt.Errorf("%w: user_id is required", errUnprocessable)
+	}
+	if event.Type == EventActivated || event.Type == EventRenewed {
+		if event.Data.Plan == "" || event.Data.Plan == config.DefaultPlan {
+			return fmt.Errorf("%w: paid plan is required", errUnprocessable)
+		}
+		if _, ok := h.plans[event.Data.Plan]; len(h.plans) > 0 && !ok {
+			return fmt.Errorf("%w: unknown plan %q", errUnprocessable, event.Data.Plan)
+		}
+		if event.Data.CurrentPeriodEnd.IsZero() {
+			return fmt.Errorf("%w: current_period_end is required", errUnprocessable)
+		}
+	}
+	return nil
+}
+
+// apply changes sub for the event and reports false if there was nothing
+// to change. Cancel/expire events for a subscription other than the
+// current one (e.g. the plan before an upgrade) are ignored.
+func (h *WebhookHandler) apply(sub *storage.Subscription, event *Event) bool {
+	now := h.now()
+	otherSubscription := event.Data.SubscriptionID != "" && sub.BillingRef != "" && event.Data.SubscriptionID != sub.BillingRef
+
+	switch event.Type {
+	case EventActivated, EventRenewed:
+		Activate(sub, event.Data.Plan, event.Data.CurrentPeriodEnd, event.Data.SubscriptionID, now)
+	case EventCanceled:
+		if otherSubscription || Cancel(sub, now) != nil {
+			return false
+		}
+		if !event.Data.CurrentPeriodEnd.IsZero() && sub.Status == storage.SubscriptionCanceled {
+			sub.EndDate = sql.NullTime{Time: event.Data.CurrentPeriodEnd, Valid: true}
+		}
+	case EventExpired:
+		if otherSubscription || sub.PlanType == config.DefaultPlan {
+			return false
+		}
+		Expire(sub, now)
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+const testSecret = "whsec_test"
+
+func TestVerifySignature(t *testing.T) {
+	now := time.Unix(1714550400, 0)
+	body := []byte(`{"id":"evt_1"}`)
+	header := Sign([]byte(testSecret), body, now)
+
+	assert.NoError(t, VerifySignature([]byte(testSecret), header, body, now.Add(time.Minute), 5*time.Minute))
+	// 키 교체 중에는 서명이 여러 개
+	assert.NoError(t, VerifySignature([]byte(testSecret), header+",v1=deadbeef", body, now, time.Minute))
+
+	assert.ErrorIs(t, VerifySignature([]byte("other"), header, body, now, time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifySignature([]byte(testSecret), header, []byte(`{"id":"evt_2"}`), now, time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifySignature([]byte(testSecret), "", body, now, time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifySignature([]byte(testSecret), header, body, now.Add(10*time.Minute), 5*time.Minute), ErrStaleSignature)
+}
+
+// postEvent: 로컬에서 서명한 웹훅을 보내고 상태 코드와 결과를 반환
+func postEvent(t *testing.T, h *WebhookHandler, event interface{}, secret string) (int, string) {
+	body, err := json.Marshal(event)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/billing", strings.NewReader(string(body)))
+	req.Header.Set(SignatureHeader, Sign([]byte(secret), body, h.now()))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp struct{ Status string }
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	return rec.Code, resp.Status
+}
+
+func TestWebhookHandler(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "")
+	require.NoError(t, err)
+
+	plans := config.PlansConfig{"free": {}, "pro": {Batch: true}}
+	h := NewWebhookHandler(store, config.BillingConfig{WebhookSecret: testSecret}, plans)
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+	periodEnd := now.AddDate(0, 1, 0)
+
+	activated := Event{ID: "evt_1", Type: EventActivated, Data: EventData{
+		UserID: user.ID, Plan: "pro", SubscriptionID: "sub_1", CurrentPeriodEnd: periodEnd,
+	}}
+	code, _ := postEvent(t, h, activated, "wrong")
+	assert.Equal(t, http.StatusUnauthorized, code)
+
+	code, result := postEvent(t, h, activated, testSecret)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, resultApplied, result)
+	sub, err := store.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", sub.PlanType)
+	assert.Equal(t, storage.SubscriptionActive, sub.Status)
+	assert.True(t, periodEnd.Equal(sub.EndDate.Time))
+	assert.Equal(t, "sub_1", sub.BillingRef)
+
+	// 재전송된 이벤트는 한 번만 반영
+	code, result = postEvent(t, h, activated, testSecret)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, resultDuplicate, result)
+
+	// 갱신은 시작일 유지, 종료일 연장
+	now = now.AddDate(0, 1, 0)
+	renewed := Event{ID: "evt_2", Type: EventRenewed, Data: EventData{
+		UserID: user.ID, Plan: "pro", SubscriptionID: "sub_1", CurrentPeriodEnd: periodEnd.AddDate(0, 1, 0),
+	}}
+	code, _ = postEvent(t, h, renewed, testSecret)
+	assert.Equal(t, http.StatusOK, code)
+	sub, err = store.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.True(t, sub.StartDate.Time.Equal(now.AddDate(0, -1, 0)))
+	assert.True(t, periodEnd.AddDate(0, 1, 0).Equal(sub.EndDate.Time))
+
+	// 다른 구독의 해지는 무시, 현재 구독의 해지는 기간 끝까지 유지
+	code, result = postEvent(t, h, Event{ID: "evt_3", Type: EventCanceled, Data: EventData{UserID: user.ID, SubscriptionID: "sub_0"}}, testSecret)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, resultIgnored, result)
+	code, result = postEvent(t, h, Event{ID: "evt_4", Type: EventCanceled, Data: EventData{UserID: user.ID, SubscriptionID: "sub_1"}}, testSecret)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, resultApplied, result)
+	sub, err = store.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", sub.PlanType)
+	assert.Equal(t, storage.SubscriptionCanceled, sub.Status)
+
+	code, result = postEvent(t, h, Event{ID: "evt_5", Type: EventExpired, Data: EventData{UserID: user.ID, SubscriptionID: "sub_1"}}, testSecret)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, resultApplied, result)
+	sub, err = store.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "free", sub.PlanType)
+	assert.Equal(t, storage.SubscriptionExpired, sub.Status)
+	assert.True(t, now.Equal(sub.EndDate.Time))
+
+	// 알 수 없는 이벤트는 기록만, 알 수 없는 사용자/요금제는 422 (기록하지 않음)
+	code, result = postEvent(t, h, Event{ID: "evt_6", Type: "invoice.paid"}, testSecret)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, resultIgnored, result)
+	code, _ = postEvent(t, h, Event{ID: "evt_7", Type: EventActivated, Data: EventData{UserID: user.ID + 100, Plan: "pro", CurrentPeriodEnd: periodEnd}}, testSecret)
+	assert.Equal(t, http.StatusUnprocessableEntity, code)
+	code, _ = postEvent(t, h, Event{ID: "evt_8", Type: EventActivated, Data: EventData{UserID: user.ID, Plan: "enterprise", CurrentPeriodEnd: periodEnd}}, testSecret)
+	assert.Equal(t, http.StatusUnprocessableEntity, code)
+	recorded, err := store.RecordBillingEvent(ctx, &storage.BillingEvent{EventID: "evt_7", Type: EventActivated})
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	code, _ = postEvent(t, h, map[string]string{"type": EventActivated}, testSecret)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhooks/billing", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestCancel(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	free := &storage.Subscription{PlanType: "free", Status: storage.SubscriptionActive}
+	assert.ErrorIs(t, Cancel(free, now), ErrNotCancelable)
+
+	// 종료일 없는 요금제(관리자 지급)는 즉시 종료
+	granted := &storage.Subscription{}
+	Activate(granted, "pro", time.Time{}, "", now)
+	assert.False(t, granted.EndDate.Valid)
+	require.NoError(t, Cancel(granted, now))
+	assert.Equal(t, "free", granted.PlanType)
+	assert.True(t, now.Equal(granted.EndDate.Time))
+
+	paid := &storage.Subscription{}
+	Activate(paid, "pro", now.AddDate(0, 1, 0), "sub_1", now)
+	require.NoError(t, Cancel(paid, now))
+	assert.Equal(t, storage.SubscriptionCanceled, paid.Status)
+	assert.Equal(t, "pro", paid.PlanType)
+	assert.ErrorIs(t, Cancel(paid, now), ErrNotCancelable)
+}
@@ -3,33 +3,80 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Database DatabaseConfig `yaml:"database"`
-	Redis    RedisConfig    `yaml:"redis"`    // [복구] Redis 필드 추가
-	YouTube  YouTubeConfig  `yaml:"youtube"`
-	Gemini   GeminiConfig   `yaml:"gemini"`
+	Server    ServerConfig    `yaml:"server"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Redis     RedisConfig     `yaml:"redis"` // [복구] Redis 필드 추가
+	YouTube   YouTubeConfig   `yaml:"youtube"`
+	Gemini    GeminiConfig    `yaml:"gemini"`
+	Batch     BatchConfig     `yaml:"batch"`
+	Live      LiveConfig      `yaml:"live"`
+	Watchlist WatchlistConfig `yaml:"watchlist"`
+	Retention RetentionConfig `yaml:"retention"`
+	Plans     PlansConfig     `yaml:"plans"` // 요금제(subscriptions.plan_type)별 이용 한도
+	Billing   BillingConfig   `yaml:"billing"`
+	JWT       JWTConfig       `yaml:"jwt"`
+	Google    GoogleConfig    `yaml:"google"`
+	Kakao     OAuthConfig     `yaml:"kakao"`
+	Naver     OAuthConfig     `yaml:"naver"`
 }
 
 type ServerConfig struct {
-	GRPCPort int    `yaml:"grpc_port"` // [수정] Port -> GRPCPort (app.go와 일치)
-	Env      string `yaml:"env"`
+	GRPCPort  int    `yaml:"grpc_port"` // [수정] Port -> GRPCPort (app.go와 일치)
+	Env       string `yaml:"env"`
+	DebugAddr string `yaml:"debug_addr"` // /debug/vars(expvar) 전용 내부 주소, 비우면 비활성화
+	// 연결 주소가 이 목록(CIDR 또는 IP)에 있을 때만 x-forwarded-for를 믿는다. 비우면 항상 연결 주소 사용
+	TrustedProxies []string `yaml:"trusted_proxies"`
 }
 
 type DatabaseConfig struct {
-	Host               string `yaml:"host"`
-	Port               int    `yaml:"port"`
-	Name               string `yaml:"name"`
-	User               string `yaml:"user"`
-	Password           string `yaml:"password"`
-	SSLMode            string `yaml:"sslmode"`
-	MaxConnections     int    `yaml:"max_connections"`
-	MaxIdleConnections int    `yaml:"max_idle_connections"`
+	Host                   string `yaml:"host"`
+	Port                   int    `yaml:"port"`
+	Name                   string `yaml:"name"`
+	User                   string `yaml:"user"`
+	Password               string `yaml:"password"`
+	SSLMode                string `yaml:"sslmode"`
+	MaxConnections         int    `yaml:"max_connections"`
+	MaxIdleConnections     int    `yaml:"max_idle_connections"`
+	ConnMaxLifetimeMinutes int    `yaml:"conn_max_lifetime_minutes"`  // 커넥션 최대 수명 (DB 페일오버/PgBouncer 재배치 대응)
+	ConnMaxIdleTimeMinutes int    `yaml:"conn_max_idle_time_minutes"` // 유휴 커넥션 정리 시간
+	ConnectTimeoutSeconds  int    `yaml:"connect_timeout_seconds"`    // 시작 시 Postgres가 뜰 때까지 재시도할 최대 시간
+	AutoMigrate            bool   `yaml:"auto_migrate"`               // 서버 시작 시 마이그레이션 적용
+}
+
+// DSN: lib/pq 접속 문자열
+// 값은 작은따옴표로 감싸 비밀번호에 공백/특수문자가 있거나 비어 있어도 안전하게 전달한다.
+func (c DatabaseConfig) DSN() string {
+	pairs := []struct{ key, value string }{
+		{"host", c.Host},
+		{"port", strconv.Itoa(c.Port)},
+		{"user", c.User},
+		{"password", c.Password},
+		{"dbname", c.Name},
+		{"sslmode", c.SSLMode},
+	}
+
+	parts := make([]string, 0, len(pairs))
+	for _, p := range pairs {
+		if p.value == "" || (p.key == "port" && c.Port == 0) {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", p.key, quoteDSNValue(p.value)))
+	}
+	return strings.Join(parts, " ")
+}
+
+func quoteDSNValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `'`, `\'`)
+	return "'" + v + "'"
 }
 
 type RedisConfig struct {
@@ -39,7 +86,9 @@ type RedisConfig struct {
 }
 
 type YouTubeConfig struct {
-	APIKey string `yaml:"api_key"`
+	APIKey           string `yaml:"api_key"`
+	MirrorThumbnails bool   `yaml:"mirror_thumbnails"` // 썸네일을 S3에 복사 (영상 삭제 후에도 기록 유지)
+	ThumbnailBaseURL string `yaml:"thumbnail_base_url"` // 미러링한 썸네일을 제공하는 공개 URL (CloudFront, 버킷은 비공개)
 }
 
 type GeminiConfig struct {
@@ -47,6 +96,121 @@ type GeminiConfig struct {
 	Model  string `yaml:"model"`
 }
 
+// BatchConfig: 재생목록/채널 일괄 분석 제한
+type BatchConfig struct {
+	MaxVideos   int `yaml:"max_videos"`  // 배치당 최대 영상 수
+	Concurrency int `yaml:"concurrency"` // 동시에 분석할 자식 Job 수
+}
+
+// LiveConfig: 라이브 방송 모니터링 설정
+type LiveConfig struct {
+	ScanIntervalSeconds int `yaml:"scan_interval_seconds"` // 재분석 주기
+	MaxDurationMinutes  int `yaml:"max_duration_minutes"`  // 최대 모니터링 시간
+	IdleTimeoutSeconds  int `yaml:"idle_timeout_seconds"`  // 시청자(구독자)가 없을 때 종료까지 대기 시간
+}
+
+// WatchlistConfig: 구독 채널 새 업로드 스캔 설정
+type WatchlistConfig struct {
+	Enabled          bool `yaml:"enabled"`
+	IntervalMinutes  int  `yaml:"interval_minutes"`    // 스캔 주기
+	MaxVideosPerScan int  `yaml:"max_videos_per_scan"` // 채널당 한 번에 확인할 최근 업로드 수
+	AlertBelowScore  int  `yaml:"alert_below_score"`   // 이 점수 미만이면 알림 기록
+}
+
+// RetentionConfig: 데이터 종류별 보관 기간 (일 단위, 0이면 영구 보관)
+// Enabled는 백그라운드 정리 작업만 제어하고, `server purge`는 항상 실행된다.
+type RetentionConfig struct {
+	Enabled       bool `yaml:"enabled"`
+	IntervalHours int  `yaml:"interval_hours"` // 정리 주기
+	BatchSize     int  `yaml:"batch_size"`     // 한 번에 삭제할 행 수
+	CaptionsDays  int  `yaml:"captions_days"`
+	CommentsDays  int  `yaml:"comments_days"` // 댓글은 타인의 개인정보라 짧게 보관
+	UploadsDays   int  `yaml:"uploads_days"`  // S3 객체와 함께 삭제 (분석 Job/결과 포함)
+}
+
+// PlanConfig: 요금제 하나의 이용 한도
+type PlanConfig struct {
+	AnalysesPerDay int  `yaml:"analyses_per_day"` // 하루 분석 영상 수, 일괄 분석 포함 (0이면 무제한)
+	MaxComments    int  `yaml:"max_comments"`     // 분석할 댓글 수 상한 (0이면 요청대로)
+	Batch          bool `yaml:"batch"`            // 재생목록/채널 일괄 분석
+	Watchlist      bool `yaml:"watchlist"`        // 채널 구독 알림
+	Priority       bool `yaml:"priority"`         // 일괄 분석을 batch.concurrency만큼 동시에 (아니면 한 편씩)
+}
+
+// PlansConfig: 요금제 이름 → 한도
+type PlansConfig map[string]PlanConfig
+
+// DefaultPlan: 구독이 없거나 만료된 사용자, 비회원의 요금제
+const DefaultPlan = "free"
+
+// Plan: 요금제 한도. 설정에 없는 요금제는 free를 따르고,
+// 요금제를 하나도 설정하지 않으면 제한 없이 모든 기능을 허용한다.
+func (p PlansConfig) Plan(name string) PlanConfig {
+	if len(p) == 0 {
+		return PlanConfig{Batch: true, Watchlist: true, Priority: true}
+	}
+	if plan, ok := p[name]; ok {
+		return plan
+	}
+	return p[DefaultPlan]
+}
+
+// BillingConfig: 결제사 웹훅과 구독 만료 처리
+type BillingConfig struct {
+	WebhookSecret           string `yaml:"webhook_secret"`            // HMAC-SHA256 서명 키 (비어 있으면 웹훅 비활성화)
+	WebhookToleranceSeconds int    `yaml:"webhook_tolerance_seconds"` // 서명 시각 허용 오차 (재전송 방지, 기본 300초)
+	ExpiryIntervalMinutes   int    `yaml:"expiry_interval_minutes"`   // 기간이 끝난 유료 구독을 free로 돌리는 주기 (기본 60분)
+}
+
+// JWTConfig: 자체 발급 토큰 서명/검증 키
+// 키 교체 시 새 키를 추가하고 signing_key_id를 바꾼 뒤, 이전 키는 기존 토큰이
+// 만료될 때까지 검증용으로 남겨둔다.
+type JWTConfig struct {
+	Issuer         string         `yaml:"issuer"`
+	TTLMinutes     int            `yaml:"ttl_minutes"`      // 액세스 토큰 유효 시간 (기본 15분)
+	RefreshTTLDays int            `yaml:"refresh_ttl_days"` // 리프레시 토큰 유효 기간 (기본 30일)
+	SigningKeyID   string         `yaml:"signing_key_id"`   // 새 토큰을 서명할 키 (kid)
+	Keys           []JWTKeyConfig `yaml:"keys"`
+}
+
+// JWTKeyConfig: 서명 키 하나. 비밀 값은 인라인(환경변수) 또는 PEM 파일로 지정
+type JWTKeyConfig struct {
+	ID             string `yaml:"id"`               // JWT 헤더의 kid
+	Algorithm      string `yaml:"algorithm"`        // RS256, EdDSA, HS256
+	Secret         string `yaml:"secret"`           // HS256 전용
+	PrivateKey     string `yaml:"private_key"`      // PEM
+	PrivateKeyFile string `yaml:"private_key_file"` // PEM 파일 경로
+	PublicKeyFile  string `yaml:"public_key_file"`  // 검증 전용 (교체되어 서명에 쓰지 않는 키)
+}
+
+// GoogleConfig: 구글 로그인 (앱마다 OAuth 클라이언트 ID가 다르므로 모두 허용 목록에 등록)
+type GoogleConfig struct {
+	WebClientID     string `yaml:"web_client_id"`
+	AndroidClientID string `yaml:"android_client_id"`
+	IOSClientID     string `yaml:"ios_client_id"`
+	CertsURL        string `yaml:"certs_url"` // 비우면 구글 공개 키 주소 (테스트/에뮬레이터용)
+}
+
+// ClientIDs: 설정된(비어 있지 않은) 클라이언트 ID 목록
+func (c GoogleConfig) ClientIDs() []string {
+	var ids []string
+	for _, id := range []string{c.WebClientID, c.AndroidClientID, c.IOSClientID} {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// OAuthConfig: 카카오/네이버 로그인 (인가 코드 방식). client_id가 비어 있으면 비활성화
+type OAuthConfig struct {
+	ClientID     string `yaml:"client_id"` // 카카오: REST API 키
+	ClientSecret string `yaml:"client_secret"`
+	RedirectURI  string `yaml:"redirect_uri"`  // 요청에 redirect_uri가 없을 때 사용
+	TokenURL     string `yaml:"token_url"`     // 비우면 공식 주소 (테스트용)
+	UserInfoURL  string `yaml:"user_info_url"` // 비우면 공식 주소 (테스트용)
+}
+
 // Load loads config from path
 func Load(path string) (*Config, error) {
 	// 1. .env 로드
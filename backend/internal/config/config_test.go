@@ -0,0 +1,39 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseConfigDSN(t *testing.T) {
+	cfg := DatabaseConfig{
+		Host:     "db",
+		Port:     5432,
+		Name:     "silver",
+		User:     "guardian",
+		Password: `p@ss word'\`,
+		SSLMode:  "disable",
+	}
+	assert.Equal(t, `host='db' port='5432' user='guardian' password='p@ss word\'\\' dbname='silver' sslmode='disable'`, cfg.DSN())
+
+	// 비어 있는 값은 생략 (lib/pq 기본값 사용)
+	assert.Equal(t, `host='localhost' dbname='silver'`, DatabaseConfig{Host: "localhost", Name: "silver"}.DSN())
+}
+
+func TestPlansConfig(t *testing.T) {
+	plans := PlansConfig{
+		"free": {AnalysesPerDay: 5, MaxComments: 10},
+		"pro":  {MaxComments: 100, Batch: true, Watchlist: true, Priority: true},
+	}
+	assert.Equal(t, 100, plans.Plan("pro").MaxComments)
+	assert.Equal(t, 5, plans.Plan("free").AnalysesPerDay)
+	// 알 수 없는 요금제는 free 한도
+	assert.Equal(t, plans["free"], plans.Plan("enterprise"))
+
+	// 요금제 미설정이면 제한 없음
+	unlimited := PlansConfig(nil).Plan("free")
+	assert.Zero(t, unlimited.AnalysesPerDay)
+	assert.True(t, unlimited.Batch)
+	assert.True(t, unlimited.Watchlist)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/generative-ai-go/genai"
 	"google.golang.org/api/option"
@@ -21,6 +22,8 @@ type AnalysisRequest struct {
 	Channel     string
 	Captions    string
 	Comments    []CommentData
+	ChannelInfo *ChannelData
+	LiveChat    []CommentData // 라이브 방송 채팅 (최근 메시지)
 }
 
 type CommentData struct {
@@ -29,6 +32,16 @@ type CommentData struct {
 	Likes  int64
 }
 
+// ChannelData: 채널 평판 정보 (사칭 채널 판단용)
+type ChannelData struct {
+	CreatedAt       time.Time
+	SubscriberCount int64
+	VideoCount      int64
+	Country         string
+	Verified        bool
+	Signals         []string
+}
+
 // AnalysisResponse: 최종 반환할 구조체 (Reasoning은 string으로 유지)
 type AnalysisResponse struct {
 	SafetyScore int      `json:"safety_score"`
@@ -103,6 +116,25 @@ func buildPrompt(req *AnalysisRequest) string {
 	}
 	sb.WriteString("\n")
 
+	if ch := req.ChannelInfo; ch != nil {
+		sb.WriteString("CHANNEL REPUTATION:\n")
+		if !ch.CreatedAt.IsZero() {
+			sb.WriteString(fmt.Sprintf("Channel created: %s\n", ch.CreatedAt.Format("2006-01-02")))
+		}
+		sb.WriteString(fmt.Sprintf("Subscribers: %d, Videos: %d\n", ch.SubscriberCount, ch.VideoCount))
+		if ch.Country != "" {
+			sb.WriteString(fmt.Sprintf("Country: %s\n", ch.Country))
+		}
+		sb.WriteString(fmt.Sprintf("Verified: %t\n", ch.Verified))
+		if len(ch.Signals) > 0 {
+			sb.WriteString("Warning signals:\n")
+			for _, signal := range ch.Signals {
+				sb.WriteString(fmt.Sprintf("- %s\n", signal))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
 	if req.Captions != "" {
 		captions := req.Captions
 		if len(captions) > 3000 {
@@ -124,10 +156,23 @@ func buildPrompt(req *AnalysisRequest) string {
 		sb.WriteString("\n")
 	}
 
+	if len(req.LiveChat) > 0 {
+		sb.WriteString("LIVE CHAT (Most recent messages of an ongoing live stream):\n")
+		for _, msg := range req.LiveChat {
+			sb.WriteString(fmt.Sprintf("- %s: %s\n", msg.Author, msg.Text))
+		}
+		sb.WriteString("\n")
+	}
+
 	sb.WriteString("ANALYSIS TASKS:\n")
 	sb.WriteString("1. Check for Deepfake signs: Unnatural speech, robotic voices, or famous people (Elon Musk, President) promoting crypto/investment.\n")
 	sb.WriteString("2. Check for Scams: 'Guaranteed returns', 'Urgent wire transfer', suspicious links.\n")
-	sb.WriteString("3. Check Sentiment: Are users calling it 'Fake', 'Scam', or 'Lie'?\n\n")
+	sb.WriteString("3. Check Sentiment: Are users calling it 'Fake', 'Scam', or 'Lie'?\n")
+	sb.WriteString("4. Check Channel Reputation: A brand-new channel using the name of a bank, government agency or celebrity is a strong impersonation signal.\n")
+	if len(req.LiveChat) > 0 {
+		sb.WriteString("5. Check Live Chat: Wallet addresses, QR codes, 'send 1 BTC get 2 back' giveaways, or bots repeating the same link are strong scam signals.\n")
+	}
+	sb.WriteString("\n")
 
 	sb.WriteString("RESPONSE FORMAT (Strict JSON):\n")
 	sb.WriteString("{\n")
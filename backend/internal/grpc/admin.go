@@ -0,0 +1,362 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/billing"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/worker"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// 관리자 서비스 (재분석, 사용자 조회, 판정 변경, 파트너 API 키, 구독)
+// 역할별 권한은 AuthInterceptor가 methodPermissions로 확인한다.
+// ---------------------------------------------------------
+
+type AdminServer struct {
+	pb.UnimplementedAdminServiceServer
+	store    storage.Store
+	analyzer *worker.Analyzer
+	sources  *source.Registry
+	tokens   *auth.TokenService // 역할 변경 시 기존 액세스 토큰 폐기
+	plans    config.PlansConfig // 지정할 수 있는 요금제
+}
+
+func NewAdminServer(store storage.Store, analyzer *worker.Analyzer, sources *source.Registry, tokens *auth.TokenService, plans config.PlansConfig) *AdminServer {
+	return &AdminServer{
+		store:    store,
+		analyzer: analyzer,
+		sources:  sources,
+		tokens:   tokens,
+		plans:    plans,
+	}
+}
+
+// GetUser: ID 또는 이메일로 사용자 조회
+func (s *AdminServer) GetUser(ctx context.Context, req *pb.AdminGetUserRequest) (*pb.AdminUser, error) {
+	var user *storage.User
+	var err error
+	switch {
+	case req.UserId != 0:
+		user, err = s.store.GetUserByID(ctx, req.UserId)
+	case req.Email != "":
+		user, err = s.store.GetUserByEmail(ctx, req.Email)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "user_id or email is required")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "user lookup failed")
+	}
+	return s.adminUser(ctx, user)
+}
+
+// SetUserRole: 역할 변경 (변경된 역할은 다음 토큰부터 적용되므로 기존 토큰을 폐기)
+func (s *AdminServer) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.AdminUser, error) {
+	if !auth.ValidRole(req.Role) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown role %q", req.Role)
+	}
+	adminID := currentUserID(ctx)
+	if req.UserId == adminID {
+		// 마지막 관리자가 스스로 권한을 잃지 않도록
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot change your own role")
+	}
+
+	err := s.store.SetUserRole(ctx, req.UserId, req.Role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		log.Printf("Failed to set role of user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "failed to set role")
+	}
+	log.Printf("Admin %d set role of user %d to %s", adminID, req.UserId, req.Role)
+
+	if s.tokens != nil {
+		if err := s.tokens.RevokeUser(ctx, req.UserId); err != nil {
+			log.Printf("Failed to revoke tokens of user %d after role change: %v", req.UserId, err)
+		}
+	}
+
+	user, err := s.store.GetUserByID(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "user lookup failed")
+	}
+	return s.adminUser(ctx, user)
+}
+
+// ReanalyzeVideo: 캐시된 결과와 무관하게 새 Job으로 다시 분석 (요청한 관리자의 기록에는 남기지 않음)
+func (s *AdminServer) ReanalyzeVideo(ctx context.Context, req *pb.ReanalyzeVideoRequest) (*pb.AnalysisResponse, error) {
+	_, ref, err := s.sources.Resolve(req.VideoUrl)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported video URL: %v", err)
+	}
+
+	job, err := createAnalysisJob(ctx, s.store, ref, 0)
+	if err != nil {
+		log.Printf("Failed to start re-analysis for %s: %v", ref.Key(), err)
+		return nil, status.Errorf(codes.Internal, "failed to create job: %v", err)
+	}
+	log.Printf("User %d requested re-analysis of %s (job %s)", currentUserID(ctx), ref.Key(), job.JobID)
+
+	s.analyzer.Analyze(context.Background(), job.JobID, req.VideoUrl, true, 10)
+
+	return &pb.AnalysisResponse{
+		JobId:   job.JobID.String(),
+		Status:  "accepted",
+		Message: "Re-analysis started",
+	}, nil
+}
+
+// OverrideVerdict: 검토자가 판정을 변경 (새 결과로 저장되어 기록/알림 조회에도 반영)
+// 점수는 기존 점수를 새 판정의 범위로 맞춘 값을 사용한다.
+func (s *AdminServer) OverrideVerdict(ctx context.Context, req *pb.OverrideVerdictRequest) (*pb.AnalysisResultResponse, error) {
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job ID")
+	}
+	minScore, maxScore, ok := storage.VerdictScoreRange(req.Verdict)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "verdict must be safe, caution or danger")
+	}
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	current, err := s.store.GetResult(ctx, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "analysis result not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load result")
+	}
+
+	score := min(max(current.SafetyScore, minScore), maxScore)
+	reviewerID := currentUserID(ctx)
+	if err := s.store.SaveReviewResult(ctx, jobID, score, reviewerID, req.Reason); err != nil {
+		log.Printf("Failed to save review of job %s: %v", jobID, err)
+		return nil, status.Errorf(codes.Internal, "failed to override verdict")
+	}
+	log.Printf("User %d changed verdict of job %s: %d (%s) -> %d (%s): %s",
+		reviewerID, jobID, current.SafetyScore, storage.VerdictForScore(current.SafetyScore), score, req.Verdict, req.Reason)
+
+	result, err := s.store.GetResult(ctx, jobID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load result")
+	}
+	return toPBAnalysisResultResponse(result), nil
+}
+
+func (s *AdminServer) adminUser(ctx context.Context, user *storage.User) (*pb.AdminUser, error) {
+	identities, err := s.store.ListIdentities(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "identity lookup failed")
+	}
+	sub, err := s.store.GetSubscription(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "subscription lookup failed")
+	}
+
+	out := &pb.AdminUser{
+		User: &pb.User{
+			Id:         user.ID,
+			Email:      user.Email,
+			Name:       user.Name,
+			PictureUrl: user.PictureURL,
+			Role:       user.Role,
+		},
+		Subscription: toPBSubscription(sub),
+	}
+	for _, i := range identities {
+		out.LinkedProviders = append(out.LinkedProviders, i.Provider)
+	}
+	return out, nil
+}
+
+// SetSubscription: 결제 없이 요금제 지정 (업그레이드/다운그레이드/무상 지급)
+// free를 지정하면 유료 요금제를 즉시 종료한다.
+func (s *AdminServer) SetSubscription(ctx context.Context, req *pb.SetSubscriptionRequest) (*pb.AdminUser, error) {
+	if req.PlanType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "plan_type is required")
+	}
+	if _, ok := s.plans[req.PlanType]; len(s.plans) > 0 && !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown plan %q", req.PlanType)
+	}
+	now := time.Now()
+	var endDate time.Time
+	if req.EndDate != "" {
+		var err error
+		if endDate, err = time.Parse(time.RFC3339, req.EndDate); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "end_date must be RFC3339")
+		}
+		if !endDate.After(now) {
+			return nil, status.Errorf(codes.InvalidArgument, "end_date must be in the future")
+		}
+	}
+
+	return s.updateSubscription(ctx, req.UserId, func(sub *storage.Subscription) error {
+		if req.PlanType != config.DefaultPlan {
+			billing.Activate(sub, req.PlanType, endDate, "", now)
+		} else if sub.PlanType != config.DefaultPlan {
+			billing.Expire(sub, now)
+		}
+		return nil
+	})
+}
+
+// CancelSubscription: 해지 (기본은 end_date까지 유지, immediately면 즉시 free)
+// 결제사의 정기 결제는 결제사 쪽에서 해지해야 한다.
+func (s *AdminServer) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.AdminUser, error) {
+	now := time.Now()
+	return s.updateSubscription(ctx, req.UserId, func(sub *storage.Subscription) error {
+		if sub.PlanType == config.DefaultPlan {
+			return status.Errorf(codes.FailedPrecondition, "user has no paid subscription")
+		}
+		if req.Immediately {
+			billing.Expire(sub, now)
+			return nil
+		}
+		if err := billing.Cancel(sub, now); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "subscription is already %s", sub.Status)
+		}
+		return nil
+	})
+}
+
+// updateSubscription: 사용자의 구독을 읽어 change를 적용한 뒤 저장 (한 트랜잭션)
+func (s *AdminServer) updateSubscription(ctx context.Context, userID int64, change func(sub *storage.Subscription) error) (*pb.AdminUser, error) {
+	user, err := s.store.GetUserByID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "user lookup failed")
+	}
+
+	var before, after string
+	err = s.store.WithTx(ctx, func(tx storage.Store) error {
+		sub, err := tx.GetSubscription(ctx, userID)
+		if err != nil {
+			return err
+		}
+		before = sub.PlanType + "/" + sub.Status
+		if err := change(sub); err != nil {
+			return err
+		}
+		after = sub.PlanType + "/" + sub.Status
+		return tx.SaveSubscription(ctx, sub)
+	})
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
+	if err != nil {
+		log.Printf("Failed to update subscription of user %d: %v", userID, err)
+		return nil, status.Errorf(codes.Internal, "failed to update subscription")
+	}
+	log.Printf("Admin %d changed subscription of user %d: %s -> %s", currentUserID(ctx), userID, before, after)
+
+	return s.adminUser(ctx, user)
+}
+
+// CreateAPIKey: 파트너 API 키 발급 (전체 키는 응답에서 한 번만 제공)
+func (s *AdminServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if len(req.Scopes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one scope is required")
+	}
+	for _, scope := range req.Scopes {
+		if !auth.ValidScope(scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown scope %q", scope)
+		}
+	}
+	if req.DailyQuota < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "daily_quota must not be negative")
+	}
+
+	generated, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create API key")
+	}
+	adminID := currentUserID(ctx)
+	key := &storage.APIKey{
+		Name:       req.Name,
+		Prefix:     generated.Prefix,
+		KeyHash:    generated.Hash,
+		Scopes:     req.Scopes,
+		DailyQuota: int(req.DailyQuota),
+		CreatedBy:  sql.NullInt64{Int64: adminID, Valid: adminID > 0},
+	}
+	if err := s.store.CreateAPIKey(ctx, key); err != nil {
+		log.Printf("Failed to create API key %q: %v", req.Name, err)
+		return nil, status.Errorf(codes.Internal, "failed to create API key")
+	}
+	log.Printf("Admin %d created API key %d (%s) for %q with scopes %v", adminID, key.ID, key.Prefix, key.Name, key.Scopes)
+
+	return &pb.CreateAPIKeyResponse{Key: toPBAPIKey(key), Secret: generated.Key}, nil
+}
+
+// RevokeAPIKey: 즉시 사용 중지 (다음 요청부터 거부)
+func (s *AdminServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.APIKey, error) {
+	revoked, err := s.store.RevokeAPIKey(ctx, req.KeyId)
+	if err != nil {
+		log.Printf("Failed to revoke API key %d: %v", req.KeyId, err)
+		return nil, status.Errorf(codes.Internal, "failed to revoke API key")
+	}
+	key, err := s.store.GetAPIKey(ctx, req.KeyId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "API key not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load API key")
+	}
+	if revoked {
+		log.Printf("Admin %d revoked API key %d (%s)", currentUserID(ctx), key.ID, key.Prefix)
+	}
+	return toPBAPIKey(key), nil
+}
+
+// ListAPIKeys: 발급된 키 목록 (해시는 제공하지 않음)
+func (s *AdminServer) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	keys, err := s.store.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list API keys")
+	}
+	resp := &pb.ListAPIKeysResponse{}
+	for i := range keys {
+		resp.Keys = append(resp.Keys, toPBAPIKey(&keys[i]))
+	}
+	return resp, nil
+}
+
+func toPBAPIKey(k *storage.APIKey) *pb.APIKey {
+	out := &pb.APIKey{
+		KeyId:      k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		DailyQuota: int32(k.DailyQuota),
+		CreatedAt:  k.CreatedAt.Format(time.RFC3339),
+	}
+	if k.LastUsedAt.Valid {
+		out.LastUsedAt = k.LastUsedAt.Time.Format(time.RFC3339)
+	}
+	if k.RevokedAt.Valid {
+		out.RevokedAt = k.RevokedAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
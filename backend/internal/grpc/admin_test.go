@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetUserRole(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	tokens := newTestTokens(t)
+	server := NewAdminServer(store, nil, nil, tokens, nil)
+
+	admin, err := store.UpsertUser(ctx, "admin@example.com", "Admin", "", "")
+	require.NoError(t, err)
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "")
+	require.NoError(t, err)
+	ctx = auth.NewContext(ctx, &auth.Claims{UserID: admin.ID, Role: auth.RoleAdmin})
+
+	before, err := tokens.AccessToken(user.ID, user.Email, user.Role)
+	require.NoError(t, err)
+
+	got, err := server.SetUserRole(ctx, &pb.SetUserRoleRequest{UserId: user.ID, Role: auth.RoleReviewer})
+	require.NoError(t, err)
+	assert.Equal(t, auth.RoleReviewer, got.User.Role)
+	assert.Equal(t, "free", got.Subscription.PlanType)
+
+	// 이전 역할이 담긴 토큰은 폐기되어 새로 발급받아야 함
+	_, err = tokens.Validate(ctx, before)
+	assert.Error(t, err)
+
+	found, err := server.GetUser(ctx, &pb.AdminGetUserRequest{Email: "senior@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, auth.RoleReviewer, found.User.Role)
+
+	_, err = server.SetUserRole(ctx, &pb.SetUserRoleRequest{UserId: user.ID, Role: "root"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.SetUserRole(ctx, &pb.SetUserRoleRequest{UserId: admin.ID, Role: auth.RoleUser})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = server.SetUserRole(ctx, &pb.SetUserRoleRequest{UserId: user.ID + 1000, Role: auth.RoleUser})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.GetUser(ctx, &pb.AdminGetUserRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestOverrideVerdict(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAdminServer(store, nil, nil, nil, nil)
+
+	reviewer, err := store.UpsertUser(ctx, "reviewer@example.com", "Reviewer", "", "")
+	require.NoError(t, err)
+	require.NoError(t, store.CreateVideo(ctx, &storage.Video{VideoID: "video000001", Title: "Bank notice"}))
+	job, err := store.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	ctx = auth.NewContext(ctx, &auth.Claims{UserID: reviewer.ID, Role: auth.RoleReviewer})
+
+	_, err = server.OverrideVerdict(ctx, &pb.OverrideVerdictRequest{JobId: job.JobID.String(), Verdict: "safe", Reason: "official channel"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, store.SaveResult(ctx, job.JobID, 25, []string{"impersonation"}, nil))
+
+	// 기존 점수를 새 판정 범위의 가장 가까운 값으로
+	resp, err := server.OverrideVerdict(ctx, &pb.OverrideVerdictRequest{JobId: job.JobID.String(), Verdict: "safe", Reason: "official channel"})
+	require.NoError(t, err)
+	assert.Equal(t, "safe", resp.Verdict)
+	assert.EqualValues(t, 70, resp.SafetyScore)
+	assert.Equal(t, storage.ResultSourceReview, resp.Source)
+	assert.Equal(t, "video000001", resp.VideoId)
+
+	result, err := store.GetLatestResult(ctx, "video000001")
+	require.NoError(t, err)
+	assert.Equal(t, reviewer.ID, result.ReviewedBy.Int64)
+	assert.Equal(t, "official channel", result.ReviewReason)
+
+	_, err = server.OverrideVerdict(ctx, &pb.OverrideVerdictRequest{JobId: job.JobID.String(), Verdict: "unknown", Reason: "x"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.OverrideVerdict(ctx, &pb.OverrideVerdictRequest{JobId: job.JobID.String(), Verdict: "danger"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSetSubscription(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAdminServer(store, nil, nil, nil, testPlans)
+	analysis := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, testPlans, nil, nil)
+
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "")
+	require.NoError(t, err)
+	ctx = auth.NewContext(ctx, &auth.Claims{UserID: 1, Role: auth.RoleAdmin})
+	end := time.Now().AddDate(0, 1, 0).UTC().Truncate(time.Second)
+
+	got, err := server.SetSubscription(ctx, &pb.SetSubscriptionRequest{UserId: user.ID, PlanType: "pro", EndDate: end.Format(time.RFC3339)})
+	require.NoError(t, err)
+	assert.Equal(t, "pro", got.Subscription.PlanType)
+	assert.Equal(t, storage.SubscriptionActive, got.Subscription.Status)
+	assert.Equal(t, end.Format(time.RFC3339), got.Subscription.EndDate)
+
+	// 한도는 바로 새 요금제 기준
+	plan, err := analysis.planFor(ctx, user.ID)
+	require.NoError(t, err)
+	assert.True(t, plan.Batch)
+
+	// 해지해도 end_date까지 유지
+	got, err = server.CancelSubscription(ctx, &pb.CancelSubscriptionRequest{UserId: user.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "pro", got.Subscription.PlanType)
+	assert.Equal(t, storage.SubscriptionCanceled, got.Subscription.Status)
+	assert.NotEmpty(t, got.Subscription.CanceledAt)
+	_, err = server.CancelSubscription(ctx, &pb.CancelSubscriptionRequest{UserId: user.ID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	got, err = server.CancelSubscription(ctx, &pb.CancelSubscriptionRequest{UserId: user.ID, Immediately: true})
+	require.NoError(t, err)
+	assert.Equal(t, "free", got.Subscription.PlanType)
+	assert.Equal(t, storage.SubscriptionExpired, got.Subscription.Status)
+	_, err = server.CancelSubscription(ctx, &pb.CancelSubscriptionRequest{UserId: user.ID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.SetSubscription(ctx, &pb.SetSubscriptionRequest{UserId: user.ID, PlanType: "enterprise"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.SetSubscription(ctx, &pb.SetSubscriptionRequest{UserId: user.ID, PlanType: "pro", EndDate: "2020-01-01T00:00:00Z"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.SetSubscription(ctx, &pb.SetSubscriptionRequest{UserId: user.ID + 1000, PlanType: "pro"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
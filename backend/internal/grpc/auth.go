@@ -0,0 +1,346 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// 인증 인터셉터 (사용자 JWT, 파트너 API 키)
+// ---------------------------------------------------------
+
+// AuthPolicy: 메서드별 인증 요구 수준
+type AuthPolicy int
+
+const (
+	// AuthRequired: 유효한 토큰이 없으면 Unauthenticated (등록되지 않은 메서드의 기본값)
+	AuthRequired AuthPolicy = iota
+	// AuthOptional: 토큰이 있으면 검증해 사용자로 처리, 없으면 비회원
+	AuthOptional
+	// AuthPublic: 토큰을 보지 않음
+	AuthPublic
+)
+
+// methodPolicies: 요청 본문의 user_id는 신뢰하지 않고 토큰의 사용자만 사용한다.
+var methodPolicies = map[string]AuthPolicy{
+	pb.AnalysisService_LoginWithGoogle_FullMethodName:   AuthPublic,
+	pb.AnalysisService_LoginWithProvider_FullMethodName: AuthPublic,
+	pb.AnalysisService_Refresh_FullMethodName:           AuthPublic, // 액세스 토큰이 만료된 상태에서 호출
+	pb.AnalysisService_Logout_FullMethodName:            AuthRequired,
+
+	// 비회원도 분석 가능 (로그인하면 기록에 남김)
+	pb.AnalysisService_StartAnalysis_FullMethodName:       AuthOptional,
+	pb.AnalysisService_StreamProgress_FullMethodName:      AuthOptional,
+	pb.AnalysisService_GetResult_FullMethodName:           AuthOptional,
+	pb.AnalysisService_GetAnalysisResult_FullMethodName:   AuthOptional,
+	pb.AnalysisService_GetUploadURL_FullMethodName:        AuthOptional,
+	pb.AnalysisService_StartBatchAnalysis_FullMethodName:  AuthOptional,
+	pb.AnalysisService_StreamBatchProgress_FullMethodName: AuthOptional,
+	pb.AnalysisService_GetBatchResult_FullMethodName:      AuthOptional,
+
+	// 취소는 Job 소유자(또는 보호자/관리자)만 가능하므로 로그인 필요
+	pb.AnalysisService_CancelAnalysis_FullMethodName: AuthRequired,
+
+	pb.AnalysisService_GetUserProfile_FullMethodName:    AuthRequired,
+	pb.AnalysisService_GetUserHistory_FullMethodName:    AuthRequired,
+	pb.AnalysisService_DeleteHistoryItem_FullMethodName: AuthRequired,
+	pb.AnalysisService_ClearHistory_FullMethodName:      AuthRequired,
+	pb.AnalysisService_AddWatch_FullMethodName:          AuthRequired,
+	pb.AnalysisService_RemoveWatch_FullMethodName:       AuthRequired,
+	pb.AnalysisService_ListWatches_FullMethodName:       AuthRequired,
+	pb.AnalysisService_ListAlerts_FullMethodName:        AuthRequired,
+	pb.AnalysisService_MarkAlertsRead_FullMethodName:    AuthRequired,
+
+	// 가족 연결
+	pb.AnalysisService_CreateFamilyInvite_FullMethodName: AuthRequired,
+	pb.AnalysisService_ClaimFamilyInvite_FullMethodName:  AuthRequired,
+	pb.AnalysisService_ApproveFamilyLink_FullMethodName:  AuthRequired,
+	pb.AnalysisService_RevokeFamilyLink_FullMethodName:   AuthRequired,
+	pb.AnalysisService_ListFamilyLinks_FullMethodName:    AuthRequired,
+}
+
+// methodPermissions: 로그인 외에 역할 권한이 필요한 RPC (관리자 서비스)
+var methodPermissions = map[string]auth.Permission{
+	pb.AdminService_GetUser_FullMethodName:            auth.PermViewUsers,
+	pb.AdminService_SetUserRole_FullMethodName:        auth.PermManageRoles,
+	pb.AdminService_ReanalyzeVideo_FullMethodName:     auth.PermReanalyze,
+	pb.AdminService_OverrideVerdict_FullMethodName:    auth.PermOverrideVerdict,
+	pb.AdminService_CreateAPIKey_FullMethodName:       auth.PermManageAPIKeys,
+	pb.AdminService_RevokeAPIKey_FullMethodName:       auth.PermManageAPIKeys,
+	pb.AdminService_ListAPIKeys_FullMethodName:        auth.PermManageAPIKeys,
+	pb.AdminService_SetSubscription_FullMethodName:    auth.PermManageSubscriptions,
+	pb.AdminService_CancelSubscription_FullMethodName: auth.PermManageSubscriptions,
+}
+
+// methodScopes: 파트너 API 키로 호출할 수 있는 RPC와 필요한 scope (그 외 RPC는 사용자 토큰만)
+var methodScopes = map[string]string{
+	pb.AnalysisService_StartAnalysis_FullMethodName:       auth.ScopeAnalysisWrite,
+	pb.AnalysisService_StartBatchAnalysis_FullMethodName:  auth.ScopeAnalysisWrite,
+	pb.AnalysisService_GetUploadURL_FullMethodName:        auth.ScopeAnalysisWrite,
+	pb.AnalysisService_StreamProgress_FullMethodName:      auth.ScopeAnalysisRead,
+	pb.AnalysisService_GetResult_FullMethodName:           auth.ScopeAnalysisRead,
+	pb.AnalysisService_GetAnalysisResult_FullMethodName:   auth.ScopeAnalysisRead,
+	pb.AnalysisService_StreamBatchProgress_FullMethodName: auth.ScopeAnalysisRead,
+	pb.AnalysisService_GetBatchResult_FullMethodName:      auth.ScopeAnalysisRead,
+}
+
+// publicServicePrefixes: 인증 없이 허용하는 인프라 서비스 (grpcurl 등 디버깅용 reflection)
+var publicServicePrefixes = []string{"/grpc.reflection.", "/grpc.health."}
+
+func policyFor(method string) AuthPolicy {
+	if p, ok := methodPolicies[method]; ok {
+		return p
+	}
+	for _, prefix := range publicServicePrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return AuthPublic
+		}
+	}
+	return AuthRequired
+}
+
+// AuthInterceptor: 메서드 정책에 따라 JWT(또는 파트너 API 키)를 검증하고 호출자를 context에 저장
+type AuthInterceptor struct {
+	tokens         *auth.TokenService
+	apiKeys        storage.APIKeyStore
+	trustedProxies []*net.IPNet // x-forwarded-for를 믿을 수 있는 프록시(ingress) 주소
+}
+
+func NewAuthInterceptor(tokens *auth.TokenService, apiKeys storage.APIKeyStore, trustedProxies []*net.IPNet) *AuthInterceptor {
+	return &AuthInterceptor{tokens: tokens, apiKeys: apiKeys, trustedProxies: trustedProxies}
+}
+
+// ParseTrustedProxies: 설정의 CIDR/IP 목록을 파싱 (IP 하나는 /32, /128로 취급)
+func ParseTrustedProxies(values []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if _, n, err := net.ParseCIDR(v); err == nil {
+			nets = append(nets, n)
+			continue
+		}
+		ip := net.ParseIP(v)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", v)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return nets, nil
+}
+
+// Unary: 단일 요청 RPC용
+func (a *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream: 스트리밍 RPC용 (StreamProgress 등)
+func (a *AuthInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+}
+
+// authStream: 인증된 context를 돌려주는 ServerStream
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (a *AuthInterceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	ctx = context.WithValue(ctx, clientIPKey{}, resolveClientIP(ctx, a.trustedProxies))
+	policy := policyFor(method)
+	if policy == AuthPublic {
+		return ctx, nil
+	}
+
+	// x-api-key가 있으면 사용자 토큰 대신 파트너 API 키로 인증
+	if key := apiKeyHeader(ctx); key != "" {
+		return a.authenticateAPIKey(ctx, method, key)
+	}
+
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		if policy == AuthRequired {
+			return nil, status.Errorf(codes.Unauthenticated, "missing authorization token")
+		}
+		return ctx, nil
+	}
+
+	// 선택 인증이어도 잘못된/폐기된 토큰은 거부 (만료 시 클라이언트가 Refresh 하도록)
+	claims, err := a.tokens.Validate(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token")
+	}
+	if perm, ok := methodPermissions[method]; ok && !claims.Can(perm) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s permission required", perm)
+	}
+	return auth.NewContext(ctx, claims), nil
+}
+
+// authenticateAPIKey: 키 확인 → scope 확인 → 일일 사용량 차감 (상한 초과 시 ResourceExhausted)
+func (a *AuthInterceptor) authenticateAPIKey(ctx context.Context, method, raw string) (context.Context, error) {
+	scope, ok := methodScopes[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "API keys cannot call this method")
+	}
+	if a.apiKeys == nil || !auth.LooksLikeAPIKey(raw) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+
+	key, err := a.apiKeys.GetAPIKeyByHash(ctx, auth.HashAPIKey(raw))
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && key.RevokedAt.Valid) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+	if err != nil {
+		log.Printf("API key lookup failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to verify API key")
+	}
+
+	caller := &auth.APIKeyCaller{KeyID: key.ID, Name: key.Name, Scopes: key.Scopes, DailyQuota: key.DailyQuota}
+	if !caller.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "API key lacks %s scope", scope)
+	}
+
+	now := time.Now().UTC()
+	used, ok, err := a.apiKeys.UseAPIKey(ctx, key.ID, now, 1, key.DailyQuota)
+	if err != nil {
+		log.Printf("Failed to record usage of API key %d: %v", key.ID, err)
+		return nil, status.Errorf(codes.Internal, "failed to verify API key")
+	}
+	if !ok {
+		return nil, status.Errorf(codes.ResourceExhausted, "daily quota of %d requests exceeded (%d used), resets at %s",
+			key.DailyQuota, used, nextUsageReset(now).Format(time.RFC3339))
+	}
+	return auth.NewAPIKeyContext(ctx, caller), nil
+}
+
+// apiKeyHeader: x-api-key 메타데이터 (없으면 빈 문자열)
+func apiKeyHeader(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-api-key"); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+type clientIPKey struct{}
+
+// clientIP: 비회원 사용량 집계용 클라이언트 주소 (인터셉터가 정한 값, 없으면 연결 주소)
+func clientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return peerIP(ctx)
+}
+
+// resolveClientIP: 연결 주소가 신뢰하는 프록시일 때만 x-forwarded-for를 뒤에서부터 읽어
+// 신뢰하지 않는 첫 주소를 클라이언트로 본다. (클라이언트가 보낸 앞쪽 값은 위조할 수 있음)
+// 직접 연결했거나 헤더가 잘못됐으면 연결 주소
+func resolveClientIP(ctx context.Context, trusted []*net.IPNet) string {
+	remote := peerIP(ctx)
+	if !trustedIP(trusted, net.ParseIP(remote)) {
+		return remote
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("x-forwarded-for")
+	if len(values) == 0 {
+		return remote
+	}
+	hops := strings.Split(strings.Join(values, ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(hops[i]))
+		if ip == nil {
+			return remote
+		}
+		if i == 0 || !trustedIP(trusted, ip) {
+			return ip.String()
+		}
+	}
+	return remote
+}
+
+func trustedIP(trusted []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, n := range trusted {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// peerIP: gRPC 연결 주소 (grpc-web 요청은 HTTP 연결 주소)
+func peerIP(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// bearerToken: authorization 메타데이터("Bearer <JWT>")에서 토큰 추출 (없으면 빈 문자열)
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "authorization must be a Bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// currentUserID: 인증된 사용자 ID (비회원이면 0)
+func currentUserID(ctx context.Context) int64 {
+	if claims, ok := auth.FromContext(ctx); ok {
+		return claims.UserID
+	}
+	return 0
+}
+
+// requireUserID: 로그인이 필요한 RPC에서 사용자 ID 확인
+func requireUserID(ctx context.Context) (int64, error) {
+	if uid := currentUserID(ctx); uid > 0 {
+		return uid, nil
+	}
+	return 0, status.Errorf(codes.Unauthenticated, "login required")
+}
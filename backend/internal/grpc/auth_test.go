@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestTokens(t *testing.T) *auth.TokenService {
+	keys, err := auth.NewKeySet(config.JWTConfig{})
+	require.NoError(t, err)
+	return auth.NewTokenService(keys, auth.NewMemoryDenylist(), 0)
+}
+
+func newTestInterceptor(t *testing.T) (*AuthInterceptor, string) {
+	interceptor, tokens := newTestInterceptorWithRoles(t, auth.RoleUser)
+	return interceptor, tokens[0]
+}
+
+// newTestInterceptorWithRoles: 역할마다 사용자 ID 7, 8, 9...의 토큰 발급
+func newTestInterceptorWithRoles(t *testing.T, roles ...string) (*AuthInterceptor, []string) {
+	tokens := newTestTokens(t)
+	var issued []string
+	for i, role := range roles {
+		token, err := tokens.AccessToken(int64(7+i), "user@example.com", role)
+		require.NoError(t, err)
+		issued = append(issued, token)
+	}
+	return NewAuthInterceptor(tokens, nil, nil), issued
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	interceptor, tokens := newTestInterceptorWithRoles(t, auth.RoleUser, auth.RoleReviewer, auth.RoleAdmin)
+	token, reviewer, admin := tokens[0], tokens[1], tokens[2]
+
+	// 핸들러가 받은 context의 사용자 ID를 반환
+	call := func(method, authorization string) (int64, error) {
+		ctx := context.Background()
+		if authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+		}
+		resp, err := interceptor.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return currentUserID(ctx), nil
+			})
+		if err != nil {
+			return 0, err
+		}
+		return resp.(int64), nil
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		wantUser      int64
+		wantCode      codes.Code
+	}{
+		{"required with token", pb.AnalysisService_GetUserHistory_FullMethodName, "Bearer " + token, 7, codes.OK},
+		{"required without token", pb.AnalysisService_GetUserHistory_FullMethodName, "", 0, codes.Unauthenticated},
+		{"required with forged token", pb.AnalysisService_GetUserProfile_FullMethodName, "Bearer forged", 0, codes.Unauthenticated},
+		{"required with basic auth", pb.AnalysisService_ListWatches_FullMethodName, "Basic dXNlcg==", 0, codes.Unauthenticated},
+		{"optional anonymous", pb.AnalysisService_StartAnalysis_FullMethodName, "", 0, codes.OK},
+		{"optional with token", pb.AnalysisService_StartAnalysis_FullMethodName, "Bearer " + token, 7, codes.OK},
+		{"optional with forged token", pb.AnalysisService_GetUploadURL_FullMethodName, "Bearer forged", 0, codes.Unauthenticated},
+		{"public ignores token", pb.AnalysisService_LoginWithGoogle_FullMethodName, "Bearer forged", 0, codes.OK},
+		{"reflection is public", "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", "", 0, codes.OK},
+		{"unknown method requires auth", "/analysis.AnalysisService/Unknown", "", 0, codes.Unauthenticated},
+		{"admin rpc as user", pb.AdminService_GetUser_FullMethodName, "Bearer " + token, 0, codes.PermissionDenied},
+		{"admin rpc anonymous", pb.AdminService_GetUser_FullMethodName, "", 0, codes.Unauthenticated},
+		{"reviewer overrides verdict", pb.AdminService_OverrideVerdict_FullMethodName, "Bearer " + reviewer, 8, codes.OK},
+		{"reviewer cannot manage roles", pb.AdminService_SetUserRole_FullMethodName, "Bearer " + reviewer, 0, codes.PermissionDenied},
+		{"admin manages roles", pb.AdminService_SetUserRole_FullMethodName, "Bearer " + admin, 9, codes.OK},
+		{"reviewer cannot manage subscriptions", pb.AdminService_SetSubscription_FullMethodName, "Bearer " + reviewer, 0, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := call(tt.method, tt.authorization)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantUser, user)
+		})
+	}
+}
+
+// fakeServerStream: Context만 제공하는 ServerStream
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	interceptor, token := newTestInterceptor(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	var user int64
+	err := interceptor.Stream(nil, &fakeServerStream{ctx: ctx},
+		&grpc.StreamServerInfo{FullMethod: pb.AnalysisService_StreamProgress_FullMethodName},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			user = currentUserID(stream.Context())
+			return nil
+		})
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, user)
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	admin := NewAdminServer(store, nil, nil, nil, nil)
+	interceptor := NewAuthInterceptor(newTestTokens(t), store, nil)
+	adminCtx := auth.NewContext(ctx, &auth.Claims{UserID: 1, Role: auth.RoleAdmin})
+
+	created, err := admin.CreateAPIKey(adminCtx, &pb.CreateAPIKeyRequest{Name: "Bank", Scopes: []string{auth.ScopeAnalysisRead}, DailyQuota: 2})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(created.Secret, created.Key.Prefix))
+
+	_, err = admin.CreateAPIKey(adminCtx, &pb.CreateAPIKeyRequest{Name: "Bank", Scopes: []string{"admin"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 핸들러가 받은 API 키 이름을 반환
+	call := func(method, key string) (string, error) {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", key))
+		resp, err := interceptor.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				caller, _ := auth.APIKeyFromContext(ctx)
+				assert.Zero(t, currentUserID(ctx))
+				return caller.Name, nil
+			})
+		if err != nil {
+			return "", err
+		}
+		return resp.(string), nil
+	}
+
+	name, err := call(pb.AnalysisService_GetAnalysisResult_FullMethodName, created.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, "Bank", name)
+
+	// scope 밖의 RPC, 사용자 전용 RPC, 잘못된 키
+	_, err = call(pb.AnalysisService_StartAnalysis_FullMethodName, created.Secret)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = call(pb.AnalysisService_GetUserHistory_FullMethodName, created.Secret)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = call(pb.AnalysisService_GetResult_FullMethodName, created.Secret+"x")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// 하루 상한 2회
+	_, err = call(pb.AnalysisService_GetResult_FullMethodName, created.Secret)
+	require.NoError(t, err)
+	_, err = call(pb.AnalysisService_GetResult_FullMethodName, created.Secret)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	list, err := admin.ListAPIKeys(adminCtx, &pb.ListAPIKeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Keys, 1)
+	assert.NotEmpty(t, list.Keys[0].LastUsedAt)
+
+	revoked, err := admin.RevokeAPIKey(adminCtx, &pb.RevokeAPIKeyRequest{KeyId: created.Key.KeyId})
+	require.NoError(t, err)
+	assert.NotEmpty(t, revoked.RevokedAt)
+	_, err = call(pb.AnalysisService_GetResult_FullMethodName, created.Secret)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = admin.RevokeAPIKey(adminCtx, &pb.RevokeAPIKeyRequest{KeyId: 999})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,343 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/worker"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// [NEW] 재생목록/채널 일괄 분석
+// ---------------------------------------------------------
+
+const defaultBatchMaxVideos = 50
+
+// StartBatchAnalysis: 재생목록/채널 URL을 영상 목록으로 펼쳐 배치로 분석
+func (s *AnalysisServer) StartBatchAnalysis(ctx context.Context, req *pb.BatchAnalysisRequest) (*pb.BatchAnalysisResponse, error) {
+	userID := currentUserID(ctx)
+	log.Printf("Received batch analysis request for URL: %s (User: %d)", req.Url, userID)
+
+	// 1. URL 검증
+	ref, err := youtube.ParseCollectionURL(req.Url)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid playlist or channel URL: %v", err)
+	}
+
+	plan, err := s.planFor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.Batch {
+		return nil, status.Errorf(codes.PermissionDenied, "batch analysis is not included in the %s plan", plan.Name)
+	}
+
+	// 2. 영상 수 상한 적용
+	limit := s.batchCfg.MaxVideos
+	if limit <= 0 {
+		limit = defaultBatchMaxVideos
+	}
+	maxVideos := int(req.MaxVideos)
+	if maxVideos <= 0 || maxVideos > limit {
+		maxVideos = limit
+	}
+
+	// 3. 재생목록/채널 펼치기
+	collection, videos, err := s.analyzer.ExpandCollection(ctx, ref, maxVideos)
+	if err != nil {
+		log.Printf("Failed to expand %s: %v", req.Url, err)
+		return nil, status.Errorf(codes.NotFound, "playlist or channel not found")
+	}
+	if len(videos) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no public videos in %s", collection.Kind)
+	}
+
+	// 4. 부모 배치
+	batch := &storage.AnalysisBatch{
+		UserID:    userID,
+		Kind:      collection.Kind,
+		SourceURL: req.Url,
+		Title:     collection.Title,
+		ChannelID: collection.ChannelID,
+		Total:     len(videos),
+	}
+	// 5. 일일 분석 횟수 차감(영상 수만큼) + 배치와 자식 Job을 한 트랜잭션으로 생성
+	jobs := make([]worker.BatchJob, 0, len(videos))
+	jobIDs := make([]string, 0, len(videos))
+	err = s.store.WithTx(ctx, func(tx storage.Store) error {
+		if err := useAnalyses(ctx, tx, userID, plan, len(videos)); err != nil {
+			return err
+		}
+		if err := tx.CreateBatch(ctx, batch); err != nil {
+			return fmt.Errorf("create batch: %w", err)
+		}
+
+		for _, v := range videos {
+			placeholderVideo := &storage.Video{
+				VideoID:      v.VideoID,
+				Platform:     source.PlatformYouTube,
+				Title:        v.Title,
+				Channel:      collection.Title,
+				ChannelID:    collection.ChannelID,
+				ThumbnailURL: youtube.DefaultThumbnailURL(v.VideoID),
+				PublishedAt:  v.PublishedAt,
+			}
+			if err := tx.CreateVideo(ctx, placeholderVideo); err != nil {
+				return fmt.Errorf("save video %s: %w", v.VideoID, err)
+			}
+
+			job, err := tx.CreateBatchJob(ctx, batch.BatchID, v.VideoID)
+			if err != nil {
+				return fmt.Errorf("create job: %w", err)
+			}
+			jobs = append(jobs, worker.BatchJob{
+				JobID:    job.JobID,
+				VideoURL: fmt.Sprintf("https://www.youtube.com/watch?v=%s", v.VideoID),
+			})
+			jobIDs = append(jobIDs, job.JobID.String())
+		}
+		return nil
+	})
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create batch: %v", err)
+	}
+
+	// 6. 분석 옵션 설정 후 비동기 실행 (우선 처리 요금제가 아니면 한 편씩)
+	analyzeComments := true
+	commentCount := 10
+	if req.Options != nil {
+		analyzeComments = req.Options.AnalyzeComments
+		if req.Options.TopCommentsCount > 0 {
+			commentCount = int(req.Options.TopCommentsCount)
+		}
+	}
+	commentCount = plan.commentLimit(commentCount)
+	concurrency := s.batchCfg.Concurrency
+	if !plan.Priority {
+		concurrency = 1
+	}
+	s.analyzer.AnalyzeBatch(batch.BatchID, jobs, analyzeComments, commentCount, concurrency)
+
+	return &pb.BatchAnalysisResponse{
+		BatchId:     batch.BatchID.String(),
+		Kind:        batch.Kind,
+		Title:       batch.Title,
+		TotalVideos: int32(batch.Total),
+		JobIds:      jobIDs,
+		Status:      "accepted",
+		Message:     fmt.Sprintf("Batch analysis of %d videos started", batch.Total),
+	}, nil
+}
+
+// StreamBatchProgress: 배치 전체 진행 상황 스트리밍
+func (s *AnalysisServer) StreamBatchProgress(req *pb.BatchProgressRequest, stream pb.AnalysisService_StreamBatchProgressServer) error {
+	batchID, err := uuid.Parse(req.BatchId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid batch ID")
+	}
+	ctx := stream.Context()
+
+	batch, err := s.store.GetBatch(ctx, batchID)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "batch not found")
+	}
+
+	// DB 스냅샷을 읽기 전에 먼저 구독해야 그 사이에 끝난 Job을 놓치지 않음
+	var progressChan <-chan worker.BatchProgressEvent
+	if batch.Status == storage.StatusProcessing {
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		progressChan, err = s.analyzer.SubscribeToBatch(subCtx, req.BatchId)
+		if err != nil {
+			log.Printf("Failed to subscribe to Redis: %v", err)
+			return status.Errorf(codes.Internal, "failed to subscribe")
+		}
+
+		// 구독 전에 배치가 끝났다면 complete 이벤트는 이미 지나갔으므로 스냅샷만 보내고 종료
+		batch, err = s.store.GetBatch(ctx, batchID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "batch not found")
+		}
+		if batch.Status != storage.StatusProcessing {
+			cancel()
+			progressChan = nil
+		}
+	}
+
+	// 초기 상태 전송 (DB 스냅샷)
+	items, err := s.store.ListBatchItems(ctx, batchID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load batch")
+	}
+	completed, failed := countBatchItems(items)
+	snapshot := &pb.BatchProgressEvent{
+		BatchId:   batchID.String(),
+		Type:      "progress",
+		Completed: int32(completed),
+		Failed:    int32(failed),
+		Total:     int32(batch.Total),
+		Message:   fmt.Sprintf("Connected to batch (current status: %s)", batch.Status),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	if batch.Status != storage.StatusProcessing {
+		snapshot.Type = "complete"
+	}
+	if err := stream.Send(snapshot); err != nil {
+		return err
+	}
+	if progressChan == nil {
+		return nil
+	}
+
+	for event := range progressChan {
+		resp := &pb.BatchProgressEvent{
+			BatchId:     event.BatchID.String(),
+			Type:        event.Type,
+			Completed:   int32(event.Completed),
+			Failed:      int32(event.Failed),
+			Total:       int32(event.Total),
+			VideoId:     event.VideoID,
+			SafetyScore: int32(event.SafetyScore),
+			Message:     event.Message,
+			Timestamp:   time.Now().Format(time.RFC3339),
+		}
+		if event.JobID != uuid.Nil {
+			resp.JobId = event.JobID.String()
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		if event.Type == "complete" {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// GetBatchResult: 배치 결과 및 채널 단위 위험도 요약 조회
+func (s *AnalysisServer) GetBatchResult(ctx context.Context, req *pb.BatchResultRequest) (*pb.BatchResult, error) {
+	batchID, err := uuid.Parse(req.BatchId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid batch ID")
+	}
+
+	batch, err := s.store.GetBatch(ctx, batchID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "batch not found")
+	}
+
+	items, err := s.store.ListBatchItems(ctx, batchID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load batch items")
+	}
+
+	completed, failed := countBatchItems(items)
+	result := &pb.BatchResult{
+		BatchId:   batchID.String(),
+		Kind:      batch.Kind,
+		Title:     batch.Title,
+		SourceUrl: batch.SourceURL,
+		Status:    batch.Status,
+		Total:     int32(batch.Total),
+		Completed: int32(completed),
+		Failed:    int32(failed),
+		Summary:   summarizeBatch(items),
+		CreatedAt: batch.CreatedAt.Format(time.RFC3339),
+	}
+	if batch.CompletedAt.Valid {
+		result.CompletedAt = batch.CompletedAt.Time.Format(time.RFC3339)
+	}
+
+	for _, item := range items {
+		pbItem := &pb.BatchItem{
+			JobId:   item.JobID.String(),
+			VideoId: item.VideoID,
+			Title:   item.Title,
+			Status:  item.Status,
+		}
+		if item.HasResult {
+			pbItem.SafetyScore = int32(item.SafetyScore)
+			pbItem.Verdict = storage.VerdictForScore(item.SafetyScore)
+		}
+		result.Items = append(result.Items, pbItem)
+	}
+
+	if batch.ChannelID != "" {
+		channel, err := s.store.GetChannel(ctx, batch.ChannelID)
+		if err == nil {
+			result.Summary.ChannelInfo = toPBChannelInfo(channel)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Failed to load channel %s: %v", batch.ChannelID, err)
+		}
+	}
+
+	return result, nil
+}
+
+// countBatchItems: 완료/실패한 자식 Job 수
+func countBatchItems(items []storage.BatchItem) (completed, failed int) {
+	for _, item := range items {
+		switch item.Status {
+		case storage.StatusCompleted:
+			completed++
+		case storage.StatusFailed, storage.StatusCancelled:
+			failed++
+		}
+	}
+	return completed, failed
+}
+
+// summarizeBatch: 결과가 있는 영상들로 채널/재생목록 단위 위험도를 계산
+// 위험(danger) 영상이 30% 이상이면 high, 위험 영상이 하나라도 있거나 주의(caution)가
+// 30% 이상이면 medium, 그 외 low
+func summarizeBatch(items []storage.BatchItem) *pb.BatchRiskSummary {
+	summary := &pb.BatchRiskSummary{RiskLevel: "low"}
+
+	scored, total := 0, 0
+	for _, item := range items {
+		if !item.HasResult {
+			continue
+		}
+		if scored == 0 || int32(item.SafetyScore) < summary.MinSafetyScore {
+			summary.MinSafetyScore = int32(item.SafetyScore)
+		}
+		scored++
+		total += item.SafetyScore
+
+		switch storage.VerdictForScore(item.SafetyScore) {
+		case storage.VerdictDanger:
+			summary.DangerCount++
+		case storage.VerdictCaution:
+			summary.CautionCount++
+		default:
+			summary.SafeCount++
+		}
+	}
+	if scored == 0 {
+		return summary
+	}
+
+	summary.AverageSafetyScore = float32(total) / float32(scored)
+	switch {
+	case float32(summary.DangerCount)/float32(scored) >= 0.3:
+		summary.RiskLevel = "high"
+	case summary.DangerCount > 0 || float32(summary.CautionCount)/float32(scored) >= 0.3:
+		summary.RiskLevel = "medium"
+	}
+	return summary
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+func scoredItems(scores ...int) []storage.BatchItem {
+	items := make([]storage.BatchItem, 0, len(scores))
+	for _, score := range scores {
+		items = append(items, storage.BatchItem{Status: storage.StatusCompleted, SafetyScore: score, HasResult: true})
+	}
+	return items
+}
+
+func TestSummarizeBatch(t *testing.T) {
+	// 결과가 없으면 low, 점수 0
+	summary := summarizeBatch([]storage.BatchItem{{Status: storage.StatusFailed}})
+	assert.Equal(t, "low", summary.RiskLevel)
+	assert.Zero(t, summary.AverageSafetyScore)
+
+	summary = summarizeBatch(scoredItems(90, 80, 75, 72))
+	assert.Equal(t, "low", summary.RiskLevel)
+	assert.Equal(t, int32(4), summary.SafeCount)
+	assert.Equal(t, int32(72), summary.MinSafetyScore)
+	assert.InDelta(t, 79.25, summary.AverageSafetyScore, 0.001)
+
+	// 위험 영상이 하나라도 있으면 medium
+	summary = summarizeBatch(scoredItems(90, 90, 90, 90, 10))
+	assert.Equal(t, "medium", summary.RiskLevel)
+	assert.Equal(t, int32(1), summary.DangerCount)
+	assert.Equal(t, int32(10), summary.MinSafetyScore)
+
+	// 주의 영상이 30% 이상이면 medium
+	summary = summarizeBatch(scoredItems(90, 90, 50))
+	assert.Equal(t, "medium", summary.RiskLevel)
+	assert.Equal(t, int32(1), summary.CautionCount)
+
+	// 위험 영상이 30% 이상이면 high (결과 없는 항목은 비율에서 제외)
+	items := append(scoredItems(90, 30, 0), storage.BatchItem{Status: storage.StatusProcessing})
+	summary = summarizeBatch(items)
+	assert.Equal(t, "high", summary.RiskLevel)
+	assert.Equal(t, int32(2), summary.DangerCount)
+	assert.Equal(t, int32(0), summary.MinSafetyScore)
+	assert.InDelta(t, 40, summary.AverageSafetyScore, 0.001)
+}
+
+func TestCountBatchItems(t *testing.T) {
+	completed, failed := countBatchItems([]storage.BatchItem{
+		{Status: storage.StatusCompleted},
+		{Status: storage.StatusFailed},
+		{Status: storage.StatusCancelled},
+		{Status: storage.StatusProcessing},
+	})
+	assert.Equal(t, 1, completed)
+	assert.Equal(t, 2, failed)
+}
@@ -0,0 +1,307 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// 가족 연결 (보호자 ↔ 어르신)
+// 보호자가 일회용 코드를 발급 → 어르신이 코드 입력 → 어르신이 승인하면
+// 보호자가 역할에 따라 어르신의 분석 기록/위험 알림을 조회할 수 있다.
+// ---------------------------------------------------------
+
+const (
+	familyInviteTTL    = 24 * time.Hour
+	familyCodeDigits   = 8 // 어르신이 전화로 듣고 입력하기 쉽게 숫자만 사용
+	familyCodeGroupLen = 4 // 1234-5678
+)
+
+// 역할별로 볼 수 있는 어르신 데이터
+var (
+	familyHistoryRoles = []string{storage.FamilyRoleGuardian, storage.FamilyRoleViewer}
+	familyAlertRoles   = []string{storage.FamilyRoleGuardian}
+)
+
+var (
+	errInvalidFamilyCode = errors.New("invalid or expired invite code")
+	errSelfFamilyLink    = errors.New("cannot link to yourself")
+	errFamilyLinkExists  = errors.New("already linked")
+)
+
+// CreateFamilyInvite: 보호자가 어르신에게 전달할 일회용 코드 발급
+func (s *AnalysisServer) CreateFamilyInvite(ctx context.Context, req *pb.CreateFamilyInviteRequest) (*pb.FamilyInvite, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role := req.Role
+	if role == "" {
+		role = storage.FamilyRoleGuardian
+	}
+	if role != storage.FamilyRoleGuardian && role != storage.FamilyRoleViewer {
+		return nil, status.Errorf(codes.InvalidArgument, "role must be guardian or viewer")
+	}
+
+	code, err := newFamilyCode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create invite")
+	}
+	inv := &storage.FamilyInvite{
+		GuardianID: uid,
+		CodeHash:   hashFamilyCode(code),
+		Role:       role,
+		ExpiresAt:  time.Now().Add(familyInviteTTL),
+	}
+	if err := s.store.CreateFamilyInvite(ctx, inv); err != nil {
+		log.Printf("Failed to create family invite for user %d: %v", uid, err)
+		return nil, status.Errorf(codes.Internal, "failed to create invite")
+	}
+
+	return &pb.FamilyInvite{
+		Code:      code[:familyCodeGroupLen] + "-" + code[familyCodeGroupLen:],
+		Role:      role,
+		ExpiresAt: inv.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ClaimFamilyInvite: 어르신이 코드를 입력하면 승인 대기 중인 연결 생성 (코드는 한 번만 사용)
+func (s *AnalysisServer) ClaimFamilyInvite(ctx context.Context, req *pb.ClaimFamilyInviteRequest) (*pb.FamilyLink, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	code := normalizeFamilyCode(req.Code)
+	if len(code) != familyCodeDigits {
+		return nil, status.Errorf(codes.InvalidArgument, "code must be %d digits", familyCodeDigits)
+	}
+
+	var link *storage.FamilyLink
+	err = s.store.WithTx(ctx, func(tx storage.Store) error {
+		inv, err := tx.ClaimFamilyInvite(ctx, hashFamilyCode(code), uid, time.Now())
+		if errors.Is(err, sql.ErrNoRows) {
+			return errInvalidFamilyCode
+		}
+		if err != nil {
+			return err
+		}
+		if inv.GuardianID == uid {
+			return errSelfFamilyLink
+		}
+		link, err = tx.RequestFamilyLink(ctx, inv.GuardianID, uid, inv.Role)
+		if err != nil {
+			return err
+		}
+		if link.Status == storage.FamilyActive {
+			return errFamilyLinkExists // 코드는 사용하지 않은 것으로 되돌림
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errInvalidFamilyCode):
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, errSelfFamilyLink):
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errFamilyLinkExists):
+		return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+	case err != nil:
+		log.Printf("Failed to claim family invite for user %d: %v", uid, err)
+		return nil, status.Errorf(codes.Internal, "failed to claim invite")
+	}
+	return s.toPBFamilyLink(ctx, link)
+}
+
+// ApproveFamilyLink: 어르신이 승인 대기 중인 연결을 승인/거절
+func (s *AnalysisServer) ApproveFamilyLink(ctx context.Context, req *pb.ApproveFamilyLinkRequest) (*pb.FamilyLink, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	link, err := s.familyLinkFor(ctx, req.LinkId, uid, false)
+	if err != nil {
+		return nil, err
+	}
+
+	next := storage.FamilyDeclined
+	if req.Approve {
+		next = storage.FamilyActive
+	}
+	return s.setFamilyLinkStatus(ctx, link, next, storage.FamilyPending)
+}
+
+// RevokeFamilyLink: 보호자 또는 어르신이 연결 해제 (승인 대기 중인 요청 취소 포함)
+func (s *AnalysisServer) RevokeFamilyLink(ctx context.Context, req *pb.RevokeFamilyLinkRequest) (*pb.FamilyLink, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	link, err := s.familyLinkFor(ctx, req.LinkId, uid, true)
+	if err != nil {
+		return nil, err
+	}
+	return s.setFamilyLinkStatus(ctx, link, storage.FamilyRevoked, storage.FamilyPending, storage.FamilyActive)
+}
+
+// ListFamilyLinks: 내가 보호자 또는 어르신인 연결 목록
+func (s *AnalysisServer) ListFamilyLinks(ctx context.Context, req *pb.ListFamilyLinksRequest) (*pb.ListFamilyLinksResponse, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.store.ListFamilyLinks(ctx, uid)
+	if err != nil {
+		log.Printf("Failed to list family links for user %d: %v", uid, err)
+		return nil, status.Errorf(codes.Internal, "failed to list family links")
+	}
+
+	resp := &pb.ListFamilyLinksResponse{}
+	for i := range links {
+		link, err := s.toPBFamilyLink(ctx, &links[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Links = append(resp.Links, link)
+	}
+	return resp, nil
+}
+
+// familyLinkFor: uid가 당사자인 연결 조회 (어르신만, 또는 guardianToo면 보호자도)
+// 다른 사람의 연결은 존재 여부도 알리지 않는다.
+func (s *AnalysisServer) familyLinkFor(ctx context.Context, linkID, uid int64, guardianToo bool) (*storage.FamilyLink, error) {
+	link, err := s.store.GetFamilyLink(ctx, linkID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "failed to load family link")
+	}
+	if err != nil || !(link.SeniorID == uid || (guardianToo && link.GuardianID == uid)) {
+		return nil, status.Errorf(codes.NotFound, "family link not found")
+	}
+	return link, nil
+}
+
+func (s *AnalysisServer) setFamilyLinkStatus(ctx context.Context, link *storage.FamilyLink, next string, from ...string) (*pb.FamilyLink, error) {
+	ok, err := s.store.SetFamilyLinkStatus(ctx, link.ID, next, from...)
+	if err != nil {
+		log.Printf("Failed to set family link %d to %s: %v", link.ID, next, err)
+		return nil, status.Errorf(codes.Internal, "failed to update family link")
+	}
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "family link is %s", link.Status)
+	}
+	log.Printf("Family link %d (guardian %d, senior %d): %s -> %s", link.ID, link.GuardianID, link.SeniorID, link.Status, next)
+
+	updated, err := s.store.GetFamilyLink(ctx, link.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load family link")
+	}
+	return s.toPBFamilyLink(ctx, updated)
+}
+
+// authorizeFamilyAccess: viewerID가 ownerID의 데이터를 볼 수 있는지 확인
+// 본인이거나, ownerID가 승인한 연결에서 roles 중 하나를 가진 보호자여야 한다.
+func (s *AnalysisServer) authorizeFamilyAccess(ctx context.Context, viewerID, ownerID int64, roles []string) error {
+	if viewerID == ownerID {
+		return nil
+	}
+	if viewerID == 0 {
+		return status.Errorf(codes.PermissionDenied, "not allowed to view this user's data")
+	}
+
+	link, err := s.store.GetFamilyLinkBetween(ctx, viewerID, ownerID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("Failed to check family link %d -> %d: %v", viewerID, ownerID, err)
+		return status.Errorf(codes.Internal, "failed to check family link")
+	}
+	if err != nil || link.Status != storage.FamilyActive {
+		return status.Errorf(codes.PermissionDenied, "not linked to this user")
+	}
+	for _, role := range roles {
+		if link.Role == role {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "family role %q does not allow this", link.Role)
+}
+
+// authorizeUploadAccess: 업로드 영상의 결과는 업로더와 그 보호자만 조회 (비회원 업로드는 제한 없음)
+// 유튜브 등 공개 영상의 결과는 누구나 조회할 수 있다.
+func (s *AnalysisServer) authorizeUploadAccess(ctx context.Context, uploadID uuid.NullUUID) error {
+	if !uploadID.Valid {
+		return nil
+	}
+	upload, err := s.store.GetUpload(ctx, uploadID.UUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load upload")
+	}
+	if upload.UserID == 0 {
+		return nil
+	}
+	return s.authorizeFamilyAccess(ctx, currentUserID(ctx), upload.UserID, familyHistoryRoles)
+}
+
+func (s *AnalysisServer) toPBFamilyLink(ctx context.Context, link *storage.FamilyLink) (*pb.FamilyLink, error) {
+	out := &pb.FamilyLink{
+		LinkId:    link.ID,
+		Role:      link.Role,
+		Status:    link.Status,
+		CreatedAt: link.CreatedAt.Format(time.RFC3339),
+	}
+	if link.ApprovedAt.Valid {
+		out.ApprovedAt = link.ApprovedAt.Time.Format(time.RFC3339)
+	}
+	for _, p := range []struct {
+		id  int64
+		dst **pb.User
+	}{{link.GuardianID, &out.Guardian}, {link.SeniorID, &out.Senior}} {
+		user, err := s.store.GetUserByID(ctx, p.id)
+		if err != nil {
+			log.Printf("Failed to load user %d for family link %d: %v", p.id, link.ID, err)
+			return nil, status.Errorf(codes.Internal, "failed to load family link")
+		}
+		*p.dst = &pb.User{Id: user.ID, Email: user.Email, Name: user.Name, PictureUrl: user.PictureURL}
+	}
+	return out, nil
+}
+
+// newFamilyCode: 암호학적 난수로 만든 숫자 코드
+func newFamilyCode() (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(familyCodeDigits), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", familyCodeDigits, n), nil
+}
+
+// normalizeFamilyCode: 하이픈/공백 등 숫자가 아닌 문자 제거
+func normalizeFamilyCode(code string) string {
+	var b strings.Builder
+	for _, r := range code {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func hashFamilyCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFamilyCode(t *testing.T) {
+	code, err := newFamilyCode()
+	require.NoError(t, err)
+	assert.Len(t, code, familyCodeDigits)
+	assert.Equal(t, code, normalizeFamilyCode(code[:4]+"-"+code[4:]+" "))
+	assert.Len(t, hashFamilyCode(code), 64)
+}
+
+func TestFamilyLinking(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, nil, nil)
+
+	users := map[string]*storage.User{}
+	ctxs := map[string]context.Context{}
+	for _, name := range []string{"senior", "guardian", "viewer", "stranger"} {
+		u, err := store.UpsertUser(ctx, name+"@example.com", name, "", "google-"+name)
+		require.NoError(t, err)
+		users[name] = u
+		ctxs[name] = auth.NewContext(ctx, &auth.Claims{UserID: u.ID, Email: u.Email})
+	}
+	ctxs["anonymous"] = ctx
+	senior := users["senior"]
+
+	// 어르신의 분석 기록과 위험 알림
+	require.NoError(t, store.CreateVideo(ctx, &storage.Video{VideoID: "video000001", Title: "Scam", ChannelID: "UCchannel"}))
+	job, err := store.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	require.NoError(t, store.AddHistory(ctx, senior.ID, job.JobID, "video000001", "Scam", ""))
+	require.NoError(t, store.SaveResult(ctx, job.JobID, 20, nil, nil))
+	require.NoError(t, store.AddWatch(ctx, &storage.ChannelWatch{UserID: senior.ID, ChannelID: "UCchannel", ChannelTitle: "Channel", UploadsPlaylistID: "UUchannel"}))
+	_, err = store.CreateWatchAlerts(ctx, "UCchannel", "video000001", "Scam", job.JobID, 20, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	// 어르신의 업로드 영상 결과
+	upload := &storage.Upload{UploadID: uuid.New(), UserID: senior.ID, S3Bucket: "bucket", S3Key: "uploads/call.mp4", Filename: "call.mp4"}
+	require.NoError(t, store.CreateUpload(ctx, upload))
+	uploadJob, err := store.CreateUploadJob(ctx, upload.UploadID)
+	require.NoError(t, err)
+
+	link := func(name, role string) *pb.FamilyLink {
+		invite, err := server.CreateFamilyInvite(ctxs[name], &pb.CreateFamilyInviteRequest{Role: role})
+		require.NoError(t, err)
+		claimed, err := server.ClaimFamilyInvite(ctxs["senior"], &pb.ClaimFamilyInviteRequest{Code: invite.Code})
+		require.NoError(t, err)
+		assert.Equal(t, storage.FamilyPending, claimed.Status)
+		assert.Equal(t, users[name].ID, claimed.Guardian.Id)
+		assert.Equal(t, senior.ID, claimed.Senior.Id)
+
+		// 코드는 한 번만 사용 가능
+		_, err = server.ClaimFamilyInvite(ctxs["stranger"], &pb.ClaimFamilyInviteRequest{Code: invite.Code})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		return claimed
+	}
+	history := func(name string) error {
+		_, err := server.GetUserHistory(ctxs[name], &pb.GetHistoryRequest{SeniorId: senior.ID})
+		return err
+	}
+	alerts := func(name string) error {
+		_, err := server.ListAlerts(ctxs[name], &pb.ListAlertsRequest{SeniorId: senior.ID})
+		return err
+	}
+	uploadResult := func(name string) error {
+		_, err := server.GetResult(ctxs[name], &pb.ResultRequest{JobId: uploadJob.JobID.String()})
+		return err
+	}
+
+	_, err = server.CreateFamilyInvite(ctxs["guardian"], &pb.CreateFamilyInviteRequest{Role: "admin"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	guardianLink := link("guardian", "")
+	assert.Equal(t, storage.FamilyRoleGuardian, guardianLink.Role)
+
+	// 승인 전에는 볼 수 없음, 승인은 어르신만
+	assert.Equal(t, codes.PermissionDenied, status.Code(history("guardian")))
+	_, err = server.ApproveFamilyLink(ctxs["guardian"], &pb.ApproveFamilyLinkRequest{LinkId: guardianLink.LinkId, Approve: true})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	approved, err := server.ApproveFamilyLink(ctxs["senior"], &pb.ApproveFamilyLinkRequest{LinkId: guardianLink.LinkId, Approve: true})
+	require.NoError(t, err)
+	assert.Equal(t, storage.FamilyActive, approved.Status)
+	assert.NotEmpty(t, approved.ApprovedAt)
+
+	resp, err := server.GetUserHistory(ctxs["guardian"], &pb.GetHistoryRequest{SeniorId: senior.ID})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "video000001", resp.Items[0].VideoId)
+	alertResp, err := server.ListAlerts(ctxs["guardian"], &pb.ListAlertsRequest{SeniorId: senior.ID})
+	require.NoError(t, err)
+	assert.Len(t, alertResp.Alerts, 1)
+	assert.NoError(t, uploadResult("guardian"))
+
+	// viewer는 기록만, 알림은 불가
+	viewerLink := link("viewer", storage.FamilyRoleViewer)
+	_, err = server.ApproveFamilyLink(ctxs["senior"], &pb.ApproveFamilyLinkRequest{LinkId: viewerLink.LinkId, Approve: true})
+	require.NoError(t, err)
+	assert.NoError(t, history("viewer"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(alerts("viewer")))
+
+	// 연결되지 않은 사용자
+	assert.Equal(t, codes.PermissionDenied, status.Code(history("stranger")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(alerts("stranger")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(uploadResult("stranger")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(uploadResult("anonymous")))
+	assert.NoError(t, uploadResult("senior"))
+
+	list, err := server.ListFamilyLinks(ctxs["senior"], &pb.ListFamilyLinksRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Links, 2)
+	assert.Equal(t, "guardian", list.Links[0].Guardian.Name)
+
+	// 해제하면 즉시 접근 불가, 이미 해제된 연결은 다시 해제 불가
+	_, err = server.RevokeFamilyLink(ctxs["stranger"], &pb.RevokeFamilyLinkRequest{LinkId: guardianLink.LinkId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	revoked, err := server.RevokeFamilyLink(ctxs["guardian"], &pb.RevokeFamilyLinkRequest{LinkId: guardianLink.LinkId})
+	require.NoError(t, err)
+	assert.Equal(t, storage.FamilyRevoked, revoked.Status)
+	assert.Equal(t, codes.PermissionDenied, status.Code(history("guardian")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(uploadResult("guardian")))
+	_, err = server.RevokeFamilyLink(ctxs["senior"], &pb.RevokeFamilyLinkRequest{LinkId: guardianLink.LinkId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 본인 코드는 사용할 수 없고, 실패한 요청은 코드를 소모하지 않음
+	invite, err := server.CreateFamilyInvite(ctxs["senior"], &pb.CreateFamilyInviteRequest{})
+	require.NoError(t, err)
+	_, err = server.ClaimFamilyInvite(ctxs["senior"], &pb.ClaimFamilyInviteRequest{Code: invite.Code})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.ClaimFamilyInvite(ctxs["guardian"], &pb.ClaimFamilyInviteRequest{Code: invite.Code})
+	assert.NoError(t, err)
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth" // [NEW] Auth 패키지 임포트
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/s3"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/worker"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
@@ -20,17 +22,27 @@ import (
 
 type AnalysisServer struct {
 	pb.UnimplementedAnalysisServiceServer
-	store    *storage.PostgresStore // PostgresStorage 구조체 이름 확인 필요 (보통 PostgresStore or Storage)
-	analyzer *worker.Analyzer
-	s3Client *s3.Client
+	store     storage.Store
+	analyzer  *worker.Analyzer
+	s3Client  *s3.Client
+	sources   *source.Registry
+	batchCfg  config.BatchConfig
+	plans     config.PlansConfig     // 요금제별 이용 한도
+	tokens    *auth.TokenService     // 로그인 세션 (액세스/리프레시 토큰)
+	providers *auth.ProviderRegistry // 로그인 제공자 (구글/카카오/네이버)
 }
 
 // 생성자
-func NewAnalysisServer(store *storage.PostgresStore, analyzer *worker.Analyzer, s3Client *s3.Client) *AnalysisServer {
+func NewAnalysisServer(store storage.Store, analyzer *worker.Analyzer, s3Client *s3.Client, sources *source.Registry, batchCfg config.BatchConfig, plans config.PlansConfig, tokens *auth.TokenService, providers *auth.ProviderRegistry) *AnalysisServer {
 	return &AnalysisServer{
-		store:    store,
-		analyzer: analyzer,
-		s3Client: s3Client,
+		store:     store,
+		analyzer:  analyzer,
+		s3Client:  s3Client,
+		sources:   sources,
+		batchCfg:  batchCfg,
+		plans:     plans,
+		tokens:    tokens,
+		providers: providers,
 	}
 }
 
@@ -40,56 +52,42 @@ func NewAnalysisServer(store *storage.PostgresStore, analyzer *worker.Analyzer,
 
 // StartAnalysis: 분석 요청 처리
 func (s *AnalysisServer) StartAnalysis(ctx context.Context, req *pb.AnalysisRequest) (*pb.AnalysisResponse, error) {
-	log.Printf("Received analysis request for URL: %s (User: %s)", req.VideoUrl, req.UserId)
+	// 로그인 유저 (비회원이면 0, 인터셉터가 검증한 토큰 기준)
+	userID := currentUserID(ctx)
+	log.Printf("Received analysis request for URL: %s (User: %d)", req.VideoUrl, userID)
 
-	// 1. URL 검증 및 Video ID 추출
-	videoID, err := youtube.ExtractVideoID(req.VideoUrl)
+	// 1. URL 검증 및 플랫폼/Video ID 추출
+	_, ref, err := s.sources.Resolve(req.VideoUrl)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid YouTube URL: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported video URL: %v", err)
 	}
+	videoID := ref.Key()
 
-	// 2. Video 정보 저장 (임시)
-	// 실제 메타데이터는 분석 워커가 채우겠지만, FK 제약 조건을 위해 먼저 생성
-	placeholderVideo := &storage.Video{
-		VideoID:     videoID,
-		Title:       "Processing...",
-		Channel:     "Unknown",
-		Description: "",
-		Duration:    0,
-		PublishedAt: time.Now(),
-	}
-
-	if err := s.store.CreateVideo(placeholderVideo); err != nil {
-		log.Printf("Video entry might already exist or DB error: %v", err)
-		// 이미 존재해도 진행 (분석 갱신)
+	plan, err := s.planFor(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Job 생성 (유저 ID 연동 포함)
-	// [NEW] req.UserId가 있다면 int64로 변환하여 Job에 기록 (비회원이면 0 or null)
-	var userID int64 = 0
-	if req.UserId != "" {
-		if uid, err := strconv.ParseInt(req.UserId, 10, 64); err == nil {
-			userID = uid
+	// 2. 일일 분석 횟수 차감 + Video(임시) / Job / History를 한 트랜잭션으로 생성
+	// (Job 생성에 실패하면 차감도 취소)
+	var job *storage.AnalysisJob
+	err = s.store.WithTx(ctx, func(tx storage.Store) error {
+		if err := useAnalyses(ctx, tx, userID, plan, 1); err != nil {
+			return err
 		}
+		var err error
+		job, err = createAnalysisJob(ctx, tx, ref, userID)
+		return err
+	})
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
 	}
-
-	// CreateJob 메서드가 userID를 받도록 수정되었다고 가정하거나, 
-	// 기존 CreateJob을 쓰고 별도로 History를 남기는 방식을 쓸 수 있습니다.
-	// 여기서는 기존 CreateJob을 호출하고, History 테이블에 추가로 기록하는 방식을 씁니다.
-	job, err := s.store.CreateJob(videoID)
 	if err != nil {
+		log.Printf("Failed to start analysis for %s: %v", videoID, err)
 		return nil, status.Errorf(codes.Internal, "failed to create job: %v", err)
 	}
 
-	// [NEW] 유저가 로그인 상태라면 History 테이블에도 기록
-	if userID > 0 {
-		// History 추가는 실패해도 분석은 진행 (로그만 남김)
-		if err := s.store.AddHistory(userID, videoID, "Processing...", ""); err != nil {
-			log.Printf("Failed to save history for user %d: %v", userID, err)
-		}
-	}
-
-	// 4. 분석 옵션 설정
+	// 3. 분석 옵션 설정 (댓글 수는 요금제 상한까지)
 	analyzeComments := true
 	commentCount := 10
 	if req.Options != nil {
@@ -98,8 +96,9 @@ func (s *AnalysisServer) StartAnalysis(ctx context.Context, req *pb.AnalysisRequ
 			commentCount = int(req.Options.TopCommentsCount)
 		}
 	}
+	commentCount = plan.commentLimit(commentCount)
 
-	// 5. 비동기 분석 시작
+	// 4. 비동기 분석 시작
 	s.analyzer.Analyze(context.Background(), job.JobID, req.VideoUrl, analyzeComments, commentCount)
 
 	return &pb.AnalysisResponse{
@@ -109,6 +108,42 @@ func (s *AnalysisServer) StartAnalysis(ctx context.Context, req *pb.AnalysisRequ
 	}, nil
 }
 
+// createAnalysisJob: Video(임시) / Job / History를 한 트랜잭션으로 생성 (userID가 0이면 기록 없음)
+// 실제 메타데이터는 분석 워커가 채우지만, FK 제약 조건을 위해 Video를 먼저 생성
+func createAnalysisJob(ctx context.Context, store storage.Store, ref *source.Ref, userID int64) (*storage.AnalysisJob, error) {
+	videoID := ref.Key()
+	placeholderVideo := &storage.Video{
+		VideoID:     videoID,
+		Platform:    ref.Platform,
+		Title:       "Processing...",
+		Channel:     "Unknown",
+		Description: "",
+		Duration:    0,
+		PublishedAt: time.Now(),
+	}
+
+	var job *storage.AnalysisJob
+	err := store.WithTx(ctx, func(tx storage.Store) error {
+		// 이미 존재하면 제목/설명만 갱신 (재분석)
+		if err := tx.CreateVideo(ctx, placeholderVideo); err != nil {
+			return fmt.Errorf("save video: %w", err)
+		}
+
+		var err error
+		if job, err = tx.CreateJob(ctx, videoID, userID); err != nil {
+			return fmt.Errorf("create job: %w", err)
+		}
+
+		if userID > 0 {
+			if err := tx.AddHistory(ctx, userID, job.JobID, videoID, "Processing...", ref.ThumbnailURL); err != nil {
+				return fmt.Errorf("save history for user %d: %w", userID, err)
+			}
+		}
+		return nil
+	})
+	return job, err
+}
+
 // StreamProgress: 실시간 진행 상황 스트리밍
 func (s *AnalysisServer) StreamProgress(req *pb.ProgressRequest, stream pb.AnalysisService_StreamProgressServer) error {
 	jobID, err := uuid.Parse(req.JobId)
@@ -117,13 +152,36 @@ func (s *AnalysisServer) StreamProgress(req *pb.ProgressRequest, stream pb.Analy
 	}
 
 	log.Printf("Client subscribed to job: %s via Redis", jobID)
+	ctx := stream.Context()
 
-	// 초기 상태 전송
-	job, err := s.store.GetJob(jobID)
+	job, err := s.store.GetJob(ctx, jobID)
 	if err != nil {
 		return status.Errorf(codes.NotFound, "job not found")
 	}
 
+	// DB 상태를 다시 읽기 전에 먼저 구독해야 그 사이에 끝난 Job의 마지막 이벤트를 놓치지 않음
+	var progressChan <-chan worker.ProgressEvent
+	if !jobFinished(job.Status) {
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		progressChan, err = s.analyzer.SubscribeToJob(subCtx, req.JobId)
+		if err != nil {
+			log.Printf("Failed to subscribe to Redis: %v", err)
+			return status.Errorf(codes.Internal, "failed to subscribe")
+		}
+
+		// 구독 전에 Job이 끝났다면 complete/error 이벤트는 이미 지나갔으므로 최종 상태만 보내고 종료
+		job, err = s.store.GetJob(ctx, jobID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "job not found")
+		}
+		if jobFinished(job.Status) {
+			cancel()
+			progressChan = nil
+		}
+	}
+
+	// 초기 상태 전송
 	initialEvent := &pb.ProgressEvent{
 		JobId:     jobID.String(),
 		Type:      "log",
@@ -135,22 +193,8 @@ func (s *AnalysisServer) StreamProgress(req *pb.ProgressRequest, stream pb.Analy
 		return err
 	}
 
-	if job.Status == "completed" || job.Status == "failed" { // 상수 대신 문자열 리터럴 사용 (storage 패키지 상수가 있다면 교체)
-		stream.Send(&pb.ProgressEvent{
-			JobId:     jobID.String(),
-			Type:      "complete",
-			Message:   "Job is already finished.",
-			Progress:  100,
-			Timestamp: time.Now().Format(time.RFC3339),
-		})
-		return nil
-	}
-
-	// Redis 구독
-	progressChan, err := s.analyzer.SubscribeToJob(stream.Context(), req.JobId)
-	if err != nil {
-		log.Printf("Failed to subscribe to Redis: %v", err)
-		return status.Errorf(codes.Internal, "failed to subscribe")
+	if progressChan == nil {
+		return stream.Send(s.finalProgressEvent(ctx, job))
 	}
 
 	for event := range progressChan {
@@ -158,8 +202,9 @@ func (s *AnalysisServer) StreamProgress(req *pb.ProgressRequest, stream pb.Analy
 			JobId:     event.JobID.String(),
 			Type:      event.Type,
 			Message:   event.Message,
-			Progress:  int32(event.Progress),
-			Timestamp: time.Now().Format(time.RFC3339),
+			Progress:    int32(event.Progress),
+			SafetyScore: int32(event.SafetyScore),
+			Timestamp:   time.Now().Format(time.RFC3339),
 		}
 
 		if err := stream.Send(resp); err != nil {
@@ -174,6 +219,87 @@ func (s *AnalysisServer) StreamProgress(req *pb.ProgressRequest, stream pb.Analy
 	return nil
 }
 
+// jobFinished: 더 이상 진행 이벤트가 오지 않는 상태
+func jobFinished(jobStatus string) bool {
+	return jobStatus == storage.StatusCompleted || jobStatus == storage.StatusFailed || jobStatus == storage.StatusCancelled
+}
+
+// finalProgressEvent: 이미 끝난 Job에 대한 마지막 이벤트 (결과가 있으면 판정 포함)
+func (s *AnalysisServer) finalProgressEvent(ctx context.Context, job *storage.AnalysisJob) *pb.ProgressEvent {
+	event := &pb.ProgressEvent{
+		JobId:     job.JobID.String(),
+		Type:      "complete",
+		Message:   fmt.Sprintf("Job is already %s.", job.Status),
+		Progress:  100,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	switch job.Status {
+	case storage.StatusFailed:
+		event.Type = "error"
+		event.Progress = int32(job.Progress)
+		if job.ErrorMessage.Valid {
+			event.Message = job.ErrorMessage.String
+		}
+	case storage.StatusCompleted:
+		if result, err := s.store.GetResult(ctx, job.JobID); err == nil {
+			event.SafetyScore = int32(result.SafetyScore)
+			event.Message = fmt.Sprintf("Job is already completed (safety score %d, %s).", result.SafetyScore, storage.VerdictForScore(result.SafetyScore))
+		}
+	}
+	return event
+}
+
+// authorizeJobCancel: Job 소유자, 소유자의 보호자(가족 연결 guardian), 관리자만 취소 가능
+// 소유자가 없는 Job(비회원/워치리스트)은 관리자만 취소할 수 있다.
+func (s *AnalysisServer) authorizeJobCancel(ctx context.Context, job *storage.AnalysisJob) error {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return err
+	}
+	if claims, ok := auth.FromContext(ctx); ok && claims.Role == auth.RoleAdmin {
+		return nil
+	}
+	if job.UserID == 0 {
+		return status.Errorf(codes.PermissionDenied, "not allowed to cancel this job")
+	}
+	return s.authorizeFamilyAccess(ctx, uid, job.UserID, familyAlertRoles)
+}
+
+// CancelAnalysis: 진행 중인 분석/라이브 모니터링 중지
+// 워커는 다음 단계(또는 다음 스캔) 전에 상태를 확인하고 종료한다.
+func (s *AnalysisServer) CancelAnalysis(ctx context.Context, req *pb.CancelRequest) (*pb.CancelResponse, error) {
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job ID")
+	}
+
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "job not found")
+	}
+	if err := s.authorizeJobCancel(ctx, job); err != nil {
+		return nil, err
+	}
+
+	switch job.Status {
+	case storage.StatusCompleted, storage.StatusFailed, storage.StatusCancelled:
+		return &pb.CancelResponse{
+			JobId:   jobID.String(),
+			Message: fmt.Sprintf("Job is already %s", job.Status),
+		}, nil
+	}
+
+	if err := s.store.UpdateJobStatus(ctx, jobID, storage.StatusCancelled, job.Progress); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel job: %v", err)
+	}
+
+	return &pb.CancelResponse{
+		JobId:     jobID.String(),
+		Cancelled: true,
+		Message:   "Analysis cancelled",
+	}, nil
+}
+
 // GetResult: 결과 조회
 func (s *AnalysisServer) GetResult(ctx context.Context, req *pb.ResultRequest) (*pb.AnalysisResult, error) {
 	jobID, err := uuid.Parse(req.JobId)
@@ -181,12 +307,15 @@ func (s *AnalysisServer) GetResult(ctx context.Context, req *pb.ResultRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "invalid job ID")
 	}
 
-	job, err := s.store.GetJob(jobID)
+	job, err := s.store.GetJob(ctx, jobID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "job not found")
 	}
+	if err := s.authorizeUploadAccess(ctx, job.UploadID); err != nil {
+		return nil, err
+	}
 
-	result, err := s.store.GetResult(jobID)
+	result, err := s.store.GetResult(ctx, jobID)
 	if err != nil {
 		// 결과가 아직 없으면 상태만 리턴
 		return &pb.AnalysisResult{
@@ -195,10 +324,10 @@ func (s *AnalysisServer) GetResult(ctx context.Context, req *pb.ResultRequest) (
 		}, nil
 	}
 
-	comments, _ := s.store.GetComments(job.VideoID, 10)
+	comments, _ := s.store.GetComments(ctx, job.VideoID, 10)
     
     // Video Metadata 가져오기 (Video 테이블)
-    video, _ := s.store.GetVideo(job.VideoID) // GetVideo 메서드 필요
+    video, _ := s.store.GetVideo(ctx, job.VideoID) // GetVideo 메서드 필요
 
 	pbResult := &pb.AnalysisResult{
 		JobId:          jobID.String(),
@@ -207,15 +336,26 @@ func (s *AnalysisServer) GetResult(ctx context.Context, req *pb.ResultRequest) (
 		GeminiResponse: string(result.GeminiResponse), // JSONB []byte -> string
 		Status:         job.Status,
 		CreatedAt:      job.CreatedAt.Format(time.RFC3339),
-        // Metadata 매핑
-        Metadata: &pb.VideoMetadata{
-            Title: video.Title,
-            Description: video.Description,
-            Channel: video.Channel,
-            Duration: video.Duration,
-            ViewCount: video.ViewCount,
-            PublishedAt: video.PublishedAt.Format(time.RFC3339),
-        },
+	}
+
+	if video != nil {
+		// Metadata 매핑
+		pbResult.Metadata = &pb.VideoMetadata{
+			Title:        video.Title,
+			Description:  video.Description,
+			Channel:      video.Channel,
+			Duration:     video.Duration,
+			ViewCount:    video.ViewCount,
+			PublishedAt:  video.PublishedAt.Format(time.RFC3339),
+			ChannelId:    video.ChannelID,
+			ThumbnailUrl: video.ThumbnailURL,
+			Platform:     video.Platform,
+		}
+		if video.ChannelID != "" {
+			if channel, err := s.store.GetChannel(ctx, video.ChannelID); err == nil {
+				pbResult.Metadata.ChannelInfo = toPBChannelInfo(channel)
+			}
+		}
 	}
 
 	if job.CompletedAt.Valid {
@@ -234,119 +374,128 @@ func (s *AnalysisServer) GetResult(ctx context.Context, req *pb.ResultRequest) (
 	return pbResult, nil
 }
 
+// toPBChannelInfo: 저장된 채널 정보를 평판 신호와 함께 변환
+func toPBChannelInfo(c *storage.Channel) *pb.ChannelInfo {
+	assessment := youtube.AssessChannel(&youtube.ChannelInfo{
+		ChannelID:             c.ChannelID,
+		Title:                 c.Title,
+		SubscriberCount:       c.SubscriberCount,
+		VideoCount:            c.VideoCount,
+		HiddenSubscriberCount: c.HiddenSubscriberCount,
+		Verified:              c.Verified,
+		CreatedAt:             c.ChannelCreatedAt,
+	}, time.Now())
+
+	out := &pb.ChannelInfo{
+		ChannelId:       c.ChannelID,
+		Title:           c.Title,
+		CustomUrl:       c.CustomURL,
+		Country:         c.Country,
+		SubscriberCount: c.SubscriberCount,
+		VideoCount:      c.VideoCount,
+		Verified:        c.Verified,
+		RiskSignals:     assessment.Signals,
+	}
+	// 개설일을 모르면 빈 문자열 (0001-01-01 대신)
+	if !c.ChannelCreatedAt.IsZero() {
+		out.CreatedAt = c.ChannelCreatedAt.Format(time.RFC3339)
+	}
+	return out
+}
+
 // ---------------------------------------------------------
 // [NEW] Auth & User 관련 메서드 구현
 // ---------------------------------------------------------
 
 // LoginWithGoogle: 구글 토큰을 검증하고 자체 JWT 발급
 func (s *AnalysisServer) LoginWithGoogle(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	// 1. Google ID Token 검증 (Client ID는 환경변수에서 가져오는 것이 좋음)
-    // 지금은 빈 문자열("")로 두면 Aud 검사를 건너뛸 수 있으나 보안상 Client ID를 넣는게 좋습니다.
-	payload, err := auth.VerifyGoogleToken(ctx, req.IdToken, "") 
-	if err != nil {
-		log.Printf("Google token verification failed: %v", err)
-		return nil, status.Errorf(codes.Unauthenticated, "Invalid Google token")
+	// 구글 ID 토큰 검증(서명/발급자/허용된 클라이언트 ID/이메일 인증) 후
+	// 같은 인증 이메일의 카카오/네이버 사용자가 있으면 그 계정에 연결
+	provider, ok := s.providers.Get(auth.ProviderGoogle)
+	if !ok {
+		return nil, status.Errorf(codes.Unavailable, "Google login is not configured")
 	}
-
-	email := payload.Claims["email"].(string)
-	name := payload.Claims["name"].(string)
-	picture := payload.Claims["picture"].(string)
-	providerID := payload.Subject // Google Unique ID
-
-	// 2. DB에 유저 저장 (없으면 생성, 있으면 업데이트)
-	user, err := s.store.UpsertUser(email, name, picture, providerID)
-	if err != nil {
-		log.Printf("DB Upsert failed: %v", err)
-		return nil, status.Errorf(codes.Internal, "Database error")
+	if req.IdToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id_token is required")
 	}
-
-	// 3. JWT 토큰 발급
-	token, err := auth.GenerateJWT(user.ID, user.Email)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Token generation failed")
-	}
-
-	return &pb.LoginResponse{
-		AccessToken: token,
-		User: &pb.User{
-			Id:         user.ID,
-			Email:      user.Email,
-			Name:       user.Name,
-			PictureUrl: user.PictureURL,
-		},
-	}, nil
+	return s.loginWith(ctx, provider, auth.Credential{IDToken: req.IdToken})
 }
 
 // GetUserProfile: 내 프로필 및 구독 정보 조회
 func (s *AnalysisServer) GetUserProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.UserProfileResponse, error) {
-	uid, err := strconv.ParseInt(req.UserId, 10, 64)
+	uid, err := requireUserID(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid user ID")
+		return nil, err
 	}
 
 	// 유저 조회
-	user, err := s.store.GetUserByID(uid)
+	user, err := s.store.GetUserByID(ctx, uid)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "User not found")
 	}
 
 	// 구독 정보 조회
-	sub, err := s.store.GetSubscription(uid)
+	sub, err := s.store.GetSubscription(ctx, uid)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Subscription check failed")
 	}
-    
-    // sql.NullTime 처리
-    startDate := ""
-    if sub.StartDate.Valid { startDate = sub.StartDate.Time.Format(time.RFC3339) }
-    endDate := ""
-    if sub.EndDate.Valid { endDate = sub.EndDate.Time.Format(time.RFC3339) }
+
+	// 연결된 로그인 계정
+	identities, err := s.store.ListIdentities(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Identity lookup failed")
+	}
+	providers := make([]string, 0, len(identities))
+	for _, i := range identities {
+		providers = append(providers, i.Provider)
+	}
+
+	// 요금제 한도와 오늘 사용량
+	plan, err := s.planFor(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	used, err := s.store.GetDailyUsage(ctx, uid, usageAnalyses, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Usage lookup failed")
+	}
 
 	return &pb.UserProfileResponse{
+		LinkedProviders: providers,
 		User: &pb.User{
 			Id:         user.ID,
 			Email:      user.Email,
 			Name:       user.Name,
 			PictureUrl: user.PictureURL,
+			Role:       user.Role,
 		},
-		Subscription: &pb.Subscription{
-			PlanType:  sub.PlanType,
-			StartDate: startDate,
-			EndDate:   endDate,
+		Subscription: toPBSubscription(sub),
+		Entitlements: &pb.Entitlements{
+			PlanType:          plan.Name,
+			AnalysesPerDay:    int32(plan.AnalysesPerDay),
+			AnalysesUsedToday: int32(used),
+			UsageResetsAt:     nextUsageReset(now).Format(time.RFC3339),
+			MaxComments:       int32(plan.MaxComments),
+			Batch:             plan.Batch,
+			Watchlist:         plan.Watchlist,
+			Priority:          plan.Priority,
 		},
 	}, nil
 }
 
-// GetUserHistory: 내 분석 기록 조회
-func (s *AnalysisServer) GetUserHistory(ctx context.Context, req *pb.GetHistoryRequest) (*pb.HistoryResponse, error) {
-	uid, err := strconv.ParseInt(req.UserId, 10, 64)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid user ID")
+func toPBSubscription(sub *storage.Subscription) *pb.Subscription {
+	out := &pb.Subscription{PlanType: sub.PlanType, Status: sub.Status}
+	if sub.StartDate.Valid {
+		out.StartDate = sub.StartDate.Time.Format(time.RFC3339)
 	}
-
-	limit := int(req.PageSize)
-	if limit <= 0 { limit = 10 }
-	offset := int((req.Page - 1)) * limit
-	if offset < 0 { offset = 0 }
-
-	historyList, err := s.store.GetHistory(uid, limit, offset)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to fetch history")
-	}
-
-	var pbItems []*pb.HistoryItem
-	for _, h := range historyList {
-		pbItems = append(pbItems, &pb.HistoryItem{
-			VideoId:      h.VideoID,
-			VideoTitle:   h.VideoTitle,
-			ThumbnailUrl: h.ThumbnailURL,
-			AnalyzedAt:   h.CreatedAt.Format(time.RFC3339),
-			SafetyScore:  int32(h.SafetyScore),
-			JobId:        h.JobID,
-		})
+	if sub.EndDate.Valid {
+		out.EndDate = sub.EndDate.Time.Format(time.RFC3339)
 	}
-
-	return &pb.HistoryResponse{Items: pbItems}, nil
+	if sub.CanceledAt.Valid {
+		out.CanceledAt = sub.CanceledAt.Time.Format(time.RFC3339)
+	}
+	return out
 }
 
 // ---------------------------------------------------------
@@ -360,22 +509,47 @@ func (s *AnalysisServer) GetAnalysisResult(ctx context.Context, req *pb.Analysis
 		return nil, status.Errorf(codes.InvalidArgument, "video_id is required")
 	}
 
-	// analysis_results 테이블에서 조회
-	result, err := s.store.GetAnalysisResultByVideoID(videoID)
+	// 영상(video_id) 또는 업로드(upload_id)의 최신 결과 조회
+	result, err := s.store.GetLatestResult(ctx, videoID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "analysis result not found")
 	}
+	if err := s.authorizeUploadAccess(ctx, result.UploadID); err != nil {
+		return nil, err
+	}
 
-	return &pb.AnalysisResultResponse{
+	resp := toPBAnalysisResultResponse(result)
+	// 라이브 모니터링 중인 Job은 결과가 갱신될 수 있으므로 Job 상태를 그대로 전달
+	if job, err := s.store.GetJob(ctx, result.JobID); err == nil {
+		resp.Status = job.Status
+	}
+
+	return resp, nil
+}
+
+// toPBAnalysisResultResponse: 결과 한 건을 응답으로 변환 (상태는 completed, 필요하면 Job 상태로 덮어씀)
+func toPBAnalysisResultResponse(result *storage.AnalysisResult) *pb.AnalysisResultResponse {
+	resp := &pb.AnalysisResultResponse{
 		VideoId:      result.VideoID,
-		AudioScore:   float32(result.AudioScore),
-		VideoScore:   float32(result.VideoScore),
-		ContextScore: float32(result.ContextScore),
-		FinalScore:   int32(result.FinalScore),
-		Status:       result.Status,
+		AudioScore:   float32(result.AudioScore.Float64),
+		VideoScore:   float32(result.VideoScore.Float64),
+		ContextScore: float32(result.ContextScore.Float64),
+		FinalScore:   int32(result.RiskScore()),
+		SafetyScore:  int32(result.SafetyScore),
+		Verdict:      storage.VerdictForScore(result.SafetyScore),
+		JobId:        result.JobID.String(),
+		Source:       result.Source,
+		Status:       storage.StatusCompleted,
 		CreatedAt:    result.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:    result.UpdatedAt.Format(time.RFC3339),
-	}, nil
+	}
+	if result.UploadID.Valid {
+		resp.UploadId = result.UploadID.UUID.String()
+		if resp.VideoId == "" {
+			resp.VideoId = resp.UploadId // 업로드 결과는 upload_id로 조회
+		}
+	}
+	return resp
 }
 
 // ---------------------------------------------------------
@@ -391,11 +565,12 @@ func (s *AnalysisServer) GetUploadURL(ctx context.Context, req *pb.UploadURLRequ
 		return nil, status.Errorf(codes.InvalidArgument, "content_type is required")
 	}
 
+	uid := currentUserID(ctx) // 비회원이면 0
 	presignReq := s3.PresignedURLRequest{
 		Filename:    req.Filename,
 		ContentType: req.ContentType,
 		FileSize:    req.FileSize,
-		UserID:      req.UserId,
+		UserID:      strconv.FormatInt(uid, 10),
 	}
 
 	presignResp, err := s.s3Client.GeneratePresignedURL(ctx, presignReq)
@@ -404,10 +579,35 @@ func (s *AnalysisServer) GetUploadURL(ctx context.Context, req *pb.UploadURLRequ
 		return nil, status.Errorf(codes.Internal, "failed to generate upload URL")
 	}
 
+	// 업로드와 분석 Job 기록 (ML 파이프라인이 이 Job에 결과를 저장)
+	upload := &storage.Upload{
+		UploadID:    uuid.MustParse(presignResp.UploadID),
+		S3Bucket:    presignResp.Bucket,
+		S3Key:       presignResp.S3Key,
+		Filename:    req.Filename,
+		ContentType: req.ContentType,
+		SizeBytes:   req.FileSize,
+		UserID:      uid,
+	}
+	var job *storage.AnalysisJob
+	err = s.store.WithTx(ctx, func(tx storage.Store) error {
+		if err := tx.CreateUpload(ctx, upload); err != nil {
+			return fmt.Errorf("record upload: %w", err)
+		}
+		var err error
+		job, err = tx.CreateUploadJob(ctx, upload.UploadID)
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to record upload: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate upload URL")
+	}
+
 	return &pb.UploadURLResponse{
 		UploadUrl: presignResp.UploadURL,
 		S3Key:     presignResp.S3Key,
 		ExpiresIn: presignResp.ExpiresIn,
 		UploadId:  presignResp.UploadID,
+		JobId:     job.JobID.String(),
 	}, nil
 }
\ No newline at end of file
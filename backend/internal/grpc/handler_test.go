@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToPBChannelInfo(t *testing.T) {
+	created := time.Date(2016, 3, 1, 0, 0, 0, 0, time.UTC)
+	info := toPBChannelInfo(&storage.Channel{ChannelID: "UC1", Title: "요리", Verified: true, SubscriberCount: 5000, ChannelCreatedAt: created})
+	assert.Equal(t, "2016-03-01T00:00:00Z", info.CreatedAt)
+
+	// 개설일을 모르면 빈 문자열
+	info = toPBChannelInfo(&storage.Channel{ChannelID: "UC2", Title: "요리"})
+	assert.Empty(t, info.CreatedAt)
+}
+
+func TestCancelAnalysis(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, nil, nil)
+
+	ctxs := map[string]context.Context{"anonymous": ctx}
+	users := map[string]*storage.User{}
+	for _, name := range []string{"senior", "guardian", "viewer", "stranger", "admin"} {
+		u, err := store.UpsertUser(ctx, name+"@example.com", name, "", "google-"+name)
+		require.NoError(t, err)
+		users[name] = u
+		role := auth.RoleUser
+		if name == "admin" {
+			role = auth.RoleAdmin
+		}
+		ctxs[name] = auth.NewContext(ctx, &auth.Claims{UserID: u.ID, Email: u.Email, Role: role})
+	}
+	for name, role := range map[string]string{"guardian": storage.FamilyRoleGuardian, "viewer": storage.FamilyRoleViewer} {
+		link, err := store.RequestFamilyLink(ctx, users[name].ID, users["senior"].ID, role)
+		require.NoError(t, err)
+		_, err = store.SetFamilyLinkStatus(ctx, link.ID, storage.FamilyActive, storage.FamilyPending)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, store.CreateVideo(ctx, &storage.Video{VideoID: "video000001", Title: "Video"}))
+	cancel := func(name string, ownerID int64) (*storage.AnalysisJob, error) {
+		job, err := store.CreateJob(ctx, "video000001", ownerID)
+		require.NoError(t, err)
+		_, err = server.CancelAnalysis(ctxs[name], &pb.CancelRequest{JobId: job.JobID.String()})
+		got, getErr := store.GetJob(ctx, job.JobID)
+		require.NoError(t, getErr)
+		return got, err
+	}
+
+	// 소유자, 보호자, 관리자만 취소 가능
+	senior := users["senior"].ID
+	for _, name := range []string{"senior", "guardian", "admin"} {
+		job, err := cancel(name, senior)
+		require.NoError(t, err, name)
+		assert.Equal(t, storage.StatusCancelled, job.Status, name)
+	}
+	for name, code := range map[string]codes.Code{"anonymous": codes.Unauthenticated, "stranger": codes.PermissionDenied, "viewer": codes.PermissionDenied} {
+		job, err := cancel(name, senior)
+		assert.Equal(t, code, status.Code(err), name)
+		assert.Equal(t, storage.StatusPending, job.Status, name)
+	}
+
+	// 소유자가 없는 Job (비회원/워치리스트)은 관리자만
+	job, err := cancel("stranger", 0)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, storage.StatusPending, job.Status)
+	job, err = cancel("admin", 0)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCancelled, job.Status)
+}
+
+// progressRecorder: 보낸 진행 이벤트를 기록하는 StreamProgress 스트림
+type progressRecorder struct {
+	fakeServerStream
+	events []*pb.ProgressEvent
+}
+
+func (r *progressRecorder) Send(event *pb.ProgressEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func TestStreamProgressFinishedJob(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, nil, nil)
+	require.NoError(t, store.CreateVideo(ctx, &storage.Video{VideoID: "video000001", Title: "Video"}))
+
+	stream := func(jobID string) []*pb.ProgressEvent {
+		rec := &progressRecorder{fakeServerStream: fakeServerStream{ctx: ctx}}
+		require.NoError(t, server.StreamProgress(&pb.ProgressRequest{JobId: jobID}, rec))
+		return rec.events
+	}
+
+	// 끝난 Job은 구독 없이 최종 상태(판정 포함)를 보내고 종료
+	done, err := store.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveResult(ctx, done.JobID, 30, nil, nil))
+	require.NoError(t, store.UpdateJobStatus(ctx, done.JobID, storage.StatusCompleted, 100))
+	events := stream(done.JobID.String())
+	require.Len(t, events, 2)
+	assert.Equal(t, "log", events[0].Type)
+	assert.Equal(t, "complete", events[1].Type)
+	assert.EqualValues(t, 30, events[1].SafetyScore)
+	assert.Contains(t, events[1].Message, storage.VerdictDanger)
+
+	failed, err := store.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateJobError(ctx, failed.JobID, "Failed to get video metadata"))
+	events = stream(failed.JobID.String())
+	require.Len(t, events, 2)
+	assert.Equal(t, "error", events[1].Type)
+	assert.Equal(t, "Failed to get video metadata", events[1].Message)
+
+	rec := &progressRecorder{fakeServerStream: fakeServerStream{ctx: ctx}}
+	err = server.StreamProgress(&pb.ProgressRequest{JobId: uuid.New().String()}, rec)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,200 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// 분석 기록 (키셋 페이지네이션)
+// ---------------------------------------------------------
+
+const (
+	defaultHistoryPageSize = 10
+	maxHistoryPageSize     = 100
+)
+
+// GetUserHistory: 내 분석 기록 조회 (최신순, page_token으로 다음 페이지)
+func (s *AnalysisServer) GetUserHistory(ctx context.Context, req *pb.GetHistoryRequest) (*pb.HistoryResponse, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = defaultHistoryPageSize
+	}
+	if limit > maxHistoryPageSize {
+		limit = maxHistoryPageSize
+	}
+
+	after, err := decodeHistoryToken(req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	filter, err := historyFilter(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// senior_id: 연결된 보호자가 어르신의 기록을 조회
+	if req.SeniorId != 0 {
+		if err := s.authorizeFamilyAccess(ctx, uid, req.SeniorId, familyHistoryRoles); err != nil {
+			return nil, err
+		}
+		uid = req.SeniorId
+	}
+
+	page, err := s.store.GetHistory(ctx, uid, filter, after, limit)
+	if err != nil {
+		log.Printf("Failed to fetch history for user %d: %v", uid, err)
+		return nil, status.Errorf(codes.Internal, "Failed to fetch history")
+	}
+
+	resp := &pb.HistoryResponse{
+		NextPageToken: encodeHistoryToken(page.Next),
+		TotalCount:    int32(page.Total),
+	}
+	for _, h := range page.Items {
+		item := &pb.HistoryItem{
+			VideoId:      h.VideoID,
+			VideoTitle:   h.VideoTitle,
+			ThumbnailUrl: h.ThumbnailURL,
+			AnalyzedAt:   h.CreatedAt.Format(time.RFC3339),
+			SafetyScore:  int32(h.SafetyScore),
+			JobId:        h.JobID,
+			JobStatus:    h.JobStatus,
+			CheckCount:   int32(h.CheckCount),
+		}
+		if h.HasResult {
+			item.Verdict = storage.VerdictForScore(h.SafetyScore)
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
+
+// DeleteHistoryItem: 내 기록에서 영상 하나 삭제
+func (s *AnalysisServer) DeleteHistoryItem(ctx context.Context, req *pb.DeleteHistoryRequest) (*pb.DeleteHistoryResponse, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.VideoId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "video_id is required")
+	}
+
+	deleted, err := s.store.DeleteHistory(ctx, uid, req.VideoId)
+	if err != nil {
+		log.Printf("Failed to delete history %s for user %d: %v", req.VideoId, uid, err)
+		return nil, status.Errorf(codes.Internal, "Failed to delete history")
+	}
+	return &pb.DeleteHistoryResponse{Deleted: deleted}, nil
+}
+
+// ClearHistory: 내 기록 전체 삭제
+func (s *AnalysisServer) ClearHistory(ctx context.Context, req *pb.ClearHistoryRequest) (*pb.ClearHistoryResponse, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.ClearHistory(ctx, uid)
+	if err != nil {
+		log.Printf("Failed to clear history for user %d: %v", uid, err)
+		return nil, status.Errorf(codes.Internal, "Failed to clear history")
+	}
+	log.Printf("Cleared %d history entries for user %d", n, uid)
+	return &pb.ClearHistoryResponse{DeletedCount: int32(n)}, nil
+}
+
+// historyFilter: 요청의 필터 필드를 검증해 storage 필터로 변환
+func historyFilter(req *pb.GetHistoryRequest) (storage.HistoryFilter, error) {
+	var f storage.HistoryFilter
+
+	if req.MinScore != nil {
+		v := int(*req.MinScore)
+		f.MinScore = &v
+	}
+	if req.MaxScore != nil {
+		v := int(*req.MaxScore)
+		f.MaxScore = &v
+	}
+	if f.MinScore != nil && f.MaxScore != nil && *f.MinScore > *f.MaxScore {
+		return f, fmt.Errorf("min_score must not exceed max_score")
+	}
+
+	if req.Verdict != "" {
+		if _, _, ok := storage.VerdictScoreRange(req.Verdict); !ok {
+			return f, fmt.Errorf("verdict must be danger, caution or safe")
+		}
+		f.Verdict = req.Verdict
+	}
+
+	var err error
+	if f.From, err = parseHistoryTime(req.From); err != nil {
+		return f, fmt.Errorf("invalid from: %v", err)
+	}
+	if f.To, err = parseHistoryTime(req.To); err != nil {
+		return f, fmt.Errorf("invalid to: %v", err)
+	}
+	return f, nil
+}
+
+// parseHistoryTime: RFC3339 또는 날짜(YYYY-MM-DD, UTC 자정)
+func parseHistoryTime(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", v)
+}
+
+// 페이지 토큰: 마지막 항목의 (created_at 마이크로초, id)를 base64로 감싼 값
+// 클라이언트는 내용을 해석하지 않고 그대로 돌려보낸다.
+
+func encodeHistoryToken(c *storage.HistoryCursor) string {
+	if c == nil {
+		return ""
+	}
+	raw := fmt.Sprintf("%d:%d", c.CreatedAt.UnixMicro(), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeHistoryToken(token string) (*storage.HistoryCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	micros, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed token")
+	}
+	us, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	cursorID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.HistoryCursor{CreatedAt: time.UnixMicro(us).UTC(), ID: cursorID}, nil
+}
@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHistoryToken(t *testing.T) {
+	cursor := &storage.HistoryCursor{CreatedAt: time.Date(2024, 5, 1, 9, 30, 0, 123456000, time.UTC), ID: 42}
+
+	decoded, err := decodeHistoryToken(encodeHistoryToken(cursor))
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	assert.Empty(t, encodeHistoryToken(nil))
+	none, err := decodeHistoryToken("")
+	assert.NoError(t, err)
+	assert.Nil(t, none)
+
+	_, err = decodeHistoryToken("not a token!")
+	assert.Error(t, err)
+}
+
+func TestGetUserHistory(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, nil, nil)
+
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "google-1")
+	require.NoError(t, err)
+	for i, score := range []int{15, 55, 95} {
+		videoID := "video00000" + strconv.Itoa(i)
+		require.NoError(t, store.CreateVideo(ctx, &storage.Video{VideoID: videoID, Title: videoID}))
+		job, err := store.CreateJob(ctx, videoID, 0)
+		require.NoError(t, err)
+		require.NoError(t, store.AddHistory(ctx, user.ID, job.JobID, videoID, "Processing...", ""))
+		require.NoError(t, store.SaveResult(ctx, job.JobID, score, nil, nil))
+	}
+	ctx = auth.NewContext(ctx, &auth.Claims{UserID: user.ID, Email: user.Email})
+
+	// 페이지를 따라가면 모든 항목을 한 번씩 최신순으로 받음
+	var videos []string
+	token := ""
+	for {
+		resp, err := server.GetUserHistory(ctx, &pb.GetHistoryRequest{PageSize: 2, PageToken: token})
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, resp.TotalCount)
+		for _, item := range resp.Items {
+			videos = append(videos, item.VideoId)
+		}
+		if token = resp.NextPageToken; token == "" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"video000002", "video000001", "video000000"}, videos)
+
+	resp, err := server.GetUserHistory(ctx, &pb.GetHistoryRequest{Verdict: "caution"})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "video000001", resp.Items[0].VideoId)
+	assert.Equal(t, "caution", resp.Items[0].Verdict)
+
+	_, err = server.GetUserHistory(ctx, &pb.GetHistoryRequest{From: "yesterday"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.GetUserHistory(ctx, &pb.GetHistoryRequest{PageToken: "%%%"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDeleteAndClearHistory(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, nil, nil)
+
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "google-1")
+	require.NoError(t, err)
+	for _, videoID := range []string{"video000001", "video000001", "video000002"} {
+		job, err := store.CreateJob(ctx, videoID, 0)
+		require.NoError(t, err)
+		require.NoError(t, store.AddHistory(ctx, user.ID, job.JobID, videoID, "Processing...", ""))
+	}
+
+	// 인증 없이는 거부
+	_, err = server.ClearHistory(ctx, &pb.ClearHistoryRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	authed := auth.NewContext(ctx, &auth.Claims{UserID: user.ID, Email: user.Email})
+
+	// 재검사한 영상은 한 항목으로 합쳐짐
+	resp, err := server.GetUserHistory(authed, &pb.GetHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.EqualValues(t, 1, resp.Items[0].CheckCount)
+	assert.EqualValues(t, 2, resp.Items[1].CheckCount)
+
+	deleted, err := server.DeleteHistoryItem(authed, &pb.DeleteHistoryRequest{VideoId: "video000001"})
+	require.NoError(t, err)
+	assert.True(t, deleted.Deleted)
+
+	cleared, err := server.ClearHistory(authed, &pb.ClearHistoryRequest{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, cleared.DeletedCount)
+}
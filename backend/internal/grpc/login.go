@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// 소셜 로그인 (구글/카카오/네이버) 및 계정 연결
+// ---------------------------------------------------------
+
+var (
+	errVerifiedEmailRequired = errors.New("verified email required")
+	errEmailTaken            = errors.New("email belongs to another account")
+)
+
+// LoginWithProvider: 카카오/네이버 인가 코드(또는 구글 ID 토큰)로 로그인
+func (s *AnalysisServer) LoginWithProvider(ctx context.Context, req *pb.ProviderLoginRequest) (*pb.LoginResponse, error) {
+	provider, ok := s.providers.Get(req.Provider)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported login provider %q (available: %v)", req.Provider, s.providers.Names())
+	}
+	return s.loginWith(ctx, provider, auth.Credential{
+		IDToken:     req.IdToken,
+		Code:        req.AuthorizationCode,
+		RedirectURI: req.RedirectUri,
+		State:       req.State,
+	})
+}
+
+// loginWith: 제공자 인증 → 사용자 찾기/연결/생성 → 세션 발급
+func (s *AnalysisServer) loginWith(ctx context.Context, provider auth.Provider, cred auth.Credential) (*pb.LoginResponse, error) {
+	if cred.IDToken == "" && cred.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id_token or authorization_code is required")
+	}
+
+	identity, err := provider.Authenticate(ctx, cred)
+	if err != nil {
+		log.Printf("%s login verification failed: %v", provider.Name(), err)
+		switch {
+		case errors.Is(err, auth.ErrEmailNotVerified):
+			return nil, status.Errorf(codes.PermissionDenied, "account email is not verified")
+		case errors.Is(err, auth.ErrGoogleNotConfigured):
+			return nil, status.Errorf(codes.Unavailable, "Google login is not configured")
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "invalid %s credential", provider.Name())
+	}
+
+	var resp *pb.LoginResponse
+	err = s.store.WithTx(ctx, func(tx storage.Store) error {
+		user, err := resolveUser(ctx, tx, identity)
+		if err != nil {
+			return err
+		}
+		resp, err = s.issueSession(ctx, tx, user, uuid.Nil)
+		return err
+	})
+	switch {
+	case errors.Is(err, errVerifiedEmailRequired):
+		return nil, status.Errorf(codes.FailedPrecondition, "a verified email is required to sign up with %s", provider.Name())
+	case errors.Is(err, errEmailTaken):
+		return nil, status.Errorf(codes.AlreadyExists, "this email is already registered; sign in with the linked account first")
+	case err != nil:
+		log.Printf("%s login failed: %v", provider.Name(), err)
+		return nil, status.Errorf(codes.Internal, "login failed")
+	}
+	return resp, nil
+}
+
+// resolveUser: 제공자 계정에 연결된 사용자를 찾고, 없으면 인증된 이메일로
+// 기존 사용자에 연결하거나 새 사용자를 만든다.
+// 인증되지 않은 이메일로 연결하면 남의 계정을 가로챌 수 있으므로 허용하지 않는다.
+func resolveUser(ctx context.Context, tx storage.Store, identity *auth.Identity) (*storage.User, error) {
+	user, err := tx.GetUserByIdentity(ctx, identity.Provider, identity.Subject)
+	switch {
+	case err == nil:
+	case errors.Is(err, sql.ErrNoRows):
+		if identity.Email == "" || !identity.EmailVerified {
+			if identity.Email != "" {
+				if _, err := tx.GetUserByEmail(ctx, identity.Email); err == nil {
+					return nil, errEmailTaken
+				}
+			}
+			return nil, errVerifiedEmailRequired
+		}
+		// 같은 인증 이메일의 사용자가 있으면 연결, 없으면 새로 생성 (UpsertUser)
+	default:
+		return nil, err
+	}
+
+	email := identity.Email
+	if user != nil {
+		email = user.Email
+	}
+	// 프로필은 최근 로그인한 계정 기준 (빈 값은 유지)
+	user, err = tx.UpsertUser(ctx, email, identity.Name, identity.Picture, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.LinkIdentity(ctx, user.ID, identity.Provider, identity.Subject, identity.Email); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeProvider returns the identity registered for each authorization code.
+type fakeProvider struct {
+	name       string
+	identities map[string]*auth.Identity
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) Authenticate(ctx context.Context, cred auth.Credential) (*auth.Identity, error) {
+	identity, ok := p.identities[cred.Code]
+	if !ok {
+		return nil, assert.AnError
+	}
+	identity.Provider = p.name
+	return identity, nil
+}
+
+func TestLoginWithProviderLinksByVerifiedEmail(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	kakao := &fakeProvider{name: auth.ProviderKakao, identities: map[string]*auth.Identity{
+		"senior":     {Subject: "1001", Email: "senior@example.com", EmailVerified: true, Name: "김할머니"},
+		"unverified": {Subject: "1002", Email: "senior@example.com"},
+		"no-email":   {Subject: "1003"},
+	}}
+	naver := &fakeProvider{name: auth.ProviderNaver, identities: map[string]*auth.Identity{
+		"senior": {Subject: "naver-1", Email: "senior@example.com", EmailVerified: true},
+		"new":    {Subject: "naver-2", Email: "new@example.com", EmailVerified: true, Name: "Park"},
+	}}
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, newTestTokens(t), auth.NewProviderRegistry(kakao, naver))
+	login := func(provider, code string) (*pb.LoginResponse, error) {
+		return server.LoginWithProvider(ctx, &pb.ProviderLoginRequest{Provider: provider, AuthorizationCode: code})
+	}
+
+	first, err := login("kakao", "senior")
+	require.NoError(t, err)
+	assert.Equal(t, "senior@example.com", first.User.Email)
+	assert.Equal(t, "김할머니", first.User.Name)
+
+	// 같은 인증 이메일의 네이버 계정은 같은 사용자에 연결, 빈 이름은 기존 값 유지
+	linked, err := login("naver", "senior")
+	require.NoError(t, err)
+	assert.Equal(t, first.User.Id, linked.User.Id)
+	assert.Equal(t, "김할머니", linked.User.Name)
+
+	again, err := login("kakao", "senior")
+	require.NoError(t, err)
+	assert.Equal(t, first.User.Id, again.User.Id)
+
+	other, err := login("naver", "new")
+	require.NoError(t, err)
+	assert.NotEqual(t, first.User.Id, other.User.Id)
+
+	// 인증되지 않은 이메일로는 기존 계정에 연결하거나 가입할 수 없음
+	_, err = login("kakao", "unverified")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = login("kakao", "no-email")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = login("kakao", "bad-code")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = login("facebook", "senior")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = login("kakao", "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	claims := &auth.Claims{UserID: first.User.Id}
+	profile, err := server.GetUserProfile(auth.NewContext(ctx, claims), &pb.GetProfileRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kakao", "naver"}, profile.LinkedProviders)
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// 요금제별 이용 한도 (subscriptions.plan_type → config.plans)
+// ---------------------------------------------------------
+
+// usageAnalyses: 분석한 영상 수 (단일 + 일괄)
+const usageAnalyses = "analyses"
+
+// userPlan: 요청한 사용자의 요금제
+type userPlan struct {
+	Name string
+	config.PlanConfig
+}
+
+// apiKeyPlan: 파트너 API 키는 요금제 대신 키별 일일 상한(daily_quota)으로 관리
+// (일괄 분석은 영상 수만큼 차감, useAnalyses 참고)
+var apiKeyPlan = &userPlan{Name: "api_key", PlanConfig: config.PlanConfig{Batch: true}}
+
+// planFor: 사용자의 요금제 (비회원과 구독이 만료된 사용자는 free)
+func (s *AnalysisServer) planFor(ctx context.Context, userID int64) (*userPlan, error) {
+	if _, ok := auth.APIKeyFromContext(ctx); ok {
+		return apiKeyPlan, nil
+	}
+	name := config.DefaultPlan
+	if userID > 0 {
+		sub, err := s.store.GetSubscription(ctx, userID)
+		if err != nil {
+			log.Printf("Failed to load subscription of user %d: %v", userID, err)
+			return nil, status.Errorf(codes.Internal, "subscription lookup failed")
+		}
+		if !sub.EndDate.Valid || sub.EndDate.Time.After(time.Now()) {
+			name = sub.PlanType
+		}
+	}
+	return &userPlan{Name: name, PlanConfig: s.plans.Plan(name)}, nil
+}
+
+// useAnalyses: 오늘 분석 횟수에 n편을 더한다. 한도를 넘으면 ResourceExhausted
+// 비회원은 사용자 대신 클라이언트 IP 단위로 같은 요금제(free) 한도를 적용한다.
+func useAnalyses(ctx context.Context, store storage.Store, userID int64, plan *userPlan, n int) error {
+	now := time.Now().UTC()
+	if caller, ok := auth.APIKeyFromContext(ctx); ok {
+		return useAPIKeyAnalyses(ctx, store, caller, now, n)
+	}
+
+	var used int
+	var ok bool
+	var err error
+	if userID == 0 {
+		ip := clientIP(ctx)
+		if used, ok, err = store.UseAnonymousUsage(ctx, ip, usageAnalyses, now, n, plan.AnalysesPerDay); err != nil {
+			log.Printf("Failed to record usage of anonymous client %s: %v", ip, err)
+		}
+	} else if used, ok, err = store.UseDailyUsage(ctx, userID, usageAnalyses, now, n, plan.AnalysesPerDay); err != nil {
+		log.Printf("Failed to record usage of user %d: %v", userID, err)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check usage")
+	}
+	if !ok {
+		return status.Errorf(codes.ResourceExhausted, "%s plan allows %d analyses per day (%d used), resets at %s",
+			plan.Name, plan.AnalysesPerDay, used, nextUsageReset(now).Format(time.RFC3339))
+	}
+	return nil
+}
+
+// useAPIKeyAnalyses: API 키는 영상 한 편을 요청 한 번으로 센다.
+// 인터셉터가 요청마다 1회를 이미 차감했으므로 나머지 n-1편만 더한다.
+func useAPIKeyAnalyses(ctx context.Context, store storage.APIKeyStore, caller *auth.APIKeyCaller, now time.Time, n int) error {
+	if n <= 1 {
+		return nil
+	}
+	used, ok, err := store.UseAPIKey(ctx, caller.KeyID, now, n-1, caller.DailyQuota)
+	if err != nil {
+		log.Printf("Failed to record usage of API key %d: %v", caller.KeyID, err)
+		return status.Errorf(codes.Internal, "failed to check usage")
+	}
+	if !ok {
+		return status.Errorf(codes.ResourceExhausted, "daily quota of %d requests exceeded (%d used, %d videos requested), resets at %s",
+			caller.DailyQuota, used, n, nextUsageReset(now).Format(time.RFC3339))
+	}
+	return nil
+}
+
+// commentLimit: 요청한 댓글 수를 요금제 상한으로 제한
+func (p *userPlan) commentLimit(requested int) int {
+	if p.MaxComments > 0 && requested > p.MaxComments {
+		return p.MaxComments
+	}
+	return requested
+}
+
+// nextUsageReset: 일일 사용량이 초기화되는 시각 (다음 UTC 자정)
+func nextUsageReset(now time.Time) time.Time {
+	return now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
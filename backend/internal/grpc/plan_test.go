@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var testPlans = config.PlansConfig{
+	"free": {AnalysesPerDay: 3, MaxComments: 10},
+	"pro":  {MaxComments: 100, Batch: true, Watchlist: true, Priority: true},
+}
+
+func TestPlanQuota(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, testPlans, nil, nil)
+
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "")
+	require.NoError(t, err)
+	plan, err := server.planFor(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "free", plan.Name)
+	assert.Equal(t, 10, plan.commentLimit(50))
+	assert.Equal(t, 5, plan.commentLimit(5))
+
+	// 일괄 분석은 영상 수만큼 차감, 한도를 넘으면 통째로 거부
+	require.NoError(t, useAnalyses(ctx, store, user.ID, plan, 2))
+	err = useAnalyses(ctx, store, user.ID, plan, 2)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "resets at ")
+	require.NoError(t, useAnalyses(ctx, store, user.ID, plan, 1))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(useAnalyses(ctx, store, user.ID, plan, 1)))
+
+	// 비회원은 클라이언트 IP별로 free 한도 적용
+	anonymous, err := server.planFor(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "free", anonymous.Name)
+	fromIP := func(ip string) context.Context {
+		return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 51234}})
+	}
+	err = useAnalyses(fromIP("203.0.113.7"), store, 0, anonymous, 10)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.NoError(t, useAnalyses(fromIP("203.0.113.7"), store, 0, anonymous, 3))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(useAnalyses(fromIP("203.0.113.7"), store, 0, anonymous, 1)))
+	require.NoError(t, useAnalyses(fromIP("203.0.113.8"), store, 0, anonymous, 1))
+
+	userCtx := auth.NewContext(ctx, &auth.Claims{UserID: user.ID})
+	profile, err := server.GetUserProfile(userCtx, &pb.GetProfileRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "free", profile.Entitlements.PlanType)
+	assert.EqualValues(t, 3, profile.Entitlements.AnalysesPerDay)
+	assert.EqualValues(t, 3, profile.Entitlements.AnalysesUsedToday)
+	assert.True(t, strings.HasSuffix(profile.Entitlements.UsageResetsAt, "T00:00:00Z"))
+	assert.False(t, profile.Entitlements.Batch)
+}
+
+func TestPlanFeatures(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, testPlans, nil, nil)
+
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "")
+	require.NoError(t, err)
+	userCtx := auth.NewContext(ctx, &auth.Claims{UserID: user.ID})
+
+	// free 요금제는 일괄 분석/채널 알림 불가 (외부 API 호출 전에 거부)
+	_, err = server.StartBatchAnalysis(userCtx, &pb.BatchAnalysisRequest{Url: "https://www.youtube.com/playlist?list=PL1234567890"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.StartBatchAnalysis(ctx, &pb.BatchAnalysisRequest{Url: "https://www.youtube.com/playlist?list=PL1234567890"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.AddWatch(userCtx, &pb.AddWatchRequest{ChannelUrl: "https://www.youtube.com/@bank"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// API 키는 요금제 대신 키별 상한
+	keyCtx := auth.NewAPIKeyContext(ctx, &auth.APIKeyCaller{KeyID: 1, Name: "Bank"})
+	plan, err := server.planFor(keyCtx, 0)
+	require.NoError(t, err)
+	assert.True(t, plan.Batch)
+
+	// 요금제 미설정이면 제한 없음
+	unlimited := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, nil, nil)
+	plan, err = unlimited.planFor(ctx, user.ID)
+	require.NoError(t, err)
+	assert.True(t, plan.Batch)
+	assert.Equal(t, 500, plan.commentLimit(500))
+}
+
+func TestAPIKeyBatchQuota(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	key := &storage.APIKey{Name: "Bank", Prefix: "sg_bank", KeyHash: strings.Repeat("a", 64), DailyQuota: 4}
+	require.NoError(t, store.CreateAPIKey(ctx, key))
+	keyCtx := auth.NewAPIKeyContext(ctx, &auth.APIKeyCaller{KeyID: key.ID, Name: key.Name, DailyQuota: key.DailyQuota})
+	now := time.Now().UTC()
+	used := func() int {
+		n, ok, err := store.UseAPIKey(ctx, key.ID, now, 0, key.DailyQuota)
+		require.NoError(t, err)
+		require.True(t, ok)
+		return n
+	}
+
+	// 인터셉터가 요청을 1회 차감한 뒤, 일괄 분석은 나머지 영상 수만큼 차감
+	_, _, err := store.UseAPIKey(ctx, key.ID, now, 1, key.DailyQuota)
+	require.NoError(t, err)
+	err = useAnalyses(keyCtx, store, 0, apiKeyPlan, 5)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "5 videos requested")
+	assert.Equal(t, 1, used())
+
+	require.NoError(t, useAnalyses(keyCtx, store, 0, apiKeyPlan, 3))
+	assert.Equal(t, 3, used())
+	// 단일 분석은 인터셉터의 차감으로 충분
+	require.NoError(t, useAnalyses(keyCtx, store, 0, apiKeyPlan, 1))
+	assert.Equal(t, 3, used())
+}
+
+func TestClientIP(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "unknown", clientIP(ctx))
+
+	direct := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 51234}})
+	assert.Equal(t, "192.0.2.10", clientIP(direct))
+	assert.Equal(t, "203.0.113.7", clientIP(context.WithValue(direct, clientIPKey{}, "203.0.113.7")))
+
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/16", "192.0.2.10"})
+	require.NoError(t, err)
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+
+	// 신뢰하지 않는 연결은 x-forwarded-for를 무시
+	md := metadata.Pairs("x-forwarded-for", "203.0.113.7")
+	untrusted := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.9"), Port: 51234}})
+	assert.Equal(t, "198.51.100.9", resolveClientIP(metadata.NewIncomingContext(untrusted, md), trusted))
+	assert.Equal(t, "192.0.2.10", resolveClientIP(metadata.NewIncomingContext(direct, md), nil))
+	assert.Equal(t, "192.0.2.10", resolveClientIP(direct, trusted))
+
+	// 신뢰하는 프록시를 거치면 뒤에서부터 신뢰하지 않는 첫 주소, 잘못된 값이면 연결 주소
+	for header, want := range map[string]string{
+		"203.0.113.7":                         "203.0.113.7",
+		"198.51.100.1, 203.0.113.7":           "203.0.113.7",
+		"198.51.100.1, 203.0.113.7, 10.0.3.4": "203.0.113.7",
+		"10.0.1.1, 10.0.3.4":                  "10.0.1.1",
+		"2001:db8::1":                         "2001:db8::1",
+		"198.51.100.1, not-an-ip":             "192.0.2.10",
+	} {
+		md := metadata.Pairs("x-forwarded-for", header)
+		assert.Equal(t, want, resolveClientIP(metadata.NewIncomingContext(direct, md), trusted), header)
+	}
+}
@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// 세션 (리프레시 토큰 교체 / 로그아웃)
+// ---------------------------------------------------------
+
+var errRefreshReused = errors.New("refresh token reused")
+
+// issueSession: 액세스 토큰과 리프레시 토큰 발급
+// familyID가 uuid.Nil이면 새 로그인, 아니면 같은 세션의 토큰 교체
+func (s *AnalysisServer) issueSession(ctx context.Context, tx storage.Store, user *storage.User, familyID uuid.UUID) (*pb.LoginResponse, error) {
+	if familyID == uuid.Nil {
+		familyID = uuid.New()
+	}
+
+	refresh, err := s.tokens.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.CreateRefreshToken(ctx, &storage.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: refresh.Hash,
+		ExpiresAt: refresh.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	access, err := s.tokens.AccessToken(user.ID, user.Email, user.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginResponse{
+		AccessToken:  access,
+		RefreshToken: refresh.Token,
+		ExpiresIn:    int32(s.tokens.AccessTokenTTL() / time.Second),
+		User: &pb.User{
+			Id:         user.ID,
+			Email:      user.Email,
+			Name:       user.Name,
+			PictureUrl: user.PictureURL,
+			Role:       user.Role,
+		},
+	}, nil
+}
+
+// Refresh: 리프레시 토큰을 새 토큰으로 교체하고 액세스 토큰 재발급
+// 이미 교체된 토큰이 다시 쓰이면 탈취로 보고 같은 세션의 토큰을 모두 폐기한다.
+func (s *AnalysisServer) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.LoginResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	stored, err := s.store.GetRefreshToken(ctx, auth.HashRefreshToken(req.RefreshToken))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+	}
+	if err != nil {
+		log.Printf("Failed to look up refresh token: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to refresh session")
+	}
+	if stored.RevokedAt.Valid || !stored.ExpiresAt.After(time.Now()) {
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token expired or revoked")
+	}
+
+	user, err := s.store.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	var resp *pb.LoginResponse
+	err = s.store.WithTx(ctx, func(tx storage.Store) error {
+		ok, err := tx.MarkRefreshTokenUsed(ctx, stored.ID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errRefreshReused
+		}
+		resp, err = s.issueSession(ctx, tx, user, stored.FamilyID)
+		return err
+	})
+	if errors.Is(err, errRefreshReused) {
+		n, err := s.store.RevokeRefreshFamily(ctx, stored.FamilyID)
+		if err != nil {
+			log.Printf("Failed to revoke session family %s: %v", stored.FamilyID, err)
+		}
+		log.Printf("Refresh token reuse for user %d: revoked %d session token(s)", stored.UserID, n)
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token already used")
+	}
+	if err != nil {
+		log.Printf("Failed to rotate refresh token for user %d: %v", stored.UserID, err)
+		return nil, status.Errorf(codes.Internal, "failed to refresh session")
+	}
+	return resp, nil
+}
+
+// Logout: 현재 액세스 토큰과 이 기기의 세션 폐기 (all_devices면 모든 세션)
+func (s *AnalysisServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "login required")
+	}
+
+	revoked := 0
+	if req.RefreshToken != "" {
+		stored, err := s.store.GetRefreshToken(ctx, auth.HashRefreshToken(req.RefreshToken))
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			// 이미 만료/삭제된 세션이면 액세스 토큰만 폐기
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "failed to log out")
+		case stored.UserID != claims.UserID:
+			return nil, status.Errorf(codes.PermissionDenied, "refresh token belongs to another user")
+		default:
+			n, err := s.store.RevokeRefreshFamily(ctx, stored.FamilyID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to log out")
+			}
+			revoked += n
+		}
+	}
+
+	if req.AllDevices {
+		n, err := s.store.RevokeUserRefreshTokens(ctx, claims.UserID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to log out")
+		}
+		revoked += n
+		if err := s.tokens.RevokeUser(ctx, claims.UserID); err != nil {
+			log.Printf("Failed to denylist tokens of user %d: %v", claims.UserID, err)
+			return nil, status.Errorf(codes.Unavailable, "failed to revoke access tokens")
+		}
+	} else if err := s.tokens.RevokeAccessToken(ctx, claims); err != nil {
+		log.Printf("Failed to denylist token %s: %v", claims.ID, err)
+		return nil, status.Errorf(codes.Unavailable, "failed to revoke access token")
+	}
+
+	return &pb.LogoutResponse{RevokedSessions: int32(revoked)}, nil
+}
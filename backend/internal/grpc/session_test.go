@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/auth/googletest"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRefreshAndLogout(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	tokens := newTestTokens(t)
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, tokens, nil)
+
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "google-1")
+	require.NoError(t, err)
+	phone, err := server.issueSession(ctx, store, user, uuid.Nil)
+	require.NoError(t, err)
+	tablet, err := server.issueSession(ctx, store, user, uuid.Nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 15*60, phone.ExpiresIn)
+
+	// 교체: 새 리프레시 토큰을 받고 이전 토큰은 더 이상 사용 불가
+	rotated, err := server.Refresh(ctx, &pb.RefreshRequest{RefreshToken: phone.RefreshToken})
+	require.NoError(t, err)
+	assert.NotEqual(t, phone.RefreshToken, rotated.RefreshToken)
+	assert.Equal(t, user.Email, rotated.User.Email)
+	claims, err := tokens.Validate(ctx, rotated.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, claims.UserID)
+
+	// 이미 쓴 토큰 재사용 = 탈취로 간주, 교체된 새 토큰까지 폐기
+	_, err = server.Refresh(ctx, &pb.RefreshRequest{RefreshToken: phone.RefreshToken})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.Refresh(ctx, &pb.RefreshRequest{RefreshToken: rotated.RefreshToken})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.Refresh(ctx, &pb.RefreshRequest{RefreshToken: "unknown"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// 다른 기기 세션은 영향 없음
+	tablet, err = server.Refresh(ctx, &pb.RefreshRequest{RefreshToken: tablet.RefreshToken})
+	require.NoError(t, err)
+
+	// 로그아웃: 현재 액세스 토큰과 세션 폐기
+	tabletClaims, err := tokens.Validate(ctx, tablet.AccessToken)
+	require.NoError(t, err)
+	resp, err := server.Logout(auth.NewContext(ctx, tabletClaims), &pb.LogoutRequest{RefreshToken: tablet.RefreshToken})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resp.RevokedSessions)
+	_, err = tokens.Validate(ctx, tablet.AccessToken)
+	assert.Error(t, err)
+	_, err = server.Refresh(ctx, &pb.RefreshRequest{RefreshToken: tablet.RefreshToken})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestLogoutAllDevices(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	tokens := newTestTokens(t)
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, tokens, nil)
+
+	user, err := store.UpsertUser(ctx, "senior@example.com", "Kim", "", "google-1")
+	require.NoError(t, err)
+	var sessions []*pb.LoginResponse
+	for i := 0; i < 3; i++ {
+		s, err := server.issueSession(ctx, store, user, uuid.Nil)
+		require.NoError(t, err)
+		sessions = append(sessions, s)
+	}
+
+	claims, err := tokens.Validate(ctx, sessions[0].AccessToken)
+	require.NoError(t, err)
+	resp, err := server.Logout(auth.NewContext(ctx, claims), &pb.LogoutRequest{AllDevices: true})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, resp.RevokedSessions)
+
+	for _, s := range sessions {
+		_, err := tokens.Validate(ctx, s.AccessToken)
+		assert.Error(t, err)
+		_, err = server.Refresh(ctx, &pb.RefreshRequest{RefreshToken: s.RefreshToken})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	_, err = server.Logout(ctx, &pb.LogoutRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestLoginWithGoogle(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	tokens := newTestTokens(t)
+	issuer := googletest.NewIssuer(t)
+	google := auth.NewGoogleVerifier(config.GoogleConfig{WebClientID: "web-client", CertsURL: issuer.URL})
+	server := NewAnalysisServer(store, nil, nil, nil, config.BatchConfig{}, nil, tokens, auth.NewProviderRegistry(google))
+
+	resp, err := server.LoginWithGoogle(ctx, &pb.LoginRequest{IdToken: issuer.Sign(t, googletest.Claims("web-client"))})
+	require.NoError(t, err)
+	assert.Equal(t, "senior@example.com", resp.User.Email)
+	assert.NotEmpty(t, resp.RefreshToken)
+
+	// 다른 앱(클라이언트 ID)용 토큰은 거부
+	_, err = server.LoginWithGoogle(ctx, &pb.LoginRequest{IdToken: issuer.Sign(t, googletest.Claims("other-client"))})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	unverified := googletest.Claims("web-client")
+	unverified["email_verified"] = false
+	_, err = server.LoginWithGoogle(ctx, &pb.LoginRequest{IdToken: issuer.Sign(t, unverified)})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// 이름/사진 클레임이 없어도 패닉 없이 로그인
+	noProfile := googletest.Claims("web-client")
+	delete(noProfile, "name")
+	delete(noProfile, "picture")
+	_, err = server.LoginWithGoogle(ctx, &pb.LoginRequest{IdToken: issuer.Sign(t, noProfile)})
+	assert.NoError(t, err)
+
+	_, err = server.LoginWithGoogle(ctx, &pb.LoginRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
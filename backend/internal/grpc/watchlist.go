@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
+	pb "github.com/vanillaturtlechips/silver-guardian/backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------------------------------------------------
+// [NEW] 채널 구독 (새 업로드 자동 분석 및 알림)
+// ---------------------------------------------------------
+
+const defaultAlertLimit = 50
+
+// AddWatch: 채널 구독 추가 (구독 시점 이후 업로드부터 분석)
+func (s *AnalysisServer) AddWatch(ctx context.Context, req *pb.AddWatchRequest) (*pb.WatchedChannel, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := s.planFor(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.Watchlist {
+		return nil, status.Errorf(codes.PermissionDenied, "channel alerts are not included in the %s plan", plan.Name)
+	}
+
+	ref, err := youtube.ParseCollectionURL(req.ChannelUrl)
+	if err != nil || ref.Kind != youtube.CollectionChannel {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel URL")
+	}
+
+	channel, err := s.analyzer.ResolveCollection(ctx, ref)
+	if err != nil {
+		log.Printf("Failed to resolve channel %s: %v", req.ChannelUrl, err)
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	watch := &storage.ChannelWatch{
+		UserID:            uid,
+		ChannelID:         channel.ChannelID,
+		ChannelTitle:      channel.Title,
+		UploadsPlaylistID: channel.PlaylistID,
+	}
+	if err := s.store.AddWatch(ctx, watch); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add watch: %v", err)
+	}
+
+	return toPBWatchedChannel(watch), nil
+}
+
+// RemoveWatch: 채널 구독 해제
+func (s *AnalysisServer) RemoveWatch(ctx context.Context, req *pb.RemoveWatchRequest) (*pb.RemoveWatchResponse, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.store.RemoveWatch(ctx, uid, req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove watch")
+	}
+	return &pb.RemoveWatchResponse{Removed: removed}, nil
+}
+
+// ListWatches: 내 구독 채널 목록
+func (s *AnalysisServer) ListWatches(ctx context.Context, req *pb.ListWatchesRequest) (*pb.ListWatchesResponse, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	watches, err := s.store.ListWatches(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch watches")
+	}
+
+	resp := &pb.ListWatchesResponse{}
+	for i := range watches {
+		resp.Channels = append(resp.Channels, toPBWatchedChannel(&watches[i]))
+	}
+	return resp, nil
+}
+
+// ListAlerts: 구독 채널의 위험 업로드와 (보호자가 있는 경우) 직접 분석한 위험/주의 영상 알림 목록
+func (s *AnalysisServer) ListAlerts(ctx context.Context, req *pb.ListAlertsRequest) (*pb.ListAlertsResponse, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > defaultAlertLimit {
+		limit = defaultAlertLimit
+	}
+
+	// senior_id: 어르신의 위험 알림은 guardian 역할의 보호자만 조회
+	if req.SeniorId != 0 {
+		if err := s.authorizeFamilyAccess(ctx, uid, req.SeniorId, familyAlertRoles); err != nil {
+			return nil, err
+		}
+		uid = req.SeniorId
+	}
+
+	alerts, err := s.store.ListWatchAlerts(ctx, uid, req.UnreadOnly, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch alerts")
+	}
+
+	resp := &pb.ListAlertsResponse{}
+	for _, a := range alerts {
+		pbAlert := &pb.WatchAlert{
+			AlertId:     a.AlertID,
+			ChannelId:   a.ChannelID,
+			VideoId:     a.VideoID,
+			VideoTitle:  a.VideoTitle,
+			SafetyScore: int32(a.SafetyScore),
+			Verdict:     a.Verdict,
+			Read:        a.ReadAt.Valid,
+			CreatedAt:   a.CreatedAt.Format(time.RFC3339),
+		}
+		if a.JobID.Valid {
+			pbAlert.JobId = a.JobID.UUID.String()
+		}
+		resp.Alerts = append(resp.Alerts, pbAlert)
+	}
+	return resp, nil
+}
+
+// MarkAlertsRead: 알림 읽음 처리
+func (s *AnalysisServer) MarkAlertsRead(ctx context.Context, req *pb.MarkAlertsReadRequest) (*pb.MarkAlertsReadResponse, error) {
+	uid, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.AlertIds) == 0 {
+		return &pb.MarkAlertsReadResponse{}, nil
+	}
+
+	updated, err := s.store.MarkWatchAlertsRead(ctx, uid, req.AlertIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update alerts")
+	}
+	return &pb.MarkAlertsReadResponse{Updated: int32(updated)}, nil
+}
+
+func toPBWatchedChannel(w *storage.ChannelWatch) *pb.WatchedChannel {
+	channel := &pb.WatchedChannel{
+		ChannelId: w.ChannelID,
+		Title:     w.ChannelTitle,
+		CreatedAt: w.CreatedAt.Format(time.RFC3339),
+	}
+	if w.LastCheckedAt.Valid {
+		channel.LastCheckedAt = w.LastCheckedAt.Time.Format(time.RFC3339)
+	}
+	return channel
+}
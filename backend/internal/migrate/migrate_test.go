@@ -0,0 +1,75 @@
+package migrate
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/migrations"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"010_later.up.sql":    {Data: []byte("CREATE TABLE later (id INT);")},
+		"002_second.up.sql":   {Data: []byte("CREATE TABLE second (id INT);")},
+		"002_second.down.sql": {Data: []byte("DROP TABLE second;")},
+		"001_first.up.sql":    {Data: []byte("CREATE TABLE first (id INT);")},
+		"README.md":           {Data: []byte("not a migration")},
+		"migrations.go":       {Data: []byte("package migrations")},
+	}
+
+	migs, err := Load(fsys)
+	require.NoError(t, err)
+	require.Len(t, migs, 3)
+
+	assert.Equal(t, []int64{1, 2, 10}, []int64{migs[0].Version, migs[1].Version, migs[2].Version})
+	assert.Equal(t, "second", migs[1].Name)
+	assert.Equal(t, "DROP TABLE second;", migs[1].Down)
+	assert.Empty(t, migs[0].Down)
+	assert.Equal(t, checksum("CREATE TABLE first (id INT);"), migs[0].Checksum)
+	assert.NotEqual(t, migs[0].Checksum, migs[1].Checksum)
+}
+
+func TestLoadErrors(t *testing.T) {
+	_, err := Load(fstest.MapFS{
+		"001_first.down.sql": {Data: []byte("DROP TABLE first;")},
+	})
+	assert.ErrorContains(t, err, "no up script")
+
+	_, err = Load(fstest.MapFS{
+		"001_first.up.sql": {Data: []byte("SELECT 1;")},
+		"001_other.up.sql": {Data: []byte("SELECT 2;")},
+	})
+	assert.ErrorContains(t, err, "used by both")
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migs, err := Load(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, migs)
+
+	for i, m := range migs {
+		assert.NotEmpty(t, m.Down, "%03d_%s has no down script", m.Version, m.Name)
+		if i > 0 {
+			assert.Greater(t, m.Version, migs[i-1].Version)
+		}
+	}
+}
+
+// 이미 배포된 DB에 적용된 마이그레이션은 수정하면 ErrChecksumMismatch가 난다.
+// 스키마 변경은 새 버전으로 추가할 것.
+func TestReleasedMigrationsUnchanged(t *testing.T) {
+	released := map[int64]string{
+		1: "bfdafb195665a24c8ded5ae7d0ba82f75f22e4566df0d0c607b5727453c3815c",
+		3: "29fc9286bcd7ebe9cb46796cb0edf5770dd13318bbf1ce25f486219a74eda7b2",
+	}
+
+	migs, err := Load(migrations.FS)
+	require.NoError(t, err)
+	for _, m := range migs {
+		if want, ok := released[m.Version]; ok {
+			assert.Equal(t, want, m.Checksum, "%03d_%s was edited after release", m.Version, m.Name)
+		}
+	}
+}
@@ -42,6 +42,7 @@ type PresignedURLResponse struct {
 	S3Key     string
 	ExpiresIn int32
 	UploadID  string
+	Bucket    string
 }
 
 func (c *Client) GeneratePresignedURL(ctx context.Context, req PresignedURLRequest) (*PresignedURLResponse, error) {
@@ -68,5 +69,6 @@ func (c *Client) GeneratePresignedURL(ctx context.Context, req PresignedURLReque
 		S3Key:     s3Key,
 		ExpiresIn: int32(15 * 60),
 		UploadID:  uploadID,
+		Bucket:    c.bucketName,
 	}, nil
 }
@@ -0,0 +1,51 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// maxDeleteBatch is the most keys S3 accepts in one DeleteObjects request.
+const maxDeleteBatch = 1000
+
+// DeleteObjects deletes keys from bucket (the client's bucket when empty)
+// in batches and returns the keys that were deleted. Keys that no longer
+// exist count as deleted; keys S3 refused are left out of the result.
+func (c *Client) DeleteObjects(ctx context.Context, bucket string, keys []string) ([]string, error) {
+	if bucket == "" {
+		bucket = c.bucketName
+	}
+
+	var deleted []string
+	for start := 0; start < len(keys); start += maxDeleteBatch {
+		chunk := keys[start:min(start+maxDeleteBatch, len(keys))]
+
+		objects := make([]types.ObjectIdentifier, len(chunk))
+		for i, key := range chunk {
+			objects[i] = types.ObjectIdentifier{Key: aws.String(key)}
+		}
+		out, err := c.s3Client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete objects from %s: %w", bucket, err)
+		}
+
+		// Quiet 모드에서는 실패한 키만 응답에 포함된다
+		failed := make(map[string]bool, len(out.Errors))
+		for _, e := range out.Errors {
+			failed[aws.ToString(e.Key)] = true
+		}
+		for _, key := range chunk {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,81 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// maxMirrorSize caps how much we download when mirroring a remote object.
+const maxMirrorSize = 10 << 20 // 10MB
+
+var mirrorHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// Mirror copies remote objects into the bucket and links them through a
+// public base URL (e.g. a CloudFront distribution in front of the bucket).
+// The bucket itself blocks public access, so object URLs cannot be served.
+// Used to keep thumbnails available after the original video is taken down.
+type Mirror struct {
+	client  *Client
+	baseURL string
+}
+
+// NewMirror creates a Mirror whose objects are served from publicBaseURL.
+func NewMirror(client *Client, publicBaseURL string) *Mirror {
+	return &Mirror{client: client, baseURL: strings.TrimRight(publicBaseURL, "/")}
+}
+
+// MirrorURL downloads srcURL and stores it under key, returning its public URL.
+func (m *Mirror) MirrorURL(ctx context.Context, srcURL, key string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srcURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := mirrorHTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", srcURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download %s: %s", srcURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMirrorSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxMirrorSize {
+		return "", fmt.Errorf("object too large to mirror: %s", srcURL)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+
+	_, err = m.client.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(m.client.bucketName),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+
+	return m.PublicURL(key), nil
+}
+
+// PublicURL returns the URL an object is served from.
+func (m *Mirror) PublicURL(key string) string {
+	return m.baseURL + "/" + key
+}
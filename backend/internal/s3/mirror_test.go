@@ -0,0 +1,84 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBucket: PutObject만 받는 path-style S3 엔드포인트
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "unexpected", http.StatusMethodNotAllowed)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	b.mu.Lock()
+	b.objects[r.URL.Path] = body
+	b.types[r.URL.Path] = r.Header.Get("Content-Type")
+	b.mu.Unlock()
+}
+
+func newTestMirror(t *testing.T) (*Mirror, *fakeBucket) {
+	bucket := &fakeBucket{objects: map[string][]byte{}, types: map[string]string{}}
+	srv := httptest.NewServer(bucket)
+	t.Cleanup(srv.Close)
+
+	client := &Client{
+		s3Client: s3.New(s3.Options{
+			Region:       "ap-northeast-2",
+			BaseEndpoint: aws.String(srv.URL),
+			UsePathStyle: true,
+			Credentials:  aws.AnonymousCredentials{},
+		}),
+		bucketName: "test-bucket",
+		region:     "ap-northeast-2",
+	}
+	return NewMirror(client, "https://cdn.example.com/"), bucket
+}
+
+func TestMirrorURL(t *testing.T) {
+	ctx := context.Background()
+	src := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/thumb.jpg":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Write([]byte("jpeg-bytes"))
+		case "/large.jpg":
+			w.Write([]byte(strings.Repeat("x", maxMirrorSize+1)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer src.Close()
+
+	mirror, bucket := newTestMirror(t)
+
+	url, err := mirror.MirrorURL(ctx, src.URL+"/thumb.jpg", "thumbnails/tiktok/123.jpg")
+	require.NoError(t, err)
+	// 버킷 URL이 아닌 공개(CDN) URL
+	assert.Equal(t, "https://cdn.example.com/thumbnails/tiktok/123.jpg", url)
+	assert.Equal(t, []byte("jpeg-bytes"), bucket.objects["/test-bucket/thumbnails/tiktok/123.jpg"])
+	assert.Equal(t, "image/jpeg", bucket.types["/test-bucket/thumbnails/tiktok/123.jpg"])
+
+	_, err = mirror.MirrorURL(ctx, src.URL+"/missing.jpg", "thumbnails/missing.jpg")
+	assert.ErrorContains(t, err, "404")
+	_, err = mirror.MirrorURL(ctx, src.URL+"/large.jpg", "thumbnails/large.jpg")
+	assert.ErrorContains(t, err, "too large")
+	assert.Len(t, bucket.objects, 1)
+}
@@ -0,0 +1,202 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+const (
+	defaultPurgeInterval  = 24 * time.Hour
+	defaultPurgeBatchSize = 500
+)
+
+// ObjectDeleter removes stored objects; *s3.Client implements it.
+type ObjectDeleter interface {
+	DeleteObjects(ctx context.Context, bucket string, keys []string) ([]string, error)
+}
+
+// PurgeReport summarizes what one purge run removed.
+type PurgeReport struct {
+	StartedAt     time.Time
+	Duration      time.Duration
+	Captions      int // 삭제한 자막 행
+	Comments      int // 삭제한 댓글 행
+	Uploads       int // 삭제한 업로드 행 (Job/결과 포함)
+	Objects       int // 삭제한 S3 객체
+	FailedObjects int // 삭제에 실패해 다음 실행으로 미룬 S3 객체
+}
+
+func (r *PurgeReport) String() string {
+	return fmt.Sprintf("captions=%d comments=%d uploads=%d objects=%d failed_objects=%d (%s)",
+		r.Captions, r.Comments, r.Uploads, r.Objects, r.FailedObjects, r.Duration.Round(time.Millisecond))
+}
+
+// Purger deletes captions, comments and uploads older than their configured
+// retention period. Uploads are removed from S3 before their rows so a
+// failed object delete is retried on the next run.
+type Purger struct {
+	store   storage.Store
+	objects ObjectDeleter
+	cfg     config.RetentionConfig
+	now     func() time.Time
+}
+
+// NewPurger creates a purger; zero interval and batch size fall back to
+// defaults. objects may be nil, in which case uploads are kept.
+func NewPurger(store storage.Store, objects ObjectDeleter, cfg config.RetentionConfig) *Purger {
+	if cfg.IntervalHours <= 0 {
+		cfg.IntervalHours = int(defaultPurgeInterval / time.Hour)
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultPurgeBatchSize
+	}
+	return &Purger{
+		store:   store,
+		objects: objects,
+		cfg:     cfg,
+		now:     time.Now,
+	}
+}
+
+// Run purges immediately and then every interval until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context) {
+	interval := time.Duration(p.cfg.IntervalHours) * time.Hour
+	log.Printf("Retention purger started (interval: %s)", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := p.PurgeOnce(ctx)
+		if err != nil {
+			log.Printf("Retention purge failed: %v (partial: %s)", err, report)
+		} else {
+			log.Printf("Retention purge finished: %s", report)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Retention purger stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeOnce deletes all currently expired data in batches. On error the
+// report still counts what was removed before the failure.
+func (p *Purger) PurgeOnce(ctx context.Context) (*PurgeReport, error) {
+	report := &PurgeReport{StartedAt: p.now()}
+	defer func() { report.Duration = p.now().Sub(report.StartedAt) }()
+
+	if cutoff, ok := p.cutoff(p.cfg.CaptionsDays); ok {
+		n, err := p.purgeRows(ctx, cutoff, p.store.PurgeCaptions)
+		report.Captions = n
+		if err != nil {
+			return report, fmt.Errorf("purge captions: %w", err)
+		}
+	}
+
+	if cutoff, ok := p.cutoff(p.cfg.CommentsDays); ok {
+		n, err := p.purgeRows(ctx, cutoff, p.store.PurgeComments)
+		report.Comments = n
+		if err != nil {
+			return report, fmt.Errorf("purge comments: %w", err)
+		}
+	}
+
+	if cutoff, ok := p.cutoff(p.cfg.UploadsDays); ok {
+		if p.objects == nil {
+			log.Printf("Upload retention is set but no object store is configured; skipping uploads")
+		} else if err := p.purgeUploads(ctx, cutoff, report); err != nil {
+			return report, fmt.Errorf("purge uploads: %w", err)
+		}
+	}
+
+	return report, nil
+}
+
+// cutoff: 보관 기간이 설정된 경우 그 이전 데이터가 삭제 대상
+func (p *Purger) cutoff(days int) (time.Time, bool) {
+	if days <= 0 {
+		return time.Time{}, false
+	}
+	return p.now().AddDate(0, 0, -days), true
+}
+
+// purgeRows calls purge until a batch comes back short.
+func (p *Purger) purgeRows(ctx context.Context, cutoff time.Time, purge func(context.Context, time.Time, int) (int, error)) (int, error) {
+	total := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		n, err := purge(ctx, cutoff, p.cfg.BatchSize)
+		total += n
+		if err != nil || n < p.cfg.BatchSize {
+			return total, err
+		}
+	}
+}
+
+// purgeUploads deletes expired uploads batch by batch: objects first, then
+// the rows whose objects are gone. It stops at the first batch with failed
+// objects, since those rows would be listed again.
+func (p *Purger) purgeUploads(ctx context.Context, cutoff time.Time, report *PurgeReport) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		uploads, err := p.store.ListExpiredUploads(ctx, cutoff, p.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		if len(uploads) == 0 {
+			return nil
+		}
+
+		// 버킷별로 묶어서 삭제
+		byBucket := make(map[string][]string)
+		idByKey := make(map[string]uuid.UUID, len(uploads))
+		for _, u := range uploads {
+			byBucket[u.S3Bucket] = append(byBucket[u.S3Bucket], u.S3Key)
+			idByKey[u.S3Bucket+"/"+u.S3Key] = u.UploadID
+		}
+
+		var gone []uuid.UUID
+		var deleteErr error
+		for bucket, keys := range byBucket {
+			deleted, err := p.objects.DeleteObjects(ctx, bucket, keys)
+			for _, key := range deleted {
+				gone = append(gone, idByKey[bucket+"/"+key])
+			}
+			if err != nil && deleteErr == nil {
+				deleteErr = err
+			}
+		}
+		report.Objects += len(gone)
+		report.FailedObjects += len(uploads) - len(gone)
+
+		n, err := p.store.DeleteUploads(ctx, gone)
+		report.Uploads += n
+		if err != nil {
+			return err
+		}
+		if deleteErr != nil {
+			return deleteErr
+		}
+		if len(gone) < len(uploads) {
+			log.Printf("Failed to delete %d upload object(s); retrying next run", len(uploads)-len(gone))
+			return nil
+		}
+		if len(uploads) < p.cfg.BatchSize {
+			return nil
+		}
+	}
+}
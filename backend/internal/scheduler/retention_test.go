@@ -0,0 +1,99 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+// fakeObjects records delete calls and refuses the keys in fail.
+type fakeObjects struct {
+	calls [][]string
+	fail  map[string]bool
+	err   error
+}
+
+func (f *fakeObjects) DeleteObjects(ctx context.Context, bucket string, keys []string) ([]string, error) {
+	f.calls = append(f.calls, keys)
+	var deleted []string
+	for _, key := range keys {
+		if !f.fail[key] {
+			deleted = append(deleted, key)
+		}
+	}
+	return deleted, f.err
+}
+
+func seedRetention(t *testing.T, store *storage.MemoryStore, keys ...string) {
+	ctx := context.Background()
+	require.NoError(t, store.CreateVideo(ctx, &storage.Video{VideoID: "video000001"}))
+	for _, lang := range []string{"ko", "en", "ja"} {
+		require.NoError(t, store.SaveCaptions(ctx, "video000001", lang, "text"))
+	}
+	require.NoError(t, store.SaveComments(ctx, "video000001", []storage.Comment{{Text: "hi", Rank: 1}}))
+	for _, key := range keys {
+		require.NoError(t, store.CreateUpload(ctx, &storage.Upload{UploadID: uuid.New(), S3Bucket: "bucket", S3Key: key}))
+	}
+}
+
+func TestPurgeOnce(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	seedRetention(t, store, "uploads/a", "uploads/b", "uploads/c")
+
+	objects := &fakeObjects{fail: map[string]bool{"uploads/c": true}}
+	purger := NewPurger(store, objects, config.RetentionConfig{
+		BatchSize:    2,
+		CaptionsDays: 0, // 영구 보관
+		CommentsDays: 30,
+		UploadsDays:  30,
+	})
+	purger.now = func() time.Time { return time.Now().AddDate(0, 0, 31) }
+
+	report, err := purger.PurgeOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, report.Captions)
+	assert.Equal(t, 1, report.Comments)
+	assert.Equal(t, 2, report.Uploads)
+	assert.Equal(t, 2, report.Objects)
+	assert.Equal(t, 1, report.FailedObjects)
+	assert.Equal(t, [][]string{{"uploads/a", "uploads/b"}, {"uploads/c"}}, objects.calls)
+
+	// 삭제에 실패한 업로드는 다음 실행에서 재시도
+	remaining, err := store.ListExpiredUploads(ctx, purger.now(), 10)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "uploads/c", remaining[0].S3Key)
+
+	delete(objects.fail, "uploads/c")
+	report, err = purger.PurgeOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Uploads)
+	assert.Zero(t, report.FailedObjects)
+}
+
+func TestPurgeOnceKeepsRowsWhenObjectStoreFails(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	seedRetention(t, store, "uploads/a")
+
+	objects := &fakeObjects{fail: map[string]bool{"uploads/a": true}, err: errors.New("access denied")}
+	purger := NewPurger(store, objects, config.RetentionConfig{CaptionsDays: 1, UploadsDays: 1})
+	purger.now = func() time.Time { return time.Now().AddDate(0, 0, 2) }
+
+	report, err := purger.PurgeOnce(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 3, report.Captions)
+	assert.Zero(t, report.Uploads)
+
+	remaining, err := store.ListExpiredUploads(ctx, purger.now(), 10)
+	require.NoError(t, err)
+	assert.Len(t, remaining, 1)
+}
@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+const (
+	defaultExpiryInterval   = time.Hour
+	subscriptionExpiryBatch = 500
+)
+
+// SubscriptionExpirer moves paid subscriptions whose period has ended back
+// to the free plan. Entitlement checks already treat them as free once the
+// end date passes; this keeps the stored plan and status in line for the
+// profile, admin tools and the next renewal webhook.
+type SubscriptionExpirer struct {
+	store    storage.SubscriptionStore
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewSubscriptionExpirer creates an expirer; a zero interval falls back to the default.
+func NewSubscriptionExpirer(store storage.SubscriptionStore, cfg config.BillingConfig) *SubscriptionExpirer {
+	interval := time.Duration(cfg.ExpiryIntervalMinutes) * time.Minute
+	if interval <= 0 {
+		interval = defaultExpiryInterval
+	}
+	return &SubscriptionExpirer{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run expires subscriptions immediately and then every interval until ctx is cancelled.
+func (e *SubscriptionExpirer) Run(ctx context.Context) {
+	log.Printf("Subscription expirer started (interval: %s)", e.interval)
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := e.ExpireOnce(ctx)
+		if err != nil {
+			log.Printf("Subscription expiry failed: %v (%d expired)", err, n)
+		} else if n > 0 {
+			log.Printf("Expired %d subscription(s)", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Subscription expirer stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExpireOnce expires every subscription that has ended, in batches.
+func (e *SubscriptionExpirer) ExpireOnce(ctx context.Context) (int, error) {
+	now := e.now()
+	total := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		n, err := e.store.ExpireSubscriptions(ctx, now, subscriptionExpiryBatch)
+		total += n
+		if err != nil || n < subscriptionExpiryBatch {
+			return total, err
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+func TestExpireOnce(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	now := time.Now()
+
+	ends := map[string]time.Time{
+		"ended@example.com":    now.Add(-time.Hour),
+		"canceled@example.com": now.Add(-time.Minute),
+		"active@example.com":   now.AddDate(0, 0, 10),
+	}
+	users := map[string]int64{}
+	for email, end := range ends {
+		user, err := store.UpsertUser(ctx, email, "", "", "")
+		require.NoError(t, err)
+		users[email] = user.ID
+		require.NoError(t, store.SaveSubscription(ctx, &storage.Subscription{
+			UserID:   user.ID,
+			PlanType: "pro",
+			Status:   storage.SubscriptionActive,
+			EndDate:  sql.NullTime{Time: end, Valid: true},
+		}))
+	}
+
+	expirer := NewSubscriptionExpirer(store, config.BillingConfig{})
+	expirer.now = func() time.Time { return now }
+	n, err := expirer.ExpireOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	for email, want := range map[string]string{"ended@example.com": "free", "canceled@example.com": "free", "active@example.com": "pro"} {
+		sub, err := store.GetSubscription(ctx, users[email])
+		require.NoError(t, err)
+		assert.Equal(t, want, sub.PlanType, email)
+	}
+
+	n, err = expirer.ExpireOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
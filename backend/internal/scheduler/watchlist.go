@@ -0,0 +1,169 @@
+// Package scheduler runs periodic background jobs inside the backend.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/worker"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
+)
+
+const (
+	defaultWatchInterval    = 30 * time.Minute
+	defaultMaxVideosPerScan = 5
+	defaultAlertBelowScore  = 70 // caution 이하
+)
+
+// WatchScheduler polls watched channels for new uploads, analyzes them
+// through the worker and records alerts for the watchers.
+type WatchScheduler struct {
+	store    storage.Store
+	analyzer *worker.Analyzer
+	youtube  *youtube.Client
+	cfg      config.WatchlistConfig
+}
+
+// NewWatchScheduler creates a scheduler; zero config values fall back to defaults.
+func NewWatchScheduler(store storage.Store, analyzer *worker.Analyzer, ytClient *youtube.Client, cfg config.WatchlistConfig) *WatchScheduler {
+	if cfg.IntervalMinutes <= 0 {
+		cfg.IntervalMinutes = int(defaultWatchInterval / time.Minute)
+	}
+	if cfg.MaxVideosPerScan <= 0 {
+		cfg.MaxVideosPerScan = defaultMaxVideosPerScan
+	}
+	if cfg.AlertBelowScore <= 0 {
+		cfg.AlertBelowScore = defaultAlertBelowScore
+	}
+	return &WatchScheduler{
+		store:    store,
+		analyzer: analyzer,
+		youtube:  ytClient,
+		cfg:      cfg,
+	}
+}
+
+// Run scans immediately and then every interval until ctx is cancelled.
+func (s *WatchScheduler) Run(ctx context.Context) {
+	interval := time.Duration(s.cfg.IntervalMinutes) * time.Minute
+	log.Printf("Watchlist scheduler started (interval: %s)", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.ScanOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Printf("Watchlist scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScanOnce checks every watched channel once.
+func (s *WatchScheduler) ScanOnce(ctx context.Context) {
+	channels, err := s.store.ListWatchedChannels(ctx)
+	if err != nil {
+		log.Printf("Failed to list watched channels: %v", err)
+		return
+	}
+
+	for _, ch := range channels {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.scanChannel(ctx, ch); err != nil {
+			log.Printf("Failed to scan channel %s: %v", ch.ChannelID, err)
+		}
+	}
+}
+
+// scanChannel: 채널의 새 업로드를 오래된 순서로 분석하고, 위험하면 구독자에게 알림 기록
+func (s *WatchScheduler) scanChannel(ctx context.Context, ch storage.WatchedChannel) error {
+	playlistID := ch.UploadsPlaylistID
+	if playlistID == "" {
+		playlistID = uploadsPlaylistID(ch.ChannelID)
+	}
+
+	videos, err := s.youtube.ListPlaylistVideos(ctx, playlistID, s.cfg.MaxVideosPerScan)
+	if err != nil {
+		return err
+	}
+
+	fresh := newUploads(videos, ch.Since)
+	if len(fresh) == 0 {
+		return s.store.AdvanceWatches(ctx, ch.ChannelID, ch.Since)
+	}
+
+	for _, v := range fresh {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		placeholderVideo := &storage.Video{
+			VideoID:      v.VideoID,
+			Platform:     source.PlatformYouTube,
+			Title:        v.Title,
+			ChannelID:    ch.ChannelID,
+			ThumbnailURL: youtube.DefaultThumbnailURL(v.VideoID),
+			PublishedAt:  v.PublishedAt,
+		}
+		if err := s.store.CreateVideo(ctx, placeholderVideo); err != nil {
+			log.Printf("Video entry might already exist or DB error: %v", err)
+		}
+
+		job, err := s.store.CreateJob(ctx, v.VideoID, 0)
+		if err != nil {
+			return fmt.Errorf("create job: %w", err)
+		}
+		s.analyzer.AnalyzeSync(ctx, job.JobID, fmt.Sprintf("https://www.youtube.com/watch?v=%s", v.VideoID), true, 10)
+
+		if result, err := s.store.GetResult(ctx, job.JobID); err != nil {
+			log.Printf("No result for watched upload %s: %v", v.VideoID, err)
+		} else if result.SafetyScore < s.cfg.AlertBelowScore {
+			n, err := s.store.CreateWatchAlerts(ctx, ch.ChannelID, v.VideoID, v.Title, job.JobID, result.SafetyScore, v.PublishedAt)
+			if err != nil {
+				log.Printf("Failed to create alerts for %s: %v", v.VideoID, err)
+			} else if n > 0 {
+				log.Printf("Risky upload %s on channel %s (score %d): %d alert(s)", v.VideoID, ch.ChannelID, result.SafetyScore, n)
+			}
+		}
+
+		// 분석이 실패해도 같은 영상을 계속 재시도하지 않도록 커서는 전진
+		if err := s.store.AdvanceWatches(ctx, ch.ChannelID, v.PublishedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newUploads returns the videos published after since, oldest first.
+func newUploads(videos []youtube.PlaylistVideo, since time.Time) []youtube.PlaylistVideo {
+	var fresh []youtube.PlaylistVideo
+	for _, v := range videos {
+		if v.PublishedAt.After(since) {
+			fresh = append(fresh, v)
+		}
+	}
+	sort.Slice(fresh, func(i, j int) bool {
+		return fresh[i].PublishedAt.Before(fresh[j].PublishedAt)
+	})
+	return fresh
+}
+
+// uploadsPlaylistID derives a channel's uploads playlist ("UC..." -> "UU...").
+func uploadsPlaylistID(channelID string) string {
+	if strings.HasPrefix(channelID, "UC") {
+		return "UU" + strings.TrimPrefix(channelID, "UC")
+	}
+	return channelID
+}
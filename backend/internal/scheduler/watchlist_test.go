@@ -0,0 +1,32 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
+)
+
+func TestNewUploads(t *testing.T) {
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	videos := []youtube.PlaylistVideo{
+		{VideoID: "newest00003", PublishedAt: since.Add(3 * time.Hour)},
+		{VideoID: "newer000002", PublishedAt: since.Add(time.Hour)},
+		{VideoID: "exactly0001", PublishedAt: since},
+		{VideoID: "older000000", PublishedAt: since.Add(-time.Hour)},
+	}
+
+	fresh := newUploads(videos, since)
+
+	if assert.Len(t, fresh, 2) {
+		assert.Equal(t, "newer000002", fresh[0].VideoID)
+		assert.Equal(t, "newest00003", fresh[1].VideoID)
+	}
+	assert.Empty(t, newUploads(nil, since))
+}
+
+func TestUploadsPlaylistID(t *testing.T) {
+	assert.Equal(t, "UUX6OQ3DkcsbYNE6H8uQQuVA", uploadsPlaylistID("UCX6OQ3DkcsbYNE6H8uQQuVA"))
+	assert.Equal(t, "PLabc", uploadsPlaylistID("PLabc"))
+}
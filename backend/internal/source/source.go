@@ -0,0 +1,133 @@
+// Package source abstracts the video platforms we can analyze (YouTube,
+// TikTok, Instagram Reels, ...) behind a single Provider interface.
+package source
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Platform names stored in videos.platform.
+const (
+	PlatformYouTube   = "youtube"
+	PlatformTikTok    = "tiktok"
+	PlatformInstagram = "instagram"
+	PlatformFacebook  = "facebook"
+	PlatformNaverTV   = "navertv"
+)
+
+var (
+	// ErrNotMatched is returned by Provider.Parse when the URL belongs to
+	// another platform.
+	ErrNotMatched = errors.New("url not handled by provider")
+	// ErrUnsupportedURL is returned by Registry.Resolve when no provider
+	// accepts the URL.
+	ErrUnsupportedURL = errors.New("unsupported video URL")
+)
+
+// Ref identifies a single video on a platform.
+type Ref struct {
+	Platform string
+	ID       string // platform-native ID
+	URL      string // canonical URL
+	// ThumbnailURL is a best-effort thumbnail known from the URL alone, used
+	// before metadata has been fetched. May be empty.
+	ThumbnailURL string
+	// Start is the playback offset requested in the URL, if any.
+	Start time.Duration
+	// Live is true when the URL points at a live stream.
+	Live bool
+}
+
+// Key returns the value stored in videos.video_id. YouTube IDs are kept as-is
+// for compatibility with existing rows; other platforms are namespaced as
+// "platform:id" so IDs from different platforms can't collide.
+func (r Ref) Key() string {
+	if r.Platform == PlatformYouTube {
+		return r.ID
+	}
+	return r.Platform + ":" + r.ID
+}
+
+// Metadata is the platform-independent video metadata.
+type Metadata struct {
+	Ref          Ref
+	Title        string
+	Description  string
+	Channel      string
+	ChannelID    string
+	ThumbnailURL string
+	Duration     int64
+	ViewCount    int64
+	PublishedAt  time.Time
+	// Live is true while the video is an on-air live stream.
+	Live bool
+}
+
+// Comment is a top-level viewer comment.
+type Comment struct {
+	Author string
+	Text   string
+	Likes  int64
+	Rank   int
+}
+
+// Provider fetches analysis inputs from one video platform.
+type Provider interface {
+	// Name returns the platform name (one of the Platform* constants).
+	Name() string
+	// Parse returns ErrNotMatched if rawURL is not for this platform, or
+	// another error if it is but can't be parsed.
+	Parse(rawURL string) (*Ref, error)
+	GetMetadata(ctx context.Context, ref Ref) (*Metadata, error)
+	GetTranscript(ctx context.Context, ref Ref) (string, error)
+	GetComments(ctx context.Context, ref Ref, count int) ([]Comment, error)
+}
+
+// Registry holds the providers the server can analyze, tried in
+// registration order.
+type Registry struct {
+	providers []Provider
+}
+
+// NewRegistry creates a registry with the given providers.
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register adds a provider to the registry.
+func (r *Registry) Register(p Provider) {
+	r.providers = append(r.providers, p)
+}
+
+// Get returns the provider for a platform name.
+func (r *Registry) Get(platform string) (Provider, bool) {
+	for _, p := range r.providers {
+		if p.Name() == platform {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Resolve finds the provider for rawURL and parses it.
+func (r *Registry) Resolve(rawURL string) (Provider, *Ref, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	for _, p := range r.providers {
+		ref, err := p.Parse(rawURL)
+		if errors.Is(err, ErrNotMatched) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return p, ref, nil
+	}
+	return nil, nil, ErrUnsupportedURL
+}
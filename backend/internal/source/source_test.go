@@ -0,0 +1,74 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProvider는 "https://fake.example/<id>" 형식만 처리한다.
+type fakeProvider struct{}
+
+func (fakeProvider) Name() string { return "fake" }
+
+func (fakeProvider) Parse(rawURL string) (*Ref, error) {
+	id, ok := strings.CutPrefix(rawURL, "https://fake.example/")
+	if !ok {
+		return nil, ErrNotMatched
+	}
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	return &Ref{Platform: "fake", ID: id}, nil
+}
+
+func (fakeProvider) GetMetadata(ctx context.Context, ref Ref) (*Metadata, error) {
+	return &Metadata{Ref: ref}, nil
+}
+
+func (fakeProvider) GetTranscript(ctx context.Context, ref Ref) (string, error) { return "", nil }
+
+func (fakeProvider) GetComments(ctx context.Context, ref Ref, count int) ([]Comment, error) {
+	return nil, nil
+}
+
+func TestRegistryResolve(t *testing.T) {
+	r := NewRegistry(NewYouTube(nil), fakeProvider{})
+
+	p, ref, err := r.Resolve("https://youtu.be/dQw4w9WgXcQ?t=10")
+	assert.NoError(t, err)
+	assert.Equal(t, PlatformYouTube, p.Name())
+	assert.Equal(t, "dQw4w9WgXcQ", ref.Key())
+	assert.Equal(t, "https://www.youtube.com/watch?v=dQw4w9WgXcQ", ref.URL)
+
+	p, ref, err = r.Resolve("https://fake.example/abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "fake", p.Name())
+	assert.Equal(t, "fake:abc", ref.Key())
+
+	// 매칭은 됐지만 파싱 실패한 경우는 그 에러를 그대로 돌려준다
+	_, _, err = r.Resolve("https://fake.example/")
+	assert.EqualError(t, err, "missing id")
+
+	_, _, err = r.Resolve("https://www.tiktok.com/@user/video/7234567890123456789")
+	assert.ErrorIs(t, err, ErrUnsupportedURL)
+
+	// YouTube 호스트지만 영상 ID가 없는 URL은 YouTube 쪽 에러
+	_, _, err = r.Resolve("https://www.youtube.com/watch?v=short")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrUnsupportedURL)
+}
+
+func TestRegistryGet(t *testing.T) {
+	r := NewRegistry(NewYouTube(nil))
+
+	p, ok := r.Get(PlatformYouTube)
+	assert.True(t, ok)
+	assert.Equal(t, PlatformYouTube, p.Name())
+
+	_, ok = r.Get(PlatformTikTok)
+	assert.False(t, ok)
+}
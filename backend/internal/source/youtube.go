@@ -0,0 +1,79 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
+)
+
+// YouTube adapts youtube.Client to the Provider interface.
+type YouTube struct {
+	client *youtube.Client
+}
+
+// NewYouTube creates the YouTube provider.
+func NewYouTube(client *youtube.Client) *YouTube {
+	return &YouTube{client: client}
+}
+
+func (y *YouTube) Name() string { return PlatformYouTube }
+
+func (y *YouTube) Parse(rawURL string) (*Ref, error) {
+	parsed, err := youtube.ParseURL(rawURL)
+	if errors.Is(err, youtube.ErrUnsupportedHost) {
+		return nil, ErrNotMatched
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Ref{
+		Platform:     PlatformYouTube,
+		ID:           parsed.VideoID,
+		URL:          fmt.Sprintf("https://www.youtube.com/watch?v=%s", parsed.VideoID),
+		ThumbnailURL: youtube.DefaultThumbnailURL(parsed.VideoID),
+		Start:        parsed.Start,
+		Live:         parsed.Live,
+	}, nil
+}
+
+func (y *YouTube) GetMetadata(ctx context.Context, ref Ref) (*Metadata, error) {
+	m, err := y.client.GetMetadata(ctx, ref.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Metadata{
+		Ref:          ref,
+		Title:        m.Title,
+		Description:  m.Description,
+		Channel:      m.Channel,
+		ChannelID:    m.ChannelID,
+		ThumbnailURL: m.BestThumbnail(),
+		Duration:     m.Duration,
+		ViewCount:    m.ViewCount,
+		PublishedAt:  m.PublishedAt,
+		Live:         m.IsLive(),
+	}, nil
+}
+
+func (y *YouTube) GetTranscript(ctx context.Context, ref Ref) (string, error) {
+	return y.client.GetCaptions(ctx, ref.ID)
+}
+
+func (y *YouTube) GetComments(ctx context.Context, ref Ref, count int) ([]Comment, error) {
+	ytComments, err := y.client.GetTopComments(ctx, ref.ID, count)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]Comment, 0, len(ytComments))
+	for _, c := range ytComments {
+		comments = append(comments, Comment{
+			Author: c.Author,
+			Text:   c.Text,
+			Likes:  c.Likes,
+			Rank:   c.Rank,
+		})
+	}
+	return comments, nil
+}
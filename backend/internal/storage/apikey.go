@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const apiKeyColumns = `key_id, name, prefix, key_hash, scopes, daily_quota, created_by, created_at, last_used_at, revoked_at`
+
+func scanAPIKey(row interface{ Scan(...interface{}) error }) (*APIKey, error) {
+	k := &APIKey{}
+	err := row.Scan(&k.ID, &k.Name, &k.Prefix, &k.KeyHash, pq.Array(&k.Scopes), &k.DailyQuota,
+		&k.CreatedBy, &k.CreatedAt, &k.LastUsedAt, &k.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// CreateAPIKey stores a key and fills in its ID and creation time.
+func (s *PostgresStore) CreateAPIKey(ctx context.Context, k *APIKey) error {
+	return s.q.QueryRowContext(ctx, `
+		INSERT INTO api_keys (name, prefix, key_hash, scopes, daily_quota, created_by)
+		VALUES ($1, $2, $3, COALESCE($4::text[], '{}'), $5, $6)
+		RETURNING key_id, created_at
+	`, k.Name, k.Prefix, k.KeyHash, pq.Array(k.Scopes), k.DailyQuota, k.CreatedBy).Scan(&k.ID, &k.CreatedAt)
+}
+
+func (s *PostgresStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error) {
+	return scanAPIKey(s.q.QueryRowContext(ctx,
+		`SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash = $1`, keyHash))
+}
+
+func (s *PostgresStore) GetAPIKey(ctx context.Context, keyID int64) (*APIKey, error) {
+	return scanAPIKey(s.q.QueryRowContext(ctx,
+		`SELECT `+apiKeyColumns+` FROM api_keys WHERE key_id = $1`, keyID))
+}
+
+func (s *PostgresStore) RevokeAPIKey(ctx context.Context, keyID int64) (bool, error) {
+	res, err := s.q.ExecContext(ctx,
+		`UPDATE api_keys SET revoked_at = now() WHERE key_id = $1 AND revoked_at IS NULL`, keyID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (s *PostgresStore) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	rows, err := s.q.QueryContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys ORDER BY created_at, key_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []APIKey
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *k)
+	}
+	return keys, rows.Err()
+}
+
+func (s *PostgresStore) UseAPIKey(ctx context.Context, keyID int64, day time.Time, n, quota int) (int, bool, error) {
+	if _, err := s.q.ExecContext(ctx, `UPDATE api_keys SET last_used_at = now() WHERE key_id = $1`, keyID); err != nil {
+		return 0, false, err
+	}
+
+	// 상한을 넘게 되는 요청은 갱신되는 행이 없음 (동시 요청도 상한을 넘지 않음)
+	var used int
+	err := s.q.QueryRowContext(ctx, `
+		INSERT INTO api_key_usage (key_id, day, request_count)
+		SELECT $1, $2, $3 WHERE $4 <= 0 OR $3 <= $4
+		ON CONFLICT (key_id, day) DO UPDATE SET request_count = api_key_usage.request_count + EXCLUDED.request_count
+		WHERE $4 <= 0 OR api_key_usage.request_count + EXCLUDED.request_count <= $4
+		RETURNING request_count
+	`, keyID, day.UTC().Format("2006-01-02"), n, quota).Scan(&used)
+	if err == sql.ErrNoRows {
+		err = s.q.QueryRowContext(ctx,
+			`SELECT request_count FROM api_key_usage WHERE key_id = $1 AND day = $2`,
+			keyID, day.UTC().Format("2006-01-02")).Scan(&used)
+		if err == sql.ErrNoRows {
+			// 한 번에 상한보다 많이 요청한 첫 요청
+			return 0, false, nil
+		}
+		return used, false, err
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return used, true, nil
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// CreateFamilyInvite stores an invite and fills in its ID.
+func (s *PostgresStore) CreateFamilyInvite(ctx context.Context, inv *FamilyInvite) error {
+	return s.q.QueryRowContext(ctx, `
+		INSERT INTO family_invites (guardian_id, code_hash, role, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING invite_id, created_at
+	`, inv.GuardianID, inv.CodeHash, inv.Role, inv.ExpiresAt).Scan(&inv.ID, &inv.CreatedAt)
+}
+
+// ClaimFamilyInvite consumes an invite. Only one of two concurrent claims
+// of the same code succeeds.
+func (s *PostgresStore) ClaimFamilyInvite(ctx context.Context, codeHash string, seniorID int64, now time.Time) (*FamilyInvite, error) {
+	inv := &FamilyInvite{}
+	err := s.q.QueryRowContext(ctx, `
+		UPDATE family_invites SET claimed_by = $2, claimed_at = $3
+		WHERE code_hash = $1 AND claimed_at IS NULL AND expires_at > $3
+		RETURNING invite_id, guardian_id, code_hash, role, expires_at, claimed_by, claimed_at, created_at
+	`, codeHash, seniorID, now).Scan(
+		&inv.ID, &inv.GuardianID, &inv.CodeHash, &inv.Role, &inv.ExpiresAt, &inv.ClaimedBy, &inv.ClaimedAt, &inv.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+const familyLinkColumns = `link_id, guardian_id, senior_id, role, status, created_at, approved_at, revoked_at`
+
+func scanFamilyLink(row interface{ Scan(...interface{}) error }) (*FamilyLink, error) {
+	l := &FamilyLink{}
+	err := row.Scan(&l.ID, &l.GuardianID, &l.SeniorID, &l.Role, &l.Status, &l.CreatedAt, &l.ApprovedAt, &l.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *PostgresStore) RequestFamilyLink(ctx context.Context, guardianID, seniorID int64, role string) (*FamilyLink, error) {
+	// 이미 활성화된 연결은 그대로 두고 (역할 변경도 어르신 승인이 필요), 거절/해제된 연결은 다시 요청
+	link, err := scanFamilyLink(s.q.QueryRowContext(ctx, `
+		INSERT INTO family_links (guardian_id, senior_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (guardian_id, senior_id) DO UPDATE SET
+			role = EXCLUDED.role,
+			status = 'pending',
+			created_at = now(),
+			approved_at = NULL,
+			revoked_at = NULL
+		WHERE family_links.status <> 'active'
+		RETURNING `+familyLinkColumns,
+		guardianID, seniorID, role))
+	if err == sql.ErrNoRows {
+		return s.GetFamilyLinkBetween(ctx, guardianID, seniorID)
+	}
+	return link, err
+}
+
+func (s *PostgresStore) GetFamilyLink(ctx context.Context, linkID int64) (*FamilyLink, error) {
+	return scanFamilyLink(s.q.QueryRowContext(ctx,
+		`SELECT `+familyLinkColumns+` FROM family_links WHERE link_id = $1`, linkID))
+}
+
+func (s *PostgresStore) GetFamilyLinkBetween(ctx context.Context, guardianID, seniorID int64) (*FamilyLink, error) {
+	return scanFamilyLink(s.q.QueryRowContext(ctx,
+		`SELECT `+familyLinkColumns+` FROM family_links WHERE guardian_id = $1 AND senior_id = $2`, guardianID, seniorID))
+}
+
+func (s *PostgresStore) SetFamilyLinkStatus(ctx context.Context, linkID int64, status string, from ...string) (bool, error) {
+	res, err := s.q.ExecContext(ctx, `
+		UPDATE family_links SET
+			status = $2,
+			approved_at = CASE WHEN $2 = 'active' THEN now() ELSE approved_at END,
+			revoked_at = CASE WHEN $2 IN ('declined', 'revoked') THEN now() ELSE revoked_at END
+		WHERE link_id = $1 AND status = ANY($3)
+	`, linkID, status, pq.Array(from))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (s *PostgresStore) ListFamilyLinks(ctx context.Context, userID int64) ([]FamilyLink, error) {
+	rows, err := s.q.QueryContext(ctx, `
+		SELECT `+familyLinkColumns+` FROM family_links
+		WHERE guardian_id = $1 OR senior_id = $1
+		ORDER BY created_at, link_id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []FamilyLink
+	for rows.Next() {
+		l, err := scanFamilyLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, *l)
+	}
+	return links, rows.Err()
+}
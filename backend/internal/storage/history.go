@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// pgTimestamp formats times for comparison with TIMESTAMP (without time
+// zone) columns, which hold UTC.
+const pgTimestamp = "2006-01-02 15:04:05.999999"
+
+// AddHistory records that a user ran jobID on a video. A user has one
+// entry per video: checking the same video again links the entry to the new
+// job, moves it to the top and increments its check count.
+func (s *PostgresStore) AddHistory(ctx context.Context, userID int64, jobID uuid.UUID, videoID, title, thumb string) error {
+	_, err := s.q.ExecContext(ctx, `
+		INSERT INTO analysis_history (user_id, job_id, video_id, video_title, thumbnail_url)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, video_id) DO UPDATE SET
+			job_id = EXCLUDED.job_id,
+			video_title = COALESCE(NULLIF(analysis_history.video_title, ''), EXCLUDED.video_title),
+			thumbnail_url = COALESCE(NULLIF(analysis_history.thumbnail_url, ''), EXCLUDED.thumbnail_url),
+			check_count = analysis_history.check_count + 1,
+			created_at = CURRENT_TIMESTAMP
+	`, userID, jobID, videoID, title, thumb)
+	return err
+}
+
+// DeleteHistory removes a user's entry for a video. It reports whether one existed.
+func (s *PostgresStore) DeleteHistory(ctx context.Context, userID int64, videoID string) (bool, error) {
+	res, err := s.q.ExecContext(ctx, `DELETE FROM analysis_history WHERE user_id = $1 AND video_id = $2`, userID, videoID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// ClearHistory removes all of a user's entries and returns how many there were
+func (s *PostgresStore) ClearHistory(ctx context.Context, userID int64) (int, error) {
+	res, err := s.q.ExecContext(ctx, `DELETE FROM analysis_history WHERE user_id = $1`, userID)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+// BackfillHistory fills in the title and thumbnail of history rows that were
+// recorded before the video's metadata was known.
+func (s *PostgresStore) BackfillHistory(ctx context.Context, videoID, title, thumbnailURL string) error {
+	_, err := s.q.ExecContext(ctx, `
+		UPDATE analysis_history SET
+			video_title = CASE WHEN $2 <> '' AND (video_title IS NULL OR video_title IN ('', 'Processing...'))
+			                   THEN $2 ELSE video_title END,
+			thumbnail_url = CASE WHEN $3 <> '' AND COALESCE(thumbnail_url, '') = ''
+			                     THEN $3 ELSE thumbnail_url END
+		WHERE video_id = $1
+	`, videoID, title, thumbnailURL)
+	return err
+}
+
+// historyFrom joins each history row with its own job's latest result
+const historyFrom = `
+        FROM analysis_history ah
+        LEFT JOIN analysis_jobs aj ON aj.job_id = ah.job_id
+        LEFT JOIN LATERAL (
+            SELECT safety_score FROM analysis_results
+            WHERE job_id = ah.job_id
+            ORDER BY created_at DESC LIMIT 1
+        ) ar ON TRUE`
+
+// historyWhere builds the WHERE clause of a user's filtered history
+func historyWhere(userID int64, f HistoryFilter) (string, []interface{}, error) {
+	conds := []string{"ah.user_id = $1"}
+	args := []interface{}{userID}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	minScore, maxScore, err := f.scoreRange()
+	if err != nil {
+		return "", nil, err
+	}
+	if minScore != nil {
+		add("ar.safety_score >= $%d", *minScore)
+	}
+	if maxScore != nil {
+		add("ar.safety_score <= $%d", *maxScore)
+	}
+	if !f.From.IsZero() {
+		add("ah.created_at >= $%d::timestamp", f.From.UTC().Format(pgTimestamp))
+	}
+	if !f.To.IsZero() {
+		add("ah.created_at < $%d::timestamp", f.To.UTC().Format(pgTimestamp))
+	}
+	return "WHERE " + strings.Join(conds, " AND "), args, nil
+}
+
+// GetHistory returns up to limit entries of a user's history after the
+// cursor (nil for the first page), newest first, with the total number of
+// entries matching the filter.
+func (s *PostgresStore) GetHistory(ctx context.Context, userID int64, f HistoryFilter, after *HistoryCursor, limit int) (*HistoryPage, error) {
+	where, args, err := historyWhere(userID, f)
+	if err != nil {
+		return nil, err
+	}
+	if limit < 1 {
+		limit = 1
+	}
+
+	page := &HistoryPage{}
+	if err := s.q.QueryRowContext(ctx, `SELECT COUNT(*) `+historyFrom+` `+where, args...).Scan(&page.Total); err != nil {
+		return nil, err
+	}
+
+	if after != nil {
+		args = append(args, after.CreatedAt.UTC().Format(pgTimestamp), after.ID)
+		where += fmt.Sprintf(" AND (ah.created_at, ah.id) < ($%d::timestamp, $%d)", len(args)-1, len(args))
+	}
+	args = append(args, limit+1) // 한 개 더 읽어서 다음 페이지 유무 판단
+
+	rows, err := s.q.QueryContext(ctx, `
+        SELECT ah.id, ah.video_id,
+               COALESCE(v.title, ah.video_title, ''),
+               COALESCE(NULLIF(v.thumbnail_url, ''), ah.thumbnail_url, ''),
+               ah.created_at, ah.check_count, COALESCE(ah.job_id::text, ''), COALESCE(aj.status, ''), ar.safety_score
+        `+historyFrom+`
+        LEFT JOIN videos v ON v.video_id = ah.video_id
+        `+where+`
+        ORDER BY ah.created_at DESC, ah.id DESC
+        LIMIT $`+fmt.Sprint(len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		h := &AnalysisHistory{UserID: userID}
+		var score sql.NullInt64
+		if err := rows.Scan(&h.ID, &h.VideoID, &h.VideoTitle, &h.ThumbnailURL, &h.CreatedAt, &h.CheckCount, &h.JobID, &h.JobStatus, &score); err != nil {
+			return nil, err
+		}
+		h.SafetyScore, h.HasResult = int(score.Int64), score.Valid
+		page.Items = append(page.Items, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	page.trim(limit)
+	return page, nil
+}
+
+// scoreRange combines the score and verdict filters into one inclusive range
+func (f HistoryFilter) scoreRange() (min, max *int, err error) {
+	min, max = f.MinScore, f.MaxScore
+	if f.Verdict == "" {
+		return min, max, nil
+	}
+
+	lo, hi, ok := VerdictScoreRange(f.Verdict)
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown verdict %q", f.Verdict)
+	}
+	if min == nil || *min < lo {
+		min = &lo
+	}
+	if max == nil || *max > hi {
+		max = &hi
+	}
+	return min, max, nil
+}
+
+// matches reports whether an entry passes the filter (in-memory store)
+func (f HistoryFilter) matches(h *AnalysisHistory) bool {
+	min, max, err := f.scoreRange()
+	if err != nil {
+		return false
+	}
+	if (min != nil || max != nil) && !h.HasResult {
+		return false
+	}
+	if min != nil && h.SafetyScore < *min {
+		return false
+	}
+	if max != nil && h.SafetyScore > *max {
+		return false
+	}
+	if !f.From.IsZero() && h.CreatedAt.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !h.CreatedAt.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+// trim cuts a page read with limit+1 rows down to limit and sets the cursor
+// of the next page if there was an extra row.
+func (p *HistoryPage) trim(limit int) {
+	if len(p.Items) <= limit {
+		return
+	}
+	p.Items = p.Items[:limit]
+	last := p.Items[limit-1]
+	p.Next = &HistoryCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+}
+
+// follows reports whether an entry comes after the cursor (newest first)
+func (c *HistoryCursor) follows(h *AnalysisHistory) bool {
+	if !h.CreatedAt.Equal(c.CreatedAt) {
+		return h.CreatedAt.Before(c.CreatedAt)
+	}
+	return h.ID < c.ID
+}
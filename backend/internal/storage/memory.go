@@ -0,0 +1,1645 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// defaultUserRole matches the users.role column default
+const defaultUserRole = "user"
+
+// MemoryStore is an in-memory Store with the same semantics as
+// PostgresStore, for unit tests that should not need a database.
+type MemoryStore struct {
+	mu   sync.Mutex
+	txMu sync.Mutex // WithTx 직렬화
+
+	videos        map[string]*Video
+	channels      map[string]*Channel
+	uploads       map[uuid.UUID]*Upload
+	captions      []Caption
+	comments      map[string][]Comment
+	jobs          map[uuid.UUID]*AnalysisJob
+	jobUploads    map[uuid.UUID]uuid.UUID // job -> upload
+	batches       map[uuid.UUID]*AnalysisBatch
+	results       []*AnalysisResult
+	users         map[int64]*User
+	subscriptions map[int64]*Subscription
+	billingEvents map[string]*BillingEvent
+	refreshTokens []*RefreshToken
+	identities    []*UserIdentity
+	invites       []*FamilyInvite
+	familyLinks   []*FamilyLink
+	apiKeys       []*APIKey
+	apiKeyUsage   map[apiKeyDay]int
+	usage         map[usageDay]int
+	anonUsage     map[anonUsageDay]int
+	history       []*AnalysisHistory
+	watches       []*ChannelWatch
+	alerts        []*WatchAlert
+
+	// 삽입 순서 (같은 시각에 생성된 행의 정렬용)
+	seq      map[interface{}]int64
+	nextSeq  int64
+	nextID   int64
+	lastTime time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		videos:        make(map[string]*Video),
+		channels:      make(map[string]*Channel),
+		uploads:       make(map[uuid.UUID]*Upload),
+		comments:      make(map[string][]Comment),
+		jobs:          make(map[uuid.UUID]*AnalysisJob),
+		jobUploads:    make(map[uuid.UUID]uuid.UUID),
+		batches:       make(map[uuid.UUID]*AnalysisBatch),
+		users:         make(map[int64]*User),
+		subscriptions: make(map[int64]*Subscription),
+		billingEvents: make(map[string]*BillingEvent),
+		apiKeyUsage:   make(map[apiKeyDay]int),
+		usage:         make(map[usageDay]int),
+		anonUsage:     make(map[anonUsageDay]int),
+		seq:           make(map[interface{}]int64),
+	}
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+// WithTx runs fn against the store and restores the previous state if fn
+// fails. Transactions are serialized with each other but, unlike Postgres,
+// not isolated from writes made outside a transaction.
+func (m *MemoryStore) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	m.txMu.Lock()
+	defer m.txMu.Unlock()
+
+	m.mu.Lock()
+	snapshot := m.clone()
+	m.mu.Unlock()
+
+	if err := fn(memoryTx{m}); err != nil {
+		m.mu.Lock()
+		m.restore(snapshot)
+		m.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// memoryTx is the Store handed to WithTx callbacks; nested WithTx calls join
+// the outer transaction.
+type memoryTx struct {
+	*MemoryStore
+}
+
+func (t memoryTx) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	return fn(t)
+}
+
+// clone deep-copies the stored rows (caller holds mu)
+func (m *MemoryStore) clone() *MemoryStore {
+	c := NewMemoryStore()
+	for k, v := range m.videos {
+		row := *v
+		c.videos[k] = &row
+	}
+	for k, v := range m.channels {
+		row := *v
+		c.channels[k] = &row
+	}
+	for k, v := range m.uploads {
+		row := *v
+		c.uploads[k] = &row
+	}
+	c.captions = append([]Caption(nil), m.captions...)
+	for k, v := range m.comments {
+		c.comments[k] = append([]Comment(nil), v...)
+	}
+	for k, v := range m.jobs {
+		row := *v
+		c.jobs[k] = &row
+	}
+	for k, v := range m.jobUploads {
+		c.jobUploads[k] = v
+	}
+	for k, v := range m.batches {
+		row := *v
+		c.batches[k] = &row
+	}
+	for _, v := range m.results {
+		row := *v
+		c.results = append(c.results, &row)
+	}
+	for k, v := range m.users {
+		row := *v
+		c.users[k] = &row
+	}
+	for k, v := range m.subscriptions {
+		row := *v
+		c.subscriptions[k] = &row
+	}
+	for k, v := range m.billingEvents {
+		row := *v
+		c.billingEvents[k] = &row
+	}
+	for _, v := range m.refreshTokens {
+		row := *v
+		c.refreshTokens = append(c.refreshTokens, &row)
+	}
+	for _, v := range m.identities {
+		row := *v
+		c.identities = append(c.identities, &row)
+	}
+	for _, v := range m.invites {
+		row := *v
+		c.invites = append(c.invites, &row)
+	}
+	for _, v := range m.familyLinks {
+		row := *v
+		c.familyLinks = append(c.familyLinks, &row)
+	}
+	for _, v := range m.apiKeys {
+		row := *v
+		row.Scopes = append([]string(nil), v.Scopes...)
+		c.apiKeys = append(c.apiKeys, &row)
+	}
+	for k, v := range m.apiKeyUsage {
+		c.apiKeyUsage[k] = v
+	}
+	for k, v := range m.usage {
+		c.usage[k] = v
+	}
+	for k, v := range m.anonUsage {
+		c.anonUsage[k] = v
+	}
+	for _, v := range m.history {
+		row := *v
+		c.history = append(c.history, &row)
+	}
+	for _, v := range m.watches {
+		row := *v
+		c.watches = append(c.watches, &row)
+	}
+	for _, v := range m.alerts {
+		row := *v
+		c.alerts = append(c.alerts, &row)
+	}
+	for k, v := range m.seq {
+		c.seq[k] = v
+	}
+	c.nextSeq, c.nextID, c.lastTime = m.nextSeq, m.nextID, m.lastTime
+	return c
+}
+
+// restore replaces the stored rows with a snapshot from clone (caller holds mu)
+func (m *MemoryStore) restore(c *MemoryStore) {
+	m.videos, m.channels, m.uploads = c.videos, c.channels, c.uploads
+	m.captions, m.comments = c.captions, c.comments
+	m.jobs, m.jobUploads, m.batches, m.results = c.jobs, c.jobUploads, c.batches, c.results
+	m.users, m.subscriptions, m.refreshTokens, m.history = c.users, c.subscriptions, c.refreshTokens, c.history
+	m.watches, m.alerts, m.identities = c.watches, c.alerts, c.identities
+	m.invites, m.familyLinks, m.billingEvents = c.invites, c.familyLinks, c.billingEvents
+	m.apiKeys, m.apiKeyUsage, m.usage, m.anonUsage = c.apiKeys, c.apiKeyUsage, c.usage, c.anonUsage
+	m.seq, m.nextSeq, m.nextID = c.seq, c.nextSeq, c.nextID
+	// lastTime는 되돌리지 않음 (타임스탬프 단조 증가 유지)
+}
+
+// now returns a strictly increasing timestamp so "newest first" is
+// deterministic, like CURRENT_TIMESTAMP in separate statements.
+func (m *MemoryStore) now() time.Time {
+	t := time.Now().UTC().Truncate(time.Microsecond)
+	if !t.After(m.lastTime) {
+		t = m.lastTime.Add(time.Microsecond)
+	}
+	m.lastTime = t
+	return t
+}
+
+func (m *MemoryStore) id() int64 {
+	m.nextID++
+	return m.nextID
+}
+
+func (m *MemoryStore) mark(key interface{}) {
+	m.nextSeq++
+	m.seq[key] = m.nextSeq
+}
+
+// --- Videos ---
+
+func (m *MemoryStore) CreateVideo(ctx context.Context, v *Video) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	if existing, ok := m.videos[v.VideoID]; ok {
+		// ON CONFLICT: 제목/설명만 갱신, 빈 channel_id/thumbnail_url은 기존 값 유지
+		existing.Title = v.Title
+		existing.Description = v.Description
+		if v.ChannelID != "" {
+			existing.ChannelID = v.ChannelID
+		}
+		if v.ThumbnailURL != "" {
+			existing.ThumbnailURL = v.ThumbnailURL
+		}
+		existing.UpdatedAt = now
+		return nil
+	}
+
+	stored := *v
+	if stored.Platform == "" {
+		stored.Platform = "youtube"
+	}
+	stored.CreatedAt, stored.UpdatedAt = now, now
+	m.videos[v.VideoID] = &stored
+	return nil
+}
+
+func (m *MemoryStore) GetVideo(ctx context.Context, videoID string) (*Video, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.videos[videoID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	out := *v
+	return &out, nil
+}
+
+func (m *MemoryStore) UpsertChannel(ctx context.Context, c *Channel) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := *c
+	stored.UpdatedAt = m.now()
+	m.channels[c.ChannelID] = &stored
+	return nil
+}
+
+func (m *MemoryStore) GetChannel(ctx context.Context, channelID string) (*Channel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, ok := m.channels[channelID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	out := *c
+	return &out, nil
+}
+
+func (m *MemoryStore) CreateUpload(ctx context.Context, u *Upload) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.uploads[u.UploadID]; ok {
+		return fmt.Errorf("upload %s already exists", u.UploadID)
+	}
+	for _, existing := range m.uploads {
+		if existing.S3Key == u.S3Key {
+			return fmt.Errorf("upload with key %s already exists", u.S3Key)
+		}
+	}
+	u.CreatedAt = m.now()
+	stored := *u
+	m.uploads[u.UploadID] = &stored
+	return nil
+}
+
+func (m *MemoryStore) GetUpload(ctx context.Context, uploadID uuid.UUID) (*Upload, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.uploads[uploadID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	out := *u
+	return &out, nil
+}
+
+func (m *MemoryStore) SaveCaptions(ctx context.Context, videoID, language, text string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.captions = append(m.captions, Caption{
+		CaptionID: int(m.id()),
+		VideoID:   videoID,
+		Language:  language,
+		Text:      text,
+		CreatedAt: m.now(),
+	})
+	return nil
+}
+
+func (m *MemoryStore) SaveComments(ctx context.Context, videoID string, comments []Comment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := make([]Comment, 0, len(comments))
+	for _, c := range comments {
+		c.CommentID = int(m.id())
+		c.VideoID = videoID
+		c.AnalyzedAt = m.now()
+		stored = append(stored, c)
+	}
+	m.comments[videoID] = stored
+	return nil
+}
+
+func (m *MemoryStore) GetComments(ctx context.Context, videoID string, limit int) ([]Comment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	comments := append([]Comment(nil), m.comments[videoID]...)
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].Rank < comments[j].Rank })
+	if len(comments) > limit {
+		comments = comments[:limit]
+	}
+	return comments, nil
+}
+
+// --- Jobs & Batches ---
+
+func (m *MemoryStore) insertJob(job *AnalysisJob) *AnalysisJob {
+	job.CreatedAt = m.now()
+	stored := *job
+	m.jobs[job.JobID] = &stored
+	m.mark(job.JobID)
+	return job
+}
+
+func (m *MemoryStore) CreateJob(ctx context.Context, videoID string, userID int64) (*AnalysisJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.insertJob(&AnalysisJob{JobID: uuid.New(), VideoID: videoID, UserID: userID, Status: StatusPending}), nil
+}
+
+func (m *MemoryStore) CreateBatchJob(ctx context.Context, batchID uuid.UUID, videoID string) (*AnalysisJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	b, ok := m.batches[batchID]
+	if !ok {
+		return nil, fmt.Errorf("batch %s does not exist", batchID)
+	}
+	return m.insertJob(&AnalysisJob{
+		JobID:   uuid.New(),
+		BatchID: uuid.NullUUID{UUID: batchID, Valid: true},
+		VideoID: videoID,
+		UserID:  b.UserID,
+		Status:  StatusPending,
+	}), nil
+}
+
+func (m *MemoryStore) CreateUploadJob(ctx context.Context, uploadID uuid.UUID) (*AnalysisJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.uploads[uploadID]
+	if !ok {
+		return nil, fmt.Errorf("upload %s does not exist", uploadID)
+	}
+	job := m.insertJob(&AnalysisJob{
+		JobID:    uuid.New(),
+		UploadID: uuid.NullUUID{UUID: uploadID, Valid: true},
+		UserID:   u.UserID,
+		Status:   StatusPending,
+	})
+	m.jobUploads[job.JobID] = uploadID
+	return job, nil
+}
+
+func (m *MemoryStore) GetJob(ctx context.Context, jobID uuid.UUID) (*AnalysisJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	out := *job
+	return &out, nil
+}
+
+func (m *MemoryStore) UpdateJobStatus(ctx context.Context, jobID uuid.UUID, status string, progress int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil // UPDATE ... WHERE는 대상이 없어도 에러가 아님
+	}
+	job.Status = status
+	job.Progress = progress
+
+	now := m.now()
+	if status == StatusProcessing && !job.StartedAt.Valid {
+		job.StartedAt = sql.NullTime{Time: now, Valid: true}
+	}
+	if status == StatusCompleted || status == StatusFailed || status == StatusCancelled {
+		job.CompletedAt = sql.NullTime{Time: now, Valid: true}
+	}
+	return nil
+}
+
+func (m *MemoryStore) UpdateJobError(ctx context.Context, jobID uuid.UUID, errMsg string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if job, ok := m.jobs[jobID]; ok {
+		job.ErrorMessage = sql.NullString{String: errMsg, Valid: true}
+		job.Status = StatusFailed
+	}
+	return nil
+}
+
+func (m *MemoryStore) CreateBatch(ctx context.Context, b *AnalysisBatch) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	b.BatchID = uuid.New()
+	b.Status = StatusProcessing
+	b.CreatedAt = m.now()
+	stored := *b
+	m.batches[b.BatchID] = &stored
+	return nil
+}
+
+func (m *MemoryStore) GetBatch(ctx context.Context, batchID uuid.UUID) (*AnalysisBatch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	b, ok := m.batches[batchID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	out := *b
+	return &out, nil
+}
+
+func (m *MemoryStore) ListBatchItems(ctx context.Context, batchID uuid.UUID) ([]BatchItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var jobs []*AnalysisJob
+	for _, job := range m.jobs {
+		if job.BatchID.Valid && job.BatchID.UUID == batchID {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return m.seq[jobs[i].JobID] < m.seq[jobs[j].JobID] })
+
+	items := make([]BatchItem, 0, len(jobs))
+	for _, job := range jobs {
+		item := BatchItem{JobID: job.JobID, VideoID: job.VideoID, Status: job.Status}
+		if v, ok := m.videos[job.VideoID]; ok {
+			item.Title = v.Title
+		}
+		if r := m.latestResult(func(r *AnalysisResult) bool { return r.JobID == job.JobID }); r != nil {
+			item.SafetyScore = r.SafetyScore
+			item.HasResult = true
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (m *MemoryStore) UpdateBatchStatus(ctx context.Context, batchID uuid.UUID, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if b, ok := m.batches[batchID]; ok {
+		b.Status = status
+		if status == StatusCompleted || status == StatusFailed || status == StatusCancelled {
+			b.CompletedAt = sql.NullTime{Time: m.now(), Valid: true}
+		}
+	}
+	return nil
+}
+
+// --- Results ---
+
+func (m *MemoryStore) SaveResult(ctx context.Context, jobID uuid.UUID, safetyScore int, categories []string, geminiResp interface{}) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	if job.VideoID != "" {
+		if _, ok := m.videos[job.VideoID]; !ok {
+			return fmt.Errorf("video %s does not exist", job.VideoID)
+		}
+	}
+
+	categoriesJSON, _ := json.Marshal(categories)
+	geminiJSON, _ := json.Marshal(geminiResp)
+	now := m.now()
+	m.results = append(m.results, &AnalysisResult{
+		ResultID:       int(m.id()),
+		JobID:          jobID,
+		VideoID:        job.VideoID,
+		UploadID:       job.UploadID,
+		Source:         ResultSourceGemini,
+		SafetyScore:    safetyScore,
+		Categories:     string(categoriesJSON),
+		GeminiResponse: string(geminiJSON),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	return nil
+}
+
+func (m *MemoryStore) SaveReviewResult(ctx context.Context, jobID uuid.UUID, safetyScore int, reviewerID int64, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r := m.latestResult(func(r *AnalysisResult) bool { return r.JobID == jobID })
+	if r == nil {
+		return sql.ErrNoRows
+	}
+	now := m.now()
+	review := *r
+	review.ResultID = int(m.id())
+	review.Source = ResultSourceReview
+	review.SafetyScore = safetyScore
+	review.ReviewedBy = sql.NullInt64{Int64: reviewerID, Valid: true}
+	review.ReviewReason = reason
+	review.CreatedAt = now
+	review.UpdatedAt = now
+	m.results = append(m.results, &review)
+	return nil
+}
+
+// latestResult returns the newest result matching fn (caller holds mu)
+func (m *MemoryStore) latestResult(fn func(r *AnalysisResult) bool) *AnalysisResult {
+	for i := len(m.results) - 1; i >= 0; i-- {
+		if fn(m.results[i]) {
+			return m.results[i]
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetResult(ctx context.Context, jobID uuid.UUID) (*AnalysisResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r := m.latestResult(func(r *AnalysisResult) bool { return r.JobID == jobID })
+	if r == nil {
+		return nil, sql.ErrNoRows
+	}
+	out := *r
+	return &out, nil
+}
+
+func (m *MemoryStore) GetLatestResult(ctx context.Context, videoOrUploadID string) (*AnalysisResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r := m.latestResult(func(r *AnalysisResult) bool {
+		return r.VideoID == videoOrUploadID || (r.UploadID.Valid && r.UploadID.UUID.String() == videoOrUploadID)
+	})
+	if r == nil {
+		return nil, sql.ErrNoRows
+	}
+	out := *r
+	return &out, nil
+}
+
+// --- Users ---
+
+func (m *MemoryStore) UpsertUser(ctx context.Context, email, name, picture, providerID string) (*User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var user *User
+	for _, u := range m.users {
+		if u.Email == email {
+			user = u
+			break
+		}
+	}
+	if user == nil {
+		user = &User{ID: m.id(), Email: email, Role: defaultUserRole, CreatedAt: m.now()}
+		m.users[user.ID] = user
+	}
+	// 빈 값은 기존 값 유지 (PostgresStore의 COALESCE(NULLIF(...)))
+	if name != "" {
+		user.Name = name
+	}
+	if picture != "" {
+		user.PictureURL = picture
+	}
+	if providerID != "" {
+		user.ProviderID = providerID
+	}
+
+	if _, ok := m.subscriptions[user.ID]; !ok {
+		m.subscriptions[user.ID] = &Subscription{UserID: user.ID, PlanType: "free", Status: SubscriptionActive}
+	}
+
+	out := *user
+	return &out, nil
+}
+
+func (m *MemoryStore) GetUserByID(ctx context.Context, id int64) (*User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.users[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	// PostgresStore와 동일하게 프로필 필드만 반환
+	return &User{ID: u.ID, Email: u.Email, Name: u.Name, PictureURL: u.PictureURL, Role: u.Role}, nil
+}
+
+func (m *MemoryStore) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, u := range m.users {
+		if u.Email == email {
+			return &User{ID: u.ID, Email: u.Email, Name: u.Name, PictureURL: u.PictureURL, Role: u.Role}, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *MemoryStore) GetUserByIdentity(ctx context.Context, provider, subject string) (*User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, i := range m.identities {
+		if i.Provider == provider && i.Subject == subject {
+			u := m.users[i.UserID]
+			return &User{ID: u.ID, Email: u.Email, Name: u.Name, PictureURL: u.PictureURL, Role: u.Role}, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *MemoryStore) LinkIdentity(ctx context.Context, userID int64, provider, subject, email string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[userID]; !ok {
+		return fmt.Errorf("user %d does not exist", userID)
+	}
+	now := m.now()
+	for _, i := range m.identities {
+		if i.Provider != provider || i.Subject != subject {
+			continue
+		}
+		if i.UserID != userID {
+			return fmt.Errorf("%s account is linked to another user", provider)
+		}
+		i.LastLoginAt = now
+		if email != "" {
+			i.Email = email
+		}
+		return nil
+	}
+	m.identities = append(m.identities, &UserIdentity{
+		ID:          m.id(),
+		UserID:      userID,
+		Provider:    provider,
+		Subject:     subject,
+		Email:       email,
+		CreatedAt:   now,
+		LastLoginAt: now,
+	})
+	return nil
+}
+
+func (m *MemoryStore) SetUserRole(ctx context.Context, userID int64, role string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.users[userID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	u.Role = role
+	return nil
+}
+
+func (m *MemoryStore) ListIdentities(ctx context.Context, userID int64) ([]UserIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []UserIdentity
+	for _, i := range m.identities {
+		if i.UserID == userID {
+			out = append(out, *i)
+		}
+	}
+	return out, nil
+}
+
+func (m *MemoryStore) GetSubscription(ctx context.Context, userID int64) (*Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sub, ok := m.subscriptions[userID]
+	if !ok {
+		return &Subscription{UserID: userID, PlanType: "free", Status: SubscriptionActive}, nil
+	}
+	out := *sub
+	return &out, nil
+}
+
+func (m *MemoryStore) SaveSubscription(ctx context.Context, sub *Subscription) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[sub.UserID]; !ok {
+		return fmt.Errorf("user %d does not exist", sub.UserID)
+	}
+	row := *sub
+	m.subscriptions[sub.UserID] = &row
+	return nil
+}
+
+func (m *MemoryStore) ExpireSubscriptions(ctx context.Context, now time.Time, limit int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expired []*Subscription
+	for _, sub := range m.subscriptions {
+		if sub.PlanType != "free" && sub.EndDate.Valid && !sub.EndDate.Time.After(now) {
+			expired = append(expired, sub)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].EndDate.Time.Before(expired[j].EndDate.Time)
+	})
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	for _, sub := range expired {
+		sub.PlanType, sub.Status = "free", SubscriptionExpired
+	}
+	return len(expired), nil
+}
+
+func (m *MemoryStore) RecordBillingEvent(ctx context.Context, e *BillingEvent) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.billingEvents[e.EventID]; ok {
+		return false, nil
+	}
+	e.ReceivedAt = m.now()
+	row := *e
+	m.billingEvents[e.EventID] = &row
+	return true, nil
+}
+
+// --- Sessions ---
+
+func (m *MemoryStore) CreateRefreshToken(ctx context.Context, t *RefreshToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[t.UserID]; !ok {
+		return fmt.Errorf("user %d does not exist", t.UserID)
+	}
+	for _, existing := range m.refreshTokens {
+		if existing.TokenHash == t.TokenHash {
+			return fmt.Errorf("refresh token already exists")
+		}
+	}
+	t.ID = m.id()
+	t.CreatedAt = m.now()
+	stored := *t
+	m.refreshTokens = append(m.refreshTokens, &stored)
+	return nil
+}
+
+func (m *MemoryStore) GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, t := range m.refreshTokens {
+		if t.TokenHash == tokenHash {
+			out := *t
+			return &out, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *MemoryStore) MarkRefreshTokenUsed(ctx context.Context, id int64) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, t := range m.refreshTokens {
+		if t.ID == id && !t.UsedAt.Valid && !t.RevokedAt.Valid {
+			t.UsedAt = sql.NullTime{Time: m.now(), Valid: true}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MemoryStore) RevokeRefreshFamily(ctx context.Context, familyID uuid.UUID) (int, error) {
+	return m.revokeRefreshTokens(func(t *RefreshToken) bool { return t.FamilyID == familyID })
+}
+
+func (m *MemoryStore) RevokeUserRefreshTokens(ctx context.Context, userID int64) (int, error) {
+	return m.revokeRefreshTokens(func(t *RefreshToken) bool { return t.UserID == userID })
+}
+
+func (m *MemoryStore) revokeRefreshTokens(match func(*RefreshToken) bool) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	revoked := 0
+	now := m.now()
+	for _, t := range m.refreshTokens {
+		if match(t) && !t.RevokedAt.Valid && !t.UsedAt.Valid && t.ExpiresAt.After(now) {
+			t.RevokedAt = sql.NullTime{Time: now, Valid: true}
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+// --- Family ---
+
+func (m *MemoryStore) CreateFamilyInvite(ctx context.Context, inv *FamilyInvite) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[inv.GuardianID]; !ok {
+		return fmt.Errorf("user %d does not exist", inv.GuardianID)
+	}
+	for _, existing := range m.invites {
+		if existing.CodeHash == inv.CodeHash {
+			return fmt.Errorf("invite code already exists")
+		}
+	}
+	inv.ID = m.id()
+	inv.CreatedAt = m.now()
+	stored := *inv
+	m.invites = append(m.invites, &stored)
+	return nil
+}
+
+func (m *MemoryStore) ClaimFamilyInvite(ctx context.Context, codeHash string, seniorID int64, now time.Time) (*FamilyInvite, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, inv := range m.invites {
+		if inv.CodeHash != codeHash || inv.ClaimedAt.Valid || !inv.ExpiresAt.After(now) {
+			continue
+		}
+		inv.ClaimedBy = sql.NullInt64{Int64: seniorID, Valid: true}
+		inv.ClaimedAt = sql.NullTime{Time: now, Valid: true}
+		out := *inv
+		return &out, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *MemoryStore) RequestFamilyLink(ctx context.Context, guardianID, seniorID int64, role string) (*FamilyLink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if guardianID == seniorID {
+		return nil, fmt.Errorf("guardian and senior must differ")
+	}
+	for _, id := range []int64{guardianID, seniorID} {
+		if _, ok := m.users[id]; !ok {
+			return nil, fmt.Errorf("user %d does not exist", id)
+		}
+	}
+	for _, l := range m.familyLinks {
+		if l.GuardianID != guardianID || l.SeniorID != seniorID {
+			continue
+		}
+		if l.Status != FamilyActive {
+			l.Role, l.Status, l.CreatedAt = role, FamilyPending, m.now()
+			l.ApprovedAt, l.RevokedAt = sql.NullTime{}, sql.NullTime{}
+		}
+		out := *l
+		return &out, nil
+	}
+	l := &FamilyLink{
+		ID:         m.id(),
+		GuardianID: guardianID,
+		SeniorID:   seniorID,
+		Role:       role,
+		Status:     FamilyPending,
+		CreatedAt:  m.now(),
+	}
+	m.familyLinks = append(m.familyLinks, l)
+	out := *l
+	return &out, nil
+}
+
+func (m *MemoryStore) GetFamilyLink(ctx context.Context, linkID int64) (*FamilyLink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, l := range m.familyLinks {
+		if l.ID == linkID {
+			out := *l
+			return &out, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *MemoryStore) GetFamilyLinkBetween(ctx context.Context, guardianID, seniorID int64) (*FamilyLink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, l := range m.familyLinks {
+		if l.GuardianID == guardianID && l.SeniorID == seniorID {
+			out := *l
+			return &out, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *MemoryStore) SetFamilyLinkStatus(ctx context.Context, linkID int64, status string, from ...string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, l := range m.familyLinks {
+		if l.ID != linkID {
+			continue
+		}
+		for _, f := range from {
+			if l.Status != f {
+				continue
+			}
+			l.Status = status
+			switch status {
+			case FamilyActive:
+				l.ApprovedAt = sql.NullTime{Time: m.now(), Valid: true}
+			case FamilyDeclined, FamilyRevoked:
+				l.RevokedAt = sql.NullTime{Time: m.now(), Valid: true}
+			}
+			return true, nil
+		}
+		return false, nil
+	}
+	return false, nil
+}
+
+func (m *MemoryStore) ListFamilyLinks(ctx context.Context, userID int64) ([]FamilyLink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []FamilyLink
+	for _, l := range m.familyLinks {
+		if l.GuardianID == userID || l.SeniorID == userID {
+			out = append(out, *l)
+		}
+	}
+	// 다시 요청된 연결은 created_at이 바뀌므로 Postgres와 같이 정렬
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+// --- API keys ---
+
+// apiKeyDay is the api_key_usage primary key
+type apiKeyDay struct {
+	keyID int64
+	day   string
+}
+
+func (m *MemoryStore) CreateAPIKey(ctx context.Context, k *APIKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, existing := range m.apiKeys {
+		if existing.KeyHash == k.KeyHash {
+			return fmt.Errorf("api key hash already exists")
+		}
+	}
+	k.ID = m.id()
+	k.CreatedAt = m.now()
+	row := *k
+	row.Scopes = append([]string(nil), k.Scopes...)
+	m.apiKeys = append(m.apiKeys, &row)
+	return nil
+}
+
+// apiKey returns the key matching fn (caller holds mu)
+func (m *MemoryStore) apiKey(fn func(k *APIKey) bool) (*APIKey, error) {
+	for _, k := range m.apiKeys {
+		if fn(k) {
+			out := *k
+			out.Scopes = append([]string(nil), k.Scopes...)
+			return &out, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *MemoryStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.apiKey(func(k *APIKey) bool { return k.KeyHash == keyHash })
+}
+
+func (m *MemoryStore) GetAPIKey(ctx context.Context, keyID int64) (*APIKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.apiKey(func(k *APIKey) bool { return k.ID == keyID })
+}
+
+func (m *MemoryStore) RevokeAPIKey(ctx context.Context, keyID int64) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, k := range m.apiKeys {
+		if k.ID == keyID && !k.RevokedAt.Valid {
+			k.RevokedAt = sql.NullTime{Time: m.now(), Valid: true}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MemoryStore) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []APIKey
+	for _, k := range m.apiKeys {
+		row := *k
+		row.Scopes = append([]string(nil), k.Scopes...)
+		out = append(out, row)
+	}
+	return out, nil
+}
+
+func (m *MemoryStore) UseAPIKey(ctx context.Context, keyID int64, day time.Time, n, quota int) (int, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, k := range m.apiKeys {
+		if k.ID == keyID {
+			k.LastUsedAt = sql.NullTime{Time: m.now(), Valid: true}
+		}
+	}
+	key := apiKeyDay{keyID: keyID, day: day.UTC().Format("2006-01-02")}
+	used := m.apiKeyUsage[key]
+	if quota > 0 && used+n > quota {
+		return used, false, nil
+	}
+	m.apiKeyUsage[key] = used + n
+	return used + n, true, nil
+}
+
+// --- Usage ---
+
+// usageDay is the usage_counters primary key
+type usageDay struct {
+	userID  int64
+	feature string
+	day     string
+}
+
+func (m *MemoryStore) UseDailyUsage(ctx context.Context, userID int64, feature string, day time.Time, n, limit int) (int, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[userID]; !ok {
+		return 0, false, fmt.Errorf("user %d does not exist", userID)
+	}
+	key := usageDay{userID: userID, feature: feature, day: day.UTC().Format("2006-01-02")}
+	used := m.usage[key]
+	if limit > 0 && used+n > limit {
+		return used, false, nil
+	}
+	m.usage[key] = used + n
+	return used + n, true, nil
+}
+
+func (m *MemoryStore) GetDailyUsage(ctx context.Context, userID int64, feature string, day time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.usage[usageDay{userID: userID, feature: feature, day: day.UTC().Format("2006-01-02")}], nil
+}
+
+// anonUsageDay is the anonymous_usage primary key
+type anonUsageDay struct {
+	clientIP string
+	feature  string
+	day      string
+}
+
+func (m *MemoryStore) UseAnonymousUsage(ctx context.Context, clientIP, feature string, day time.Time, n, limit int) (int, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := anonUsageDay{clientIP: clientIP, feature: feature, day: day.UTC().Format("2006-01-02")}
+	used := m.anonUsage[key]
+	if limit > 0 && used+n > limit {
+		return used, false, nil
+	}
+	m.anonUsage[key] = used + n
+	return used + n, true, nil
+}
+
+// --- History ---
+
+func (m *MemoryStore) AddHistory(ctx context.Context, userID int64, jobID uuid.UUID, videoID, title, thumb string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.jobs[jobID]; !ok {
+		return fmt.Errorf("job %s does not exist", jobID)
+	}
+
+	entry := &AnalysisHistory{UserID: userID, VideoID: videoID, VideoTitle: title, ThumbnailURL: thumb}
+	for i, h := range m.history {
+		if h.UserID == userID && h.VideoID == videoID {
+			// 재검사: 기존 행을 최신 Job으로 갱신하고 맨 뒤(최신)로 이동
+			entry = h
+			entry.CheckCount++
+			if entry.VideoTitle == "" {
+				entry.VideoTitle = title
+			}
+			if entry.ThumbnailURL == "" {
+				entry.ThumbnailURL = thumb
+			}
+			m.history = append(m.history[:i], m.history[i+1:]...)
+			break
+		}
+	}
+	if entry.ID == 0 {
+		entry.ID = m.id()
+		entry.CheckCount = 1
+	}
+	entry.JobID = jobID.String()
+	entry.CreatedAt = m.now()
+	m.history = append(m.history, entry)
+	return nil
+}
+
+func (m *MemoryStore) DeleteHistory(ctx context.Context, userID int64, videoID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, h := range m.history {
+		if h.UserID == userID && h.VideoID == videoID {
+			m.history = append(m.history[:i], m.history[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MemoryStore) ClearHistory(ctx context.Context, userID int64) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kept := m.history[:0]
+	for _, h := range m.history {
+		if h.UserID != userID {
+			kept = append(kept, h)
+		}
+	}
+	deleted := len(m.history) - len(kept)
+	m.history = kept
+	return deleted, nil
+}
+
+func (m *MemoryStore) BackfillHistory(ctx context.Context, videoID, title, thumbnailURL string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, h := range m.history {
+		if h.VideoID != videoID {
+			continue
+		}
+		if title != "" && (h.VideoTitle == "" || h.VideoTitle == "Processing...") {
+			h.VideoTitle = title
+		}
+		if thumbnailURL != "" && h.ThumbnailURL == "" {
+			h.ThumbnailURL = thumbnailURL
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetHistory(ctx context.Context, userID int64, f HistoryFilter, after *HistoryCursor, limit int) (*HistoryPage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, _, err := f.scoreRange(); err != nil {
+		return nil, err
+	}
+	if limit < 1 {
+		limit = 1
+	}
+
+	page := &HistoryPage{}
+	for i := len(m.history) - 1; i >= 0; i-- {
+		h := m.history[i]
+		if h.UserID != userID {
+			continue
+		}
+		out := m.historyRow(h)
+		if !f.matches(out) {
+			continue
+		}
+		page.Total++
+		if (after == nil || after.follows(out)) && len(page.Items) <= limit {
+			page.Items = append(page.Items, out)
+		}
+	}
+
+	page.trim(limit)
+	return page, nil
+}
+
+// historyRow resolves a history entry's title, thumbnail and result like
+// the Postgres joins (caller holds mu)
+func (m *MemoryStore) historyRow(h *AnalysisHistory) *AnalysisHistory {
+	out := *h
+	if v, ok := m.videos[h.VideoID]; ok {
+		out.VideoTitle = v.Title
+		if v.ThumbnailURL != "" {
+			out.ThumbnailURL = v.ThumbnailURL
+		}
+	}
+	if jobID, err := uuid.Parse(h.JobID); err == nil {
+		if job, ok := m.jobs[jobID]; ok {
+			out.JobStatus = job.Status
+		}
+		if r := m.latestResult(func(r *AnalysisResult) bool { return r.JobID == jobID }); r != nil {
+			out.SafetyScore, out.HasResult = r.SafetyScore, true
+		}
+	}
+	return &out
+}
+
+// --- Watchlist ---
+
+func (m *MemoryStore) AddWatch(ctx context.Context, w *ChannelWatch) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, existing := range m.watches {
+		if existing.UserID == w.UserID && existing.ChannelID == w.ChannelID {
+			existing.ChannelTitle = w.ChannelTitle
+			existing.UploadsPlaylistID = w.UploadsPlaylistID
+			*w = *existing
+			return nil
+		}
+	}
+
+	now := m.now()
+	w.WatchID = m.id()
+	w.LastUploadAt = now
+	w.LastCheckedAt = sql.NullTime{}
+	w.CreatedAt = now
+	stored := *w
+	m.watches = append(m.watches, &stored)
+	return nil
+}
+
+func (m *MemoryStore) RemoveWatch(ctx context.Context, userID int64, channelID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, w := range m.watches {
+		if w.UserID == userID && w.ChannelID == channelID {
+			m.watches = append(m.watches[:i], m.watches[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MemoryStore) ListWatches(ctx context.Context, userID int64) ([]ChannelWatch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var watches []ChannelWatch
+	for i := len(m.watches) - 1; i >= 0; i-- {
+		if m.watches[i].UserID == userID {
+			watches = append(watches, *m.watches[i])
+		}
+	}
+	return watches, nil
+}
+
+func (m *MemoryStore) ListWatchedChannels(ctx context.Context) ([]WatchedChannel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	type group struct {
+		WatchedChannel
+		lastChecked sql.NullTime
+	}
+	byChannel := make(map[string]*group)
+	var order []string
+	for _, w := range m.watches {
+		g, ok := byChannel[w.ChannelID]
+		if !ok {
+			g = &group{WatchedChannel: WatchedChannel{ChannelID: w.ChannelID, Since: w.LastUploadAt}, lastChecked: w.LastCheckedAt}
+			byChannel[w.ChannelID] = g
+			order = append(order, w.ChannelID)
+		}
+		if w.UploadsPlaylistID > g.UploadsPlaylistID {
+			g.UploadsPlaylistID = w.UploadsPlaylistID
+		}
+		if w.LastUploadAt.Before(g.Since) {
+			g.Since = w.LastUploadAt
+		}
+		if w.LastCheckedAt.Valid && (!g.lastChecked.Valid || w.LastCheckedAt.Time.Before(g.lastChecked.Time)) {
+			g.lastChecked = w.LastCheckedAt
+		}
+	}
+
+	// 한 번도 확인하지 않은 채널 먼저, 그다음 오래전에 확인한 순서
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := byChannel[order[i]].lastChecked, byChannel[order[j]].lastChecked
+		if !a.Valid || !b.Valid {
+			return !a.Valid && b.Valid
+		}
+		return a.Time.Before(b.Time)
+	})
+
+	channels := make([]WatchedChannel, 0, len(order))
+	for _, id := range order {
+		channels = append(channels, byChannel[id].WatchedChannel)
+	}
+	return channels, nil
+}
+
+func (m *MemoryStore) AdvanceWatches(ctx context.Context, channelID string, uploadedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for _, w := range m.watches {
+		if w.ChannelID != channelID {
+			continue
+		}
+		if uploadedAt.After(w.LastUploadAt) {
+			w.LastUploadAt = uploadedAt
+		}
+		w.LastCheckedAt = sql.NullTime{Time: now, Valid: true}
+	}
+	return nil
+}
+
+func (m *MemoryStore) CreateWatchAlerts(ctx context.Context, channelID, videoID, title string, jobID uuid.UUID, safetyScore int, publishedAt time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	created := 0
+	for _, w := range m.watches {
+		if w.ChannelID != channelID || !w.LastUploadAt.Before(publishedAt) {
+			continue
+		}
+		duplicate := false
+		for _, a := range m.alerts {
+			if a.UserID == w.UserID && a.Kind == AlertKindWatch && a.VideoID == videoID {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		m.alerts = append(m.alerts, &WatchAlert{
+			AlertID:     m.id(),
+			UserID:      w.UserID,
+			Kind:        AlertKindWatch,
+			ChannelID:   channelID,
+			VideoID:     videoID,
+			JobID:       uuid.NullUUID{UUID: jobID, Valid: true},
+			VideoTitle:  title,
+			SafetyScore: safetyScore,
+			Verdict:     VerdictForScore(safetyScore),
+			CreatedAt:   m.now(),
+		})
+		created++
+	}
+	return created, nil
+}
+
+func (m *MemoryStore) CreateFamilyAlert(ctx context.Context, jobID uuid.UUID, safetyScore int) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[jobID]
+	if !ok || job.UserID == 0 || job.VideoID == "" {
+		return false, nil
+	}
+	video, ok := m.videos[job.VideoID]
+	if !ok {
+		return false, nil
+	}
+	guarded := false
+	for _, l := range m.familyLinks {
+		if l.SeniorID == job.UserID && l.Status == FamilyActive && l.Role == FamilyRoleGuardian {
+			guarded = true
+			break
+		}
+	}
+	if !guarded {
+		return false, nil
+	}
+	alertID := m.id()
+	for i, a := range m.alerts {
+		if a.UserID == job.UserID && a.Kind == AlertKindFamily && a.VideoID == job.VideoID {
+			// 다시 검사한 영상은 최신 결과로 갱신하고 목록 맨 앞으로 올린다
+			alertID = a.AlertID
+			m.alerts = append(m.alerts[:i], m.alerts[i+1:]...)
+			break
+		}
+	}
+	m.alerts = append(m.alerts, &WatchAlert{
+		AlertID:     alertID,
+		UserID:      job.UserID,
+		Kind:        AlertKindFamily,
+		ChannelID:   video.ChannelID,
+		VideoID:     job.VideoID,
+		JobID:       uuid.NullUUID{UUID: jobID, Valid: true},
+		VideoTitle:  video.Title,
+		SafetyScore: safetyScore,
+		Verdict:     VerdictForScore(safetyScore),
+		CreatedAt:   m.now(),
+	})
+	return true, nil
+}
+
+func (m *MemoryStore) ListWatchAlerts(ctx context.Context, userID int64, unreadOnly bool, limit int) ([]WatchAlert, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var alerts []WatchAlert
+	for i := len(m.alerts) - 1; i >= 0 && len(alerts) < limit; i-- {
+		a := m.alerts[i]
+		if a.UserID != userID || (unreadOnly && a.ReadAt.Valid) {
+			continue
+		}
+		alerts = append(alerts, *a)
+	}
+	return alerts, nil
+}
+
+func (m *MemoryStore) MarkWatchAlertsRead(ctx context.Context, userID int64, alertIDs []int64) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := make(map[int64]bool, len(alertIDs))
+	for _, id := range alertIDs {
+		ids[id] = true
+	}
+
+	updated := 0
+	now := m.now()
+	for _, a := range m.alerts {
+		if a.UserID == userID && ids[a.AlertID] && !a.ReadAt.Valid {
+			a.ReadAt = sql.NullTime{Time: now, Valid: true}
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// --- Retention ---
+
+func (m *MemoryStore) PurgeCaptions(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kept := m.captions[:0]
+	deleted := 0
+	for _, c := range m.captions {
+		if deleted < limit && c.CreatedAt.Before(before) {
+			deleted++
+			continue
+		}
+		kept = append(kept, c)
+	}
+	m.captions = kept
+	return deleted, nil
+}
+
+func (m *MemoryStore) PurgeComments(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// 오래된 댓글부터 (comment_id 순) limit개
+	var expired []int
+	for _, comments := range m.comments {
+		for _, c := range comments {
+			if c.AnalyzedAt.Before(before) {
+				expired = append(expired, c.CommentID)
+			}
+		}
+	}
+	sort.Ints(expired)
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	purge := make(map[int]bool, len(expired))
+	for _, id := range expired {
+		purge[id] = true
+	}
+
+	for videoID, comments := range m.comments {
+		kept := comments[:0]
+		for _, c := range comments {
+			if !purge[c.CommentID] {
+				kept = append(kept, c)
+			}
+		}
+		if len(kept) == 0 {
+			delete(m.comments, videoID)
+		} else {
+			m.comments[videoID] = kept
+		}
+	}
+	return len(expired), nil
+}
+
+func (m *MemoryStore) ListExpiredUploads(ctx context.Context, before time.Time, limit int) ([]Upload, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var uploads []Upload
+	for _, u := range m.uploads {
+		if u.CreatedAt.Before(before) {
+			uploads = append(uploads, *u)
+		}
+	}
+	sort.Slice(uploads, func(i, j int) bool { return uploads[i].CreatedAt.Before(uploads[j].CreatedAt) })
+	if len(uploads) > limit {
+		uploads = uploads[:limit]
+	}
+	return uploads, nil
+}
+
+func (m *MemoryStore) DeleteUploads(ctx context.Context, ids []uuid.UUID) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deleted := 0
+	for _, id := range ids {
+		if _, ok := m.uploads[id]; !ok {
+			continue
+		}
+		delete(m.uploads, id)
+		deleted++
+
+		// ON DELETE CASCADE: 업로드의 Job과 결과 삭제, 기록은 Job 연결만 해제
+		for jobID, uploadID := range m.jobUploads {
+			if uploadID != id {
+				continue
+			}
+			delete(m.jobs, jobID)
+			delete(m.jobUploads, jobID)
+			for _, h := range m.history {
+				if h.JobID == jobID.String() {
+					h.JobID = ""
+				}
+			}
+		}
+		results := m.results[:0]
+		for _, r := range m.results {
+			if !(r.UploadID.Valid && r.UploadID.UUID == id) {
+				results = append(results, r)
+			}
+		}
+		m.results = results
+	}
+	return deleted, nil
+}
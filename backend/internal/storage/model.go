@@ -8,20 +8,39 @@ import (
 )
 
 type Video struct {
-    VideoID     string       `db:"video_id"`
-    Title       string       `db:"title"`
-    Description string       `db:"description"`
-    Channel     string       `db:"channel"`
-    Duration    int64        `db:"duration"`
-    ViewCount   int64        `db:"view_count"`
-    PublishedAt time.Time    `db:"published_at"`
-    CreatedAt   time.Time    `db:"created_at"`
-    UpdatedAt   time.Time    `db:"updated_at"`
+    VideoID      string       `db:"video_id"`
+    Platform     string       `db:"platform"`
+    Title        string       `db:"title"`
+    Description  string       `db:"description"`
+    Channel      string       `db:"channel"`
+    ChannelID    string       `db:"channel_id"`
+    ThumbnailURL string       `db:"thumbnail_url"`
+    Duration     int64        `db:"duration"`
+    ViewCount    int64        `db:"view_count"`
+    PublishedAt  time.Time    `db:"published_at"`
+    CreatedAt    time.Time    `db:"created_at"`
+    UpdatedAt    time.Time    `db:"updated_at"`
+}
+
+type Channel struct {
+    ChannelID             string    `db:"channel_id"`
+    Title                 string    `db:"title"`
+    CustomURL             string    `db:"custom_url"`
+    Country               string    `db:"country"`
+    SubscriberCount       int64     `db:"subscriber_count"`
+    VideoCount            int64     `db:"video_count"`
+    HiddenSubscriberCount bool      `db:"hidden_subscriber_count"`
+    Verified              bool      `db:"verified"`
+    ChannelCreatedAt      time.Time `db:"channel_created_at"`
+    UpdatedAt             time.Time `db:"updated_at"`
 }
 
 type AnalysisJob struct {
     JobID        uuid.UUID      `db:"job_id"`
-    VideoID      string         `db:"video_id"`
+    BatchID      uuid.NullUUID  `db:"batch_id"`
+    UploadID     uuid.NullUUID  `db:"upload_id"` // 업로드 영상 분석 Job
+    VideoID      string         `db:"video_id"`  // 업로드 Job은 빈 문자열
+    UserID       int64          `db:"user_id"`   // 요청한 사용자, 익명/워치리스트 Job은 0
     Status       string         `db:"status"`
     Progress     int            `db:"progress"`
     CreatedAt    time.Time      `db:"created_at"`
@@ -30,13 +49,73 @@ type AnalysisJob struct {
     ErrorMessage sql.NullString `db:"error_message"`
 }
 
+// AnalysisBatch groups the child jobs of a playlist/channel analysis
+type AnalysisBatch struct {
+    BatchID     uuid.UUID    `db:"batch_id"`
+    UserID      int64        `db:"user_id"` // 0 for anonymous
+    Kind        string       `db:"kind"`    // "playlist" or "channel"
+    SourceURL   string       `db:"source_url"`
+    Title       string       `db:"title"`
+    ChannelID   string       `db:"channel_id"`
+    Total       int          `db:"total"`
+    Status      string       `db:"status"`
+    CreatedAt   time.Time    `db:"created_at"`
+    CompletedAt sql.NullTime `db:"completed_at"`
+}
+
+// BatchItem is one child job of a batch with its result, if any
+type BatchItem struct {
+    JobID       uuid.UUID
+    VideoID     string
+    Title       string
+    Status      string
+    SafetyScore int
+    HasResult   bool
+}
+
+// Result sources
+const (
+    ResultSourceGemini   = "gemini"   // URL 분석 (Gemini)
+    ResultSourcePipeline = "pipeline" // S3 업로드 ML 파이프라인 (Lambda)
+    ResultSourceReview   = "review"   // 검토자/관리자가 변경한 판정
+)
+
+// AnalysisResult is one verdict of a job. A job can have several results
+// (live re-scans); the newest one wins. Video results have VideoID set,
+// upload results have UploadID and the per-signal scores.
 type AnalysisResult struct {
-    ResultID       int       `db:"result_id"`
-    JobID          uuid.UUID `db:"job_id"`
-    SafetyScore    int       `db:"safety_score"`
-    Categories     string    `db:"categories"`     // JSON
-    GeminiResponse string    `db:"gemini_response"` // JSON
-    CreatedAt      time.Time `db:"created_at"`
+    ResultID       int             `db:"result_id"`
+    JobID          uuid.UUID       `db:"job_id"`
+    VideoID        string          `db:"video_id"`
+    UploadID       uuid.NullUUID   `db:"upload_id"`
+    Source         string          `db:"source"`
+    SafetyScore    int             `db:"safety_score"`
+    AudioScore     sql.NullFloat64 `db:"audio_score"`   // 오디오 딥페이크 확률 (0.0-1.0)
+    VideoScore     sql.NullFloat64 `db:"video_score"`   // 비디오 조작 확률 (0.0-1.0)
+    ContextScore   sql.NullFloat64 `db:"context_score"` // 컨텍스트 사기 확률 (0.0-1.0)
+    Categories     string          `db:"categories"`      // JSON
+    GeminiResponse string          `db:"gemini_response"` // JSON
+    ReviewedBy     sql.NullInt64   `db:"reviewed_by"`     // source = review
+    ReviewReason   string          `db:"review_reason"`
+    CreatedAt      time.Time       `db:"created_at"`
+    UpdatedAt      time.Time       `db:"updated_at"`
+}
+
+// RiskScore is the pipeline's 0-100 risk score (final_score), the inverse of SafetyScore
+func (r *AnalysisResult) RiskScore() int {
+    return 100 - r.SafetyScore
+}
+
+// Upload is a video the user uploaded to S3 for analysis
+type Upload struct {
+    UploadID    uuid.UUID `db:"upload_id"`
+    UserID      int64     `db:"user_id"` // 0 for anonymous
+    S3Bucket    string    `db:"s3_bucket"`
+    S3Key       string    `db:"s3_key"`
+    Filename    string    `db:"filename"`
+    ContentType string    `db:"content_type"`
+    SizeBytes   int64     `db:"size_bytes"`
+    CreatedAt   time.Time `db:"created_at"`
 }
 
 type Caption struct {
@@ -63,14 +142,115 @@ type User struct {
 	Name       string    `json:"name"`
 	PictureURL string    `json:"picture_url"`
 	ProviderID string    `json:"provider_id"`
+	Role       string    `json:"role"` // user, guardian, reviewer, admin
 	CreatedAt  time.Time `json:"created_at"`
 }
 
-type Subscription struct {
+// UserIdentity links a login provider account (google, kakao, naver) to a
+// user. A user can have several, linked by verified email.
+type UserIdentity struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Provider    string    `json:"provider"`
+	Subject     string    `json:"subject"` // account ID at the provider
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at"`
+}
+
+// FamilyInvite is a one-time code a guardian gives a senior. Only the
+// SHA-256 hash of the code is kept.
+type FamilyInvite struct {
+	ID         int64         `json:"id"`
+	GuardianID int64         `json:"guardian_id"`
+	CodeHash   string        `json:"-"`
+	Role       string        `json:"role"`
+	ExpiresAt  time.Time     `json:"expires_at"`
+	ClaimedBy  sql.NullInt64 `json:"claimed_by"`
+	ClaimedAt  sql.NullTime  `json:"claimed_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// FamilyLink lets a guardian see a senior's data once the senior approves.
+type FamilyLink struct {
+	ID         int64        `json:"id"`
+	GuardianID int64        `json:"guardian_id"`
+	SeniorID   int64        `json:"senior_id"`
+	Role       string       `json:"role"`
+	Status     string       `json:"status"`
+	CreatedAt  time.Time    `json:"created_at"`
+	ApprovedAt sql.NullTime `json:"approved_at"`
+	RevokedAt  sql.NullTime `json:"revoked_at"` // set when declined or revoked
+}
+
+// Family link roles: what a guardian may see of the senior's data
+const (
+	FamilyRoleGuardian = "guardian" // history and alerts
+	FamilyRoleViewer   = "viewer"   // history only
+)
+
+// Family link statuses
+const (
+	FamilyPending  = "pending" // claimed, waiting for the senior's approval
+	FamilyActive   = "active"
+	FamilyDeclined = "declined"
+	FamilyRevoked  = "revoked"
+)
+
+// RefreshToken is a stored refresh token. Only the SHA-256 hash of the
+// token is kept. Tokens issued by rotating one another share a FamilyID.
+type RefreshToken struct {
+	ID        int64        `json:"id"`
 	UserID    int64        `json:"user_id"`
-	PlanType  string       `json:"plan_type"`
-	StartDate sql.NullTime `json:"start_date"`
-	EndDate   sql.NullTime `json:"end_date"`
+	FamilyID  uuid.UUID    `json:"family_id"`
+	TokenHash string       `json:"-"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    sql.NullTime `json:"used_at"`    // set when rotated
+	RevokedAt sql.NullTime `json:"revoked_at"` // set on logout or reuse
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// APIKey is a partner credential. Only the SHA-256 hash of the key is
+// kept; Prefix is its first characters, shown to tell keys apart.
+type APIKey struct {
+	ID         int64         `json:"id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"`
+	KeyHash    string        `json:"-"`
+	Scopes     []string      `json:"scopes"`
+	DailyQuota int           `json:"daily_quota"` // requests per UTC day, 0 = unlimited
+	CreatedBy  sql.NullInt64 `json:"created_by"`
+	CreatedAt  time.Time     `json:"created_at"`
+	LastUsedAt sql.NullTime  `json:"last_used_at"`
+	RevokedAt  sql.NullTime  `json:"revoked_at"`
+}
+
+// Subscription is a user's plan. A paid plan lasts until EndDate; a
+// canceled one keeps its plan until then instead of renewing.
+type Subscription struct {
+	UserID     int64        `json:"user_id"`
+	PlanType   string       `json:"plan_type"`
+	Status     string       `json:"status"`
+	StartDate  sql.NullTime `json:"start_date"`
+	EndDate    sql.NullTime `json:"end_date"`
+	BillingRef string       `json:"billing_ref"` // the billing provider's subscription ID
+	CanceledAt sql.NullTime `json:"canceled_at"`
+}
+
+// Subscription statuses
+const (
+	SubscriptionActive   = "active"
+	SubscriptionCanceled = "canceled" // paid until end_date, not renewed
+	SubscriptionExpired  = "expired"  // moved back to the free plan
+)
+
+// BillingEvent is a processed billing webhook, kept so that redelivered
+// events are applied only once.
+type BillingEvent struct {
+	EventID    string        `json:"event_id"`
+	Type       string        `json:"type"`
+	UserID     sql.NullInt64 `json:"user_id"`
+	ReceivedAt time.Time     `json:"received_at"`
 }
 
 type AnalysisHistory struct {
@@ -79,15 +259,117 @@ type AnalysisHistory struct {
     VideoID      string    `json:"video_id"`
     VideoTitle   string    `json:"video_title"`
     ThumbnailURL string    `json:"thumbnail_url"`
-    CreatedAt    time.Time `json:"created_at"`
+    CreatedAt    time.Time `json:"created_at"`  // time of the latest check
+    CheckCount   int       `json:"check_count"` // how many times the user checked this video
     SafetyScore  int       `json:"safety_score"`
-    JobID        string    `json:"job_id"`
+    HasResult    bool      `json:"has_result"` // false while the job is still running (or failed)
+    JobID        string    `json:"job_id"`     // the job this entry recorded, "" if it was deleted
+    JobStatus    string    `json:"job_status"`
+}
+
+// HistoryFilter narrows a user's history. Zero values mean "no filter".
+// Score and verdict filters only match entries that have a result.
+type HistoryFilter struct {
+    MinScore *int      // inclusive
+    MaxScore *int      // inclusive
+    From     time.Time // inclusive
+    To       time.Time // exclusive
+    Verdict  string    // danger, caution or safe
+}
+
+// HistoryCursor is the last entry of a page; the next page starts after it
+type HistoryCursor struct {
+    CreatedAt time.Time
+    ID        int64
+}
+
+// HistoryPage is one page of a user's history, newest first
+type HistoryPage struct {
+    Items []*AnalysisHistory
+    Next  *HistoryCursor // nil on the last page
+    Total int            // entries matching the filter across all pages
+}
+
+// ChannelWatch is a channel a user subscribed to for new-upload alerts
+type ChannelWatch struct {
+    WatchID           int64        `db:"watch_id"`
+    UserID            int64        `db:"user_id"`
+    ChannelID         string       `db:"channel_id"`
+    ChannelTitle      string       `db:"channel_title"`
+    UploadsPlaylistID string       `db:"uploads_playlist_id"`
+    LastUploadAt      time.Time    `db:"last_upload_at"`
+    LastCheckedAt     sql.NullTime `db:"last_checked_at"`
+    CreatedAt         time.Time    `db:"created_at"`
+}
+
+// WatchedChannel is a channel watched by at least one user. Since is the
+// oldest upload cursor among its watchers.
+type WatchedChannel struct {
+    ChannelID         string
+    UploadsPlaylistID string
+    Since             time.Time
+}
+
+// WatchAlert is a risky upload found on a watched channel, or a risky
+// verdict on a video analyzed by a senior who has a guardian
+type WatchAlert struct {
+    AlertID     int64          `db:"alert_id"`
+    UserID      int64          `db:"user_id"`
+    ChannelID   string         `db:"channel_id"`
+    VideoID     string         `db:"video_id"`
+    Kind        string         `db:"kind"` // AlertKindWatch or AlertKindFamily
+    JobID       uuid.NullUUID  `db:"job_id"`
+    VideoTitle  string         `db:"video_title"`
+    SafetyScore int            `db:"safety_score"`
+    Verdict     string         `db:"verdict"`
+    ReadAt      sql.NullTime   `db:"read_at"`
+    CreatedAt   time.Time      `db:"created_at"`
+}
+
+// Alert kinds. A video can have one alert of each kind per user.
+const (
+    AlertKindWatch  = "watch"  // 구독 채널의 새 업로드
+    AlertKindFamily = "family" // 보호자가 있는 어르신이 직접 분석한 영상
+)
+
+// Verdicts derived from safety_score (0 = definite scam, 100 = safe)
+const (
+    VerdictDanger  = "danger"
+    VerdictCaution = "caution"
+    VerdictSafe    = "safe"
+)
+
+// VerdictScoreRange is the inverse of VerdictForScore: the inclusive score
+// range of a verdict. ok is false for an unknown verdict.
+func VerdictScoreRange(verdict string) (min, max int, ok bool) {
+    switch verdict {
+    case VerdictDanger:
+        return 0, 39, true
+    case VerdictCaution:
+        return 40, 69, true
+    case VerdictSafe:
+        return 70, 100, true
+    }
+    return 0, 0, false
+}
+
+// VerdictForScore maps a safety score to a verdict
+func VerdictForScore(score int) string {
+    switch {
+    case score < 40:
+        return VerdictDanger
+    case score < 70:
+        return VerdictCaution
+    default:
+        return VerdictSafe
+    }
 }
 
 // Job statuses
 const (
     StatusPending    = "pending"
     StatusProcessing = "processing"
+    StatusMonitoring = "monitoring" // 라이브 방송 모니터링 중
     StatusCompleted  = "completed"
     StatusFailed     = "failed"
     StatusCancelled  = "cancelled"
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+)
+
+// Pool defaults, used when the corresponding DatabaseConfig field is unset.
+const (
+	defaultMaxOpenConns    = 25
+	defaultMaxIdleConns    = 5
+	defaultConnMaxLifetime = 30 * time.Minute
+	defaultConnMaxIdleTime = 5 * time.Minute
+	defaultConnectTimeout  = 30 * time.Second
+
+	initialConnectBackoff = 500 * time.Millisecond
+	maxConnectBackoff     = 8 * time.Second
+	pingTimeout           = 5 * time.Second
+)
+
+// OpenPostgresStore opens a pool configured from cfg and waits, retrying
+// with exponential backoff, until Postgres accepts connections or
+// cfg.ConnectTimeoutSeconds elapses.
+func OpenPostgresStore(ctx context.Context, cfg config.DatabaseConfig) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", cfg.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	db.SetMaxOpenConns(orDefault(cfg.MaxConnections, defaultMaxOpenConns))
+	db.SetMaxIdleConns(orDefault(cfg.MaxIdleConnections, defaultMaxIdleConns))
+	db.SetConnMaxLifetime(durationOrDefault(cfg.ConnMaxLifetimeMinutes, time.Minute, defaultConnMaxLifetime))
+	db.SetConnMaxIdleTime(durationOrDefault(cfg.ConnMaxIdleTimeMinutes, time.Minute, defaultConnMaxIdleTime))
+
+	timeout := durationOrDefault(cfg.ConnectTimeoutSeconds, time.Second, defaultConnectTimeout)
+	if err := waitForDB(ctx, db.PingContext, timeout, initialConnectBackoff); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresStore{db: db, q: db}, nil
+}
+
+// waitForDB pings until it succeeds, doubling the wait between attempts up
+// to maxConnectBackoff. It gives up once the next attempt would start after
+// timeout.
+func waitForDB(ctx context.Context, ping func(context.Context) error, timeout, backoff time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for attempt := 1; ; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+		err := ping(pingCtx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			return fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		}
+
+		log.Printf("Database not ready (attempt %d): %v — retrying in %s", attempt, err, backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxConnectBackoff {
+			backoff = maxConnectBackoff
+		}
+	}
+}
+
+// Ping checks that the database is reachable (health checks)
+func (s *PostgresStore) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+// Stats returns connection pool statistics for monitoring
+func (s *PostgresStore) Stats() sql.DBStats {
+	return s.db.Stats()
+}
+
+func orDefault(v, def int) int {
+	if v > 0 {
+		return v
+	}
+	return def
+}
+
+func durationOrDefault(v int, unit, def time.Duration) time.Duration {
+	if v > 0 {
+		return time.Duration(v) * unit
+	}
+	return def
+}
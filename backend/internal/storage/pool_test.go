@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForDB(t *testing.T) {
+	errDown := errors.New("connection refused")
+
+	t.Run("retries until ready", func(t *testing.T) {
+		attempts := 0
+		err := waitForDB(context.Background(), func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return errDown
+			}
+			return nil
+		}, time.Second, time.Millisecond)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("gives up after timeout", func(t *testing.T) {
+		attempts := 0
+		err := waitForDB(context.Background(), func(context.Context) error {
+			attempts++
+			return errDown
+		}, 20*time.Millisecond, 5*time.Millisecond)
+
+		assert.ErrorIs(t, err, errDown)
+		assert.Greater(t, attempts, 1)
+	})
+
+	t.Run("stops when cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		err := waitForDB(ctx, func(context.Context) error {
+			cancel()
+			return errDown
+		}, time.Minute, time.Millisecond)
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -1,8 +1,10 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +14,14 @@ import (
 
 type PostgresStore struct {
 	db *sql.DB
+	q  querier // db, or the open transaction inside WithTx
+}
+
+// querier is the subset of *sql.DB and *sql.Tx the store runs queries on
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }
 
 func NewPostgresStore(dsn string, maxConn, maxIdle int) (*PostgresStore, error) {
@@ -28,33 +38,65 @@ func NewPostgresStore(dsn string, maxConn, maxIdle int) (*PostgresStore, error)
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	return &PostgresStore{db: db}, nil
+	return &PostgresStore{db: db, q: db}, nil
 }
 
 func (s *PostgresStore) Close() error {
 	return s.db.Close()
 }
 
+// WithTx runs fn with a store bound to a single transaction, committing if fn
+// returns nil and rolling back otherwise. Calls on a store that is already in
+// a transaction join it.
+func (s *PostgresStore) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	if _, ok := s.q.(*sql.Tx); ok {
+		return fn(s)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(&PostgresStore{db: s.db, q: tx}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// DB exposes the underlying pool (used by the migration runner)
+func (s *PostgresStore) DB() *sql.DB {
+	return s.db
+}
+
 // CreateVideo inserts or updates a video
-func (s *PostgresStore) CreateVideo(v *Video) error {
+func (s *PostgresStore) CreateVideo(ctx context.Context, v *Video) error {
 	query := `
-        INSERT INTO videos (video_id, title, description, channel, duration, view_count, published_at)
-        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        INSERT INTO videos (video_id, platform, title, description, channel, channel_id, thumbnail_url, duration, view_count, published_at)
+        VALUES ($1, COALESCE(NULLIF($2, ''), 'youtube'), $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), $8, $9, $10)
         ON CONFLICT (video_id) DO UPDATE SET
             title = EXCLUDED.title,
             description = EXCLUDED.description,
+            channel_id = COALESCE(EXCLUDED.channel_id, videos.channel_id),
+            thumbnail_url = COALESCE(EXCLUDED.thumbnail_url, videos.thumbnail_url),
             updated_at = CURRENT_TIMESTAMP
     `
-	_, err := s.db.Exec(query, v.VideoID, v.Title, v.Description, v.Channel, v.Duration, v.ViewCount, v.PublishedAt)
+	_, err := s.q.ExecContext(ctx, query, v.VideoID, v.Platform, v.Title, v.Description, v.Channel, v.ChannelID, v.ThumbnailURL, v.Duration, v.ViewCount, v.PublishedAt)
 	return err
 }
 
 // GetVideo retrieves a video by ID
-func (s *PostgresStore) GetVideo(videoID string) (*Video, error) {
+func (s *PostgresStore) GetVideo(ctx context.Context, videoID string) (*Video, error) {
 	v := &Video{}
-	query := `SELECT video_id, title, description, channel, duration, view_count, published_at FROM videos WHERE video_id = $1`
-	err := s.db.QueryRow(query, videoID).Scan(
-		&v.VideoID, &v.Title, &v.Description, &v.Channel, &v.Duration, &v.ViewCount, &v.PublishedAt,
+	query := `
+        SELECT video_id, platform, title, description, channel, COALESCE(channel_id, ''), COALESCE(thumbnail_url, ''),
+               duration, view_count, published_at
+        FROM videos WHERE video_id = $1
+    `
+	err := s.q.QueryRowContext(ctx, query, videoID).Scan(
+		&v.VideoID, &v.Platform, &v.Title, &v.Description, &v.Channel, &v.ChannelID, &v.ThumbnailURL,
+		&v.Duration, &v.ViewCount, &v.PublishedAt,
 	)
 	if err != nil {
 		return nil, err
@@ -62,20 +104,97 @@ func (s *PostgresStore) GetVideo(videoID string) (*Video, error) {
 	return v, nil
 }
 
+// UpsertChannel inserts or refreshes channel reputation data
+func (s *PostgresStore) UpsertChannel(ctx context.Context, c *Channel) error {
+	query := `
+        INSERT INTO channels (channel_id, title, custom_url, country, subscriber_count, video_count,
+                              hidden_subscriber_count, verified, channel_created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        ON CONFLICT (channel_id) DO UPDATE SET
+            title = EXCLUDED.title,
+            custom_url = EXCLUDED.custom_url,
+            country = EXCLUDED.country,
+            subscriber_count = EXCLUDED.subscriber_count,
+            video_count = EXCLUDED.video_count,
+            hidden_subscriber_count = EXCLUDED.hidden_subscriber_count,
+            verified = EXCLUDED.verified,
+            channel_created_at = EXCLUDED.channel_created_at,
+            updated_at = CURRENT_TIMESTAMP
+    `
+	_, err := s.q.ExecContext(ctx, query, c.ChannelID, c.Title, c.CustomURL, c.Country, c.SubscriberCount, c.VideoCount,
+		c.HiddenSubscriberCount, c.Verified, c.ChannelCreatedAt)
+	return err
+}
+
+// GetChannel retrieves a channel by ID
+func (s *PostgresStore) GetChannel(ctx context.Context, channelID string) (*Channel, error) {
+	c := &Channel{}
+	query := `
+        SELECT channel_id, title, custom_url, country, subscriber_count, video_count,
+               hidden_subscriber_count, verified, channel_created_at, updated_at
+        FROM channels WHERE channel_id = $1
+    `
+	err := s.q.QueryRowContext(ctx, query, channelID).Scan(
+		&c.ChannelID, &c.Title, &c.CustomURL, &c.Country, &c.SubscriberCount, &c.VideoCount,
+		&c.HiddenSubscriberCount, &c.Verified, &c.ChannelCreatedAt, &c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
 // CreateJob creates a new analysis job
-func (s *PostgresStore) CreateJob(videoID string) (*AnalysisJob, error) {
+func (s *PostgresStore) CreateJob(ctx context.Context, videoID string, userID int64) (*AnalysisJob, error) {
 	job := &AnalysisJob{
 		JobID:   uuid.New(),
 		VideoID: videoID,
+		UserID:  userID,
 		Status:  StatusPending,
 	}
 
 	query := `
-        INSERT INTO analysis_jobs (job_id, video_id, status)
-        VALUES ($1, $2, $3)
+        INSERT INTO analysis_jobs (job_id, video_id, user_id, status)
+        VALUES ($1, $2, NULLIF($3, 0), $4)
+        RETURNING created_at
+    `
+	err := s.q.QueryRowContext(ctx, query, job.JobID, job.VideoID, job.UserID, job.Status).Scan(&job.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// CreateBatch creates a playlist/channel batch
+func (s *PostgresStore) CreateBatch(ctx context.Context, b *AnalysisBatch) error {
+	b.BatchID = uuid.New()
+	b.Status = StatusProcessing
+
+	query := `
+        INSERT INTO analysis_batches (batch_id, user_id, kind, source_url, title, channel_id, total, status)
+        VALUES ($1, NULLIF($2, 0), $3, $4, $5, $6, $7, $8)
         RETURNING created_at
     `
-	err := s.db.QueryRow(query, job.JobID, job.VideoID, job.Status).Scan(&job.CreatedAt)
+	return s.q.QueryRowContext(ctx, query, b.BatchID, b.UserID, b.Kind, b.SourceURL, b.Title, b.ChannelID, b.Total, b.Status).Scan(&b.CreatedAt)
+}
+
+// CreateBatchJob creates a child job of a batch
+func (s *PostgresStore) CreateBatchJob(ctx context.Context, batchID uuid.UUID, videoID string) (*AnalysisJob, error) {
+	job := &AnalysisJob{
+		JobID:   uuid.New(),
+		BatchID: uuid.NullUUID{UUID: batchID, Valid: true},
+		VideoID: videoID,
+		Status:  StatusPending,
+	}
+
+	// 배치를 만든 사용자가 Job 소유자
+	query := `
+        INSERT INTO analysis_jobs (job_id, batch_id, video_id, user_id, status)
+        SELECT $1, batch_id, $3, user_id, $4 FROM analysis_batches WHERE batch_id = $2
+        RETURNING created_at, COALESCE(user_id, 0)
+    `
+	err := s.q.QueryRowContext(ctx, query, job.JobID, batchID, job.VideoID, job.Status).Scan(&job.CreatedAt, &job.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -83,43 +202,93 @@ func (s *PostgresStore) CreateJob(videoID string) (*AnalysisJob, error) {
 	return job, nil
 }
 
-func (s *PostgresStore) UpdateJobStatus(jobID uuid.UUID, status string, progress int) error {
-	query := `UPDATE analysis_jobs SET status = $1, progress = $2 WHERE job_id = $3`
-	_, err := s.db.Exec(query, status, progress, jobID)
+// GetBatch retrieves a batch by ID
+func (s *PostgresStore) GetBatch(ctx context.Context, batchID uuid.UUID) (*AnalysisBatch, error) {
+	b := &AnalysisBatch{}
+	query := `
+        SELECT batch_id, COALESCE(user_id, 0), kind, source_url, title, channel_id, total, status, created_at, completed_at
+        FROM analysis_batches WHERE batch_id = $1
+    `
+	err := s.q.QueryRowContext(ctx, query, batchID).Scan(
+		&b.BatchID, &b.UserID, &b.Kind, &b.SourceURL, &b.Title, &b.ChannelID, &b.Total, &b.Status, &b.CreatedAt, &b.CompletedAt,
+	)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return b, nil
+}
 
-	if status == StatusProcessing {
-		query = `UPDATE analysis_jobs SET started_at = CURRENT_TIMESTAMP WHERE job_id = $1 AND started_at IS NULL`
-		if _, err := s.db.Exec(query, jobID); err != nil {
-			return err
-		}
+// ListBatchItems returns the child jobs of a batch with their scores
+func (s *PostgresStore) ListBatchItems(ctx context.Context, batchID uuid.UUID) ([]BatchItem, error) {
+	query := `
+        SELECT aj.job_id, aj.video_id, COALESCE(v.title, ''), aj.status, ar.safety_score
+        FROM analysis_jobs aj
+        LEFT JOIN videos v ON v.video_id = aj.video_id
+        LEFT JOIN LATERAL (
+            SELECT safety_score FROM analysis_results
+            WHERE job_id = aj.job_id
+            ORDER BY created_at DESC LIMIT 1
+        ) ar ON TRUE
+        WHERE aj.batch_id = $1
+        ORDER BY aj.created_at, aj.job_id
+    `
+	rows, err := s.q.QueryContext(ctx, query, batchID)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	if status == StatusCompleted || status == StatusFailed || status == StatusCancelled {
-		query = `UPDATE analysis_jobs SET completed_at = CURRENT_TIMESTAMP WHERE job_id = $1`
-		if _, err := s.db.Exec(query, jobID); err != nil {
-			return err
+	var items []BatchItem
+	for rows.Next() {
+		var item BatchItem
+		var score sql.NullInt64
+		if err := rows.Scan(&item.JobID, &item.VideoID, &item.Title, &item.Status, &score); err != nil {
+			return nil, err
 		}
+		item.SafetyScore = int(score.Int64)
+		item.HasResult = score.Valid
+		items = append(items, item)
 	}
+	return items, rows.Err()
+}
 
-	return nil
+// UpdateBatchStatus updates batch status (sets completed_at for terminal states)
+func (s *PostgresStore) UpdateBatchStatus(ctx context.Context, batchID uuid.UUID, status string) error {
+	query := `
+        UPDATE analysis_batches SET status = $1,
+            completed_at = CASE WHEN $1 IN ('completed', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP ELSE completed_at END
+        WHERE batch_id = $2
+    `
+	_, err := s.q.ExecContext(ctx, query, status, batchID)
+	return err
+}
+
+// UpdateJobStatus updates job status and progress (sets started_at on the
+// first processing update and completed_at for terminal states)
+func (s *PostgresStore) UpdateJobStatus(ctx context.Context, jobID uuid.UUID, status string, progress int) error {
+	query := `
+        UPDATE analysis_jobs SET status = $1, progress = $2,
+            started_at = CASE WHEN $1 = 'processing' THEN COALESCE(started_at, CURRENT_TIMESTAMP) ELSE started_at END,
+            completed_at = CASE WHEN $1 IN ('completed', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP ELSE completed_at END
+        WHERE job_id = $3
+    `
+	_, err := s.q.ExecContext(ctx, query, status, progress, jobID)
+	return err
 }
 
 // UpdateJobError updates job error message
-func (s *PostgresStore) UpdateJobError(jobID uuid.UUID, errMsg string) error {
+func (s *PostgresStore) UpdateJobError(ctx context.Context, jobID uuid.UUID, errMsg string) error {
 	query := `UPDATE analysis_jobs SET error_message = $1, status = $2 WHERE job_id = $3`
-	_, err := s.db.Exec(query, errMsg, StatusFailed, jobID)
+	_, err := s.q.ExecContext(ctx, query, errMsg, StatusFailed, jobID)
 	return err
 }
 
 // GetJob retrieves a job by ID
-func (s *PostgresStore) GetJob(jobID uuid.UUID) (*AnalysisJob, error) {
+func (s *PostgresStore) GetJob(ctx context.Context, jobID uuid.UUID) (*AnalysisJob, error) {
 	job := &AnalysisJob{}
-	query := `SELECT job_id, video_id, status, progress, created_at, started_at, completed_at, error_message FROM analysis_jobs WHERE job_id = $1`
-	err := s.db.QueryRow(query, jobID).Scan(
-		&job.JobID, &job.VideoID, &job.Status, &job.Progress,
+	query := `SELECT job_id, batch_id, upload_id, COALESCE(video_id, ''), COALESCE(user_id, 0), status, progress, created_at, started_at, completed_at, error_message FROM analysis_jobs WHERE job_id = $1`
+	err := s.q.QueryRowContext(ctx, query, jobID).Scan(
+		&job.JobID, &job.BatchID, &job.UploadID, &job.VideoID, &job.UserID, &job.Status, &job.Progress,
 		&job.CreatedAt, &job.StartedAt, &job.CompletedAt, &job.ErrorMessage,
 	)
 	if err != nil {
@@ -128,37 +297,86 @@ func (s *PostgresStore) GetJob(jobID uuid.UUID) (*AnalysisJob, error) {
 	return job, nil
 }
 
-// SaveResult saves analysis result
-func (s *PostgresStore) SaveResult(jobID uuid.UUID, safetyScore int, categories []string, geminiResp interface{}) error {
+// SaveResult saves a Gemini analysis result, linked to the job's video or upload
+func (s *PostgresStore) SaveResult(ctx context.Context, jobID uuid.UUID, safetyScore int, categories []string, geminiResp interface{}) error {
 	categoriesJSON, _ := json.Marshal(categories)
 	geminiJSON, _ := json.Marshal(geminiResp)
 
 	query := `
-        INSERT INTO analysis_results (job_id, safety_score, categories, gemini_response)
-        VALUES ($1, $2, $3, $4)
+        INSERT INTO analysis_results (job_id, video_id, upload_id, source, safety_score, categories, gemini_response)
+        SELECT aj.job_id, aj.video_id, aj.upload_id, $2, $3, $4, $5
+        FROM analysis_jobs aj
+        WHERE aj.job_id = $1
     `
-	_, err := s.db.Exec(query, jobID, safetyScore, categoriesJSON, geminiJSON)
-	return err
+	res, err := s.q.ExecContext(ctx, query, jobID, ResultSourceGemini, safetyScore, categoriesJSON, geminiJSON)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
 }
 
-// GetResult retrieves analysis result
-func (s *PostgresStore) GetResult(jobID uuid.UUID) (*AnalysisResult, error) {
+// SaveReviewResult copies the job's latest result with the reviewer's score
+func (s *PostgresStore) SaveReviewResult(ctx context.Context, jobID uuid.UUID, safetyScore int, reviewerID int64, reason string) error {
+	query := `
+        INSERT INTO analysis_results (job_id, video_id, upload_id, source, safety_score,
+            audio_score, video_score, context_score, categories, gemini_response, reviewed_by, review_reason)
+        SELECT job_id, video_id, upload_id, $2, $3,
+            audio_score, video_score, context_score, categories, gemini_response, $4, NULLIF($5, '')
+        FROM analysis_results
+        WHERE job_id = $1
+        ORDER BY created_at DESC LIMIT 1
+    `
+	res, err := s.q.ExecContext(ctx, query, jobID, ResultSourceReview, safetyScore, reviewerID, reason)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+const resultColumns = `result_id, job_id, COALESCE(video_id, ''), upload_id, source, safety_score,
+        audio_score, video_score, context_score, categories, gemini_response, reviewed_by, COALESCE(review_reason, ''),
+        created_at, COALESCE(updated_at, created_at)`
+
+// GetResult retrieves the latest result of a job
+func (s *PostgresStore) GetResult(ctx context.Context, jobID uuid.UUID) (*AnalysisResult, error) {
+	query := `SELECT ` + resultColumns + ` FROM analysis_results WHERE job_id = $1 ORDER BY created_at DESC LIMIT 1`
+	return scanResult(s.q.QueryRowContext(ctx, query, jobID))
+}
+
+// GetLatestResult retrieves the latest result for a video ID or an upload ID
+func (s *PostgresStore) GetLatestResult(ctx context.Context, videoOrUploadID string) (*AnalysisResult, error) {
+	query := `
+        SELECT ` + resultColumns + `
+        FROM analysis_results
+        WHERE video_id = $1 OR upload_id::text = $1
+        ORDER BY created_at DESC LIMIT 1
+    `
+	return scanResult(s.q.QueryRowContext(ctx, query, videoOrUploadID))
+}
+
+func scanResult(row *sql.Row) (*AnalysisResult, error) {
 	result := &AnalysisResult{}
 	var categoriesJSON []byte
 	var geminiJSON []byte
 
-	query := `SELECT result_id, job_id, safety_score, categories, gemini_response, created_at FROM analysis_results WHERE job_id = $1`
-	err := s.db.QueryRow(query, jobID).Scan(
-		&result.ResultID, &result.JobID, &result.SafetyScore,
-		&categoriesJSON, &geminiJSON, &result.CreatedAt,
+	err := row.Scan(
+		&result.ResultID, &result.JobID, &result.VideoID, &result.UploadID, &result.Source, &result.SafetyScore,
+		&result.AudioScore, &result.VideoScore, &result.ContextScore,
+		&categoriesJSON, &geminiJSON, &result.ReviewedBy, &result.ReviewReason, &result.CreatedAt, &result.UpdatedAt,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Unmarshal JSON fields
+	// JSON 필드는 문자열 그대로 보관
 	if len(categoriesJSON) > 0 {
-		json.Unmarshal(categoriesJSON, &result.Categories)
+		result.Categories = string(categoriesJSON)
 	}
 	if len(geminiJSON) > 0 {
 		// 그대로 []byte로 두거나 unmarshal
@@ -169,43 +387,34 @@ func (s *PostgresStore) GetResult(jobID uuid.UUID) (*AnalysisResult, error) {
 }
 
 // SaveCaptions saves video captions
-func (s *PostgresStore) SaveCaptions(videoID, language, text string) error {
+func (s *PostgresStore) SaveCaptions(ctx context.Context, videoID, language, text string) error {
 	query := `INSERT INTO captions (video_id, language, text) VALUES ($1, $2, $3)`
-	_, err := s.db.Exec(query, videoID, language, text)
+	_, err := s.q.ExecContext(ctx, query, videoID, language, text)
 	return err
 }
 
 // SaveComments saves top comments
-func (s *PostgresStore) SaveComments(videoID string, comments []Comment) error {
-	tx, err := s.db.Begin()
-	if err != nil {
-		return err
-	}
-	defer tx.Rollback()
-
-	if _, err := tx.Exec(`DELETE FROM comments WHERE video_id = $1`, videoID); err != nil {
-		return err
-	}
-
-	stmt, err := tx.Prepare(`INSERT INTO comments (video_id, author, text, likes, rank) VALUES ($1, $2, $3, $4, $5)`)
-	if err != nil {
-		return err
-	}
-	defer stmt.Close()
-
-	for _, c := range comments {
-		if _, err := stmt.Exec(videoID, c.Author, c.Text, c.Likes, c.Rank); err != nil {
+func (s *PostgresStore) SaveComments(ctx context.Context, videoID string, comments []Comment) error {
+	return s.WithTx(ctx, func(tx Store) error {
+		q := tx.(*PostgresStore).q
+		if _, err := q.ExecContext(ctx, `DELETE FROM comments WHERE video_id = $1`, videoID); err != nil {
 			return err
 		}
-	}
 
-	return tx.Commit()
+		for _, c := range comments {
+			if _, err := q.ExecContext(ctx, `INSERT INTO comments (video_id, author, text, likes, rank) VALUES ($1, $2, $3, $4, $5)`,
+				videoID, c.Author, c.Text, c.Likes, c.Rank); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // GetComments retrieves top comments
-func (s *PostgresStore) GetComments(videoID string, limit int) ([]Comment, error) {
+func (s *PostgresStore) GetComments(ctx context.Context, videoID string, limit int) ([]Comment, error) {
 	query := `SELECT author, text, likes, rank FROM comments WHERE video_id = $1 ORDER BY rank LIMIT $2`
-	rows, err := s.db.Query(query, videoID, limit)
+	rows, err := s.q.QueryContext(ctx, query, videoID, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -228,40 +437,46 @@ func (s *PostgresStore) GetComments(videoID string, limit int) ([]Comment, error
 // [NEW] Auth & User Logic (Corrected Receiver Name)
 // ---------------------------------------------------------
 
-func (s *PostgresStore) UpsertUser(email, name, picture, providerID string) (*User, error) {
+func (s *PostgresStore) UpsertUser(ctx context.Context, email, name, picture, providerID string) (*User, error) {
 	query := `
 		INSERT INTO users (email, name, picture_url, provider_id)
 		VALUES ($1, $2, $3, $4)
 		ON CONFLICT (email) 
 		DO UPDATE SET 
-			name = EXCLUDED.name, 
-			picture_url = EXCLUDED.picture_url,
-			provider_id = EXCLUDED.provider_id
-		RETURNING id, email, name, picture_url, provider_id, created_at
+			name = COALESCE(NULLIF(EXCLUDED.name, ''), users.name), 
+			picture_url = COALESCE(NULLIF(EXCLUDED.picture_url, ''), users.picture_url),
+			provider_id = COALESCE(NULLIF(EXCLUDED.provider_id, ''), users.provider_id)
+		RETURNING id, email, COALESCE(name, ''), COALESCE(picture_url, ''), COALESCE(provider_id, ''), role, created_at
 	`
 
 	user := &User{}
-	err := s.db.QueryRow(query, email, name, picture, providerID).Scan(
-		&user.ID, &user.Email, &user.Name, &user.PictureURL, &user.ProviderID, &user.CreatedAt,
-	)
+	err := s.WithTx(ctx, func(tx Store) error {
+		q := tx.(*PostgresStore).q
+		err := q.QueryRowContext(ctx, query, email, name, picture, providerID).Scan(
+			&user.ID, &user.Email, &user.Name, &user.PictureURL, &user.ProviderID, &user.Role, &user.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		// 기본 구독 정보가 없으면 생성 (Free)
+		_, err = q.ExecContext(ctx, `
+			INSERT INTO subscriptions (user_id, plan_type)
+			VALUES ($1, 'free')
+			ON CONFLICT (user_id) DO NOTHING
+		`, user.ID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	// 기본 구독 정보가 없으면 생성 (Free)
-	_, err = s.db.Exec(`
-		INSERT INTO subscriptions (user_id, plan_type)
-		VALUES ($1, 'free')
-		ON CONFLICT (user_id) DO NOTHING
-	`, user.ID)
-
-	return user, err
+	return user, nil
 }
 
-func (s *PostgresStore) GetUserByID(id int64) (*User, error) {
+func (s *PostgresStore) GetUserByID(ctx context.Context, id int64) (*User, error) {
 	user := &User{}
-	err := s.db.QueryRow("SELECT id, email, name, picture_url FROM users WHERE id = $1", id).Scan(
-		&user.ID, &user.Email, &user.Name, &user.PictureURL,
+	err := s.q.QueryRowContext(ctx, "SELECT id, email, COALESCE(name, ''), COALESCE(picture_url, ''), role FROM users WHERE id = $1", id).Scan(
+		&user.ID, &user.Email, &user.Name, &user.PictureURL, &user.Role,
 	)
 	if err != nil {
 		return nil, err
@@ -269,112 +484,122 @@ func (s *PostgresStore) GetUserByID(id int64) (*User, error) {
 	return user, nil
 }
 
-// --- Subscription Logic ---
-
-func (s *PostgresStore) GetSubscription(userID int64) (*Subscription, error) {
-	sub := &Subscription{}
-	err := s.db.QueryRow("SELECT user_id, plan_type, start_date, end_date FROM subscriptions WHERE user_id = $1", userID).Scan(
-		&sub.UserID, &sub.PlanType, &sub.StartDate, &sub.EndDate,
-	)
-	if err == sql.ErrNoRows {
-		// 없으면 Free 리턴
-		return &Subscription{UserID: userID, PlanType: "free"}, nil
+func (s *PostgresStore) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	user := &User{}
+	err := s.q.QueryRowContext(ctx,
+		"SELECT id, email, COALESCE(name, ''), COALESCE(picture_url, ''), role FROM users WHERE email = $1", email,
+	).Scan(&user.ID, &user.Email, &user.Name, &user.PictureURL, &user.Role)
+	if err != nil {
+		return nil, err
 	}
-	return sub, err
+	return user, nil
 }
 
-// --- History Logic ---
+func (s *PostgresStore) GetUserByIdentity(ctx context.Context, provider, subject string) (*User, error) {
+	user := &User{}
+	err := s.q.QueryRowContext(ctx, `
+		SELECT u.id, u.email, COALESCE(u.name, ''), COALESCE(u.picture_url, ''), u.role
+		FROM user_identities i JOIN users u ON u.id = i.user_id
+		WHERE i.provider = $1 AND i.subject = $2
+	`, provider, subject).Scan(&user.ID, &user.Email, &user.Name, &user.PictureURL, &user.Role)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
 
-func (s *PostgresStore) AddHistory(userID int64, videoID, title, thumb string) error {
-	_, err := s.db.Exec(`
-		INSERT INTO analysis_history (user_id, video_id, video_title, thumbnail_url)
-		VALUES ($1, $2, $3, $4)
-	`, userID, videoID, title, thumb)
+func (s *PostgresStore) LinkIdentity(ctx context.Context, userID int64, provider, subject, email string) error {
+	// 이미 연결된 계정이면 로그인 시각만 갱신, 다른 사용자의 계정이면 갱신되는 행이 없음
+	var linkedTo int64
+	err := s.q.QueryRowContext(ctx, `
+		INSERT INTO user_identities (user_id, provider, subject, email)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		ON CONFLICT (provider, subject) DO UPDATE SET
+			last_login_at = now(),
+			email = COALESCE(EXCLUDED.email, user_identities.email)
+		WHERE user_identities.user_id = EXCLUDED.user_id
+		RETURNING user_id
+	`, userID, provider, subject, email).Scan(&linkedTo)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s account is linked to another user", provider)
+	}
 	return err
 }
 
-func (s *PostgresStore) GetHistory(userID int64, limit, offset int) ([]*AnalysisHistory, error) {
-    rows, err := s.db.Query(`
-        SELECT ah.id, ah.video_id,
-               v.title as video_title,
-               ah.thumbnail_url, ah.created_at,
-               COALESCE(
-                   (SELECT ar.safety_score 
-                    FROM analysis_jobs aj 
-                    JOIN analysis_results ar ON ar.job_id = aj.job_id
-                    WHERE aj.video_id = ah.video_id 
-                    ORDER BY aj.created_at DESC LIMIT 1), 
-               0) as safety_score,
-               COALESCE(
-                   (SELECT aj.job_id::text
-                    FROM analysis_jobs aj
-                    WHERE aj.video_id = ah.video_id
-                    ORDER BY aj.created_at DESC LIMIT 1),
-               '') as job_id
-        FROM analysis_history ah
-        LEFT JOIN videos v ON v.video_id = ah.video_id
-        WHERE ah.user_id = $1
-        ORDER BY ah.created_at DESC
-        LIMIT $2 OFFSET $3
-    `, userID, limit, offset)
-    if err != nil {
-        return nil, err
-    }
-    defer rows.Close()
-    var history []*AnalysisHistory
-    for rows.Next() {
-        h := &AnalysisHistory{UserID: userID}
-        if err := rows.Scan(&h.ID, &h.VideoID, &h.VideoTitle, &h.ThumbnailURL, &h.CreatedAt, &h.SafetyScore, &h.JobID); err != nil {
-            return nil, err
-        }
-        history = append(history, h)
-    }
-    return history, nil
-}
-
-
-// AnalysisResultDB represents analysis_results table
-type AnalysisResultDB struct {
-	ID           int
-	VideoID      string
-	S3Bucket     string
-	S3Key        string
-	AudioScore   float64
-	VideoScore   float64
-	ContextScore float64
-	FinalScore   int
-	Status       string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-}
-
-// GetAnalysisResultByVideoID retrieves analysis result by video_id
-func (s *PostgresStore) GetAnalysisResultByVideoID(videoID string) (*AnalysisResultDB, error) {
+func (s *PostgresStore) ListIdentities(ctx context.Context, userID int64) ([]UserIdentity, error) {
+	rows, err := s.q.QueryContext(ctx, `
+		SELECT identity_id, user_id, provider, subject, COALESCE(email, ''), created_at, last_login_at
+		FROM user_identities WHERE user_id = $1
+		ORDER BY created_at, identity_id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var identities []UserIdentity
+	for rows.Next() {
+		var i UserIdentity
+		if err := rows.Scan(&i.ID, &i.UserID, &i.Provider, &i.Subject, &i.Email, &i.CreatedAt, &i.LastLoginAt); err != nil {
+			return nil, err
+		}
+		identities = append(identities, i)
+	}
+	return identities, rows.Err()
+}
+
+func (s *PostgresStore) SetUserRole(ctx context.Context, userID int64, role string) error {
+	res, err := s.q.ExecContext(ctx, `UPDATE users SET role = $2 WHERE id = $1`, userID, role)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// --- Upload Logic ---
+
+// CreateUpload records an S3 upload
+func (s *PostgresStore) CreateUpload(ctx context.Context, u *Upload) error {
 	query := `
-		SELECT id, video_id, s3_bucket, s3_key, audio_score, video_score, 
-		       context_score, final_score, status, created_at, updated_at
-		FROM analysis_results
-		WHERE video_id = $1
-	`
-	
-	result := &AnalysisResultDB{}
-	err := s.db.QueryRow(query, videoID).Scan(
-		&result.ID,
-		&result.VideoID,
-		&result.S3Bucket,
-		&result.S3Key,
-		&result.AudioScore,
-		&result.VideoScore,
-		&result.ContextScore,
-		&result.FinalScore,
-		&result.Status,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
-	
+        INSERT INTO uploads (upload_id, user_id, s3_bucket, s3_key, filename, content_type, size_bytes)
+        VALUES ($1, NULLIF($2, 0), $3, $4, $5, $6, $7)
+        RETURNING created_at
+    `
+	return s.q.QueryRowContext(ctx, query, u.UploadID, u.UserID, u.S3Bucket, u.S3Key, u.Filename, u.ContentType, u.SizeBytes).Scan(&u.CreatedAt)
+}
+
+func (s *PostgresStore) GetUpload(ctx context.Context, uploadID uuid.UUID) (*Upload, error) {
+	u := &Upload{}
+	err := s.q.QueryRowContext(ctx, `
+		SELECT upload_id, COALESCE(user_id, 0), s3_bucket, s3_key, filename, content_type, size_bytes, created_at
+		FROM uploads WHERE upload_id = $1
+	`, uploadID).Scan(&u.UploadID, &u.UserID, &u.S3Bucket, &u.S3Key, &u.Filename, &u.ContentType, &u.SizeBytes, &u.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	
-	return result, nil
+	return u, nil
+}
+
+// CreateUploadJob creates the analysis job of an upload. The ML pipeline
+// attaches its result to this job.
+func (s *PostgresStore) CreateUploadJob(ctx context.Context, uploadID uuid.UUID) (*AnalysisJob, error) {
+	job := &AnalysisJob{
+		JobID:    uuid.New(),
+		UploadID: uuid.NullUUID{UUID: uploadID, Valid: true},
+		Status:   StatusPending,
+	}
+
+	// 업로드한 사용자가 Job 소유자
+	query := `
+        INSERT INTO analysis_jobs (job_id, upload_id, user_id, status)
+        SELECT $1, upload_id, user_id, $3 FROM uploads WHERE upload_id = $2
+        RETURNING created_at, COALESCE(user_id, 0)
+    `
+	if err := s.q.QueryRowContext(ctx, query, job.JobID, uploadID, job.Status).Scan(&job.CreatedAt, &job.UserID); err != nil {
+		return nil, err
+	}
+	return job, nil
 }
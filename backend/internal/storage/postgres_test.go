@@ -0,0 +1,150 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/migrate"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage/storetest"
+	"github.com/vanillaturtlechips/silver-guardian/backend/migrations"
+)
+
+// tables is every table the migrations create (except schema_migrations),
+// truncated between subtests. The test fails if a migration adds a table
+// that is missing here.
+var tables = []string{
+	"videos", "channels", "captions", "comments",
+	"uploads", "analysis_batches", "analysis_jobs", "analysis_results",
+	"users", "user_identities", "refresh_tokens", "subscriptions", "billing_events",
+	"family_links", "family_invites",
+	"api_keys", "api_key_usage", "usage_counters", "anonymous_usage",
+	"analysis_history", "channel_watches", "watch_alerts",
+}
+
+// TestPostgresStore runs the conformance suite against a real database.
+// Set SILVER_GUARDIAN_TEST_DSN to a disposable database; every table is
+// truncated between subtests.
+func TestPostgresStore(t *testing.T) {
+	dsn := os.Getenv("SILVER_GUARDIAN_TEST_DSN")
+	if dsn == "" {
+		t.Skip("SILVER_GUARDIAN_TEST_DSN not set")
+	}
+
+	store, err := storage.NewPostgresStore(dsn, 5, 2)
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+
+	runner, err := migrate.New(store.DB(), migrations.FS)
+	require.NoError(t, err)
+	_, err = runner.Up(context.Background())
+	require.NoError(t, err)
+
+	var existing []string
+	rows, err := store.DB().Query(`
+		SELECT table_name FROM information_schema.tables
+		WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' AND table_name <> 'schema_migrations'`)
+	require.NoError(t, err)
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		existing = append(existing, name)
+	}
+	require.NoError(t, rows.Err())
+	rows.Close()
+	assert.ElementsMatch(t, tables, existing, "update the truncate list for new tables")
+
+	truncate := "TRUNCATE " + strings.Join(tables, ", ") + " RESTART IDENTITY CASCADE"
+	storetest.Run(t, func(t *testing.T) storage.Store {
+		_, err := store.DB().Exec(truncate)
+		require.NoError(t, err)
+		return store
+	})
+}
+
+// TestLegacyPipelineMigration migrates a database that still has the ML
+// pipeline's analysis_results table (003 schema) in a scratch schema.
+func TestLegacyPipelineMigration(t *testing.T) {
+	dsn := os.Getenv("SILVER_GUARDIAN_TEST_DSN")
+	if dsn == "" {
+		t.Skip("SILVER_GUARDIAN_TEST_DSN not set")
+	}
+	ctx := context.Background()
+
+	admin, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Close() })
+	const schema = "legacy_migration_test"
+	_, err = admin.Exec("DROP SCHEMA IF EXISTS " + schema + " CASCADE; CREATE SCHEMA " + schema)
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Exec("DROP SCHEMA IF EXISTS " + schema + " CASCADE") })
+
+	sep := " "
+	if strings.Contains(dsn, "://") {
+		sep = "&"
+		if !strings.Contains(dsn, "?") {
+			sep = "?"
+		}
+	}
+	db, err := sql.Open("postgres", dsn+sep+"search_path="+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`
+		CREATE TABLE analysis_results (
+			id SERIAL PRIMARY KEY,
+			video_id VARCHAR(255) UNIQUE NOT NULL,
+			s3_bucket VARCHAR(255) NOT NULL,
+			s3_key VARCHAR(512) NOT NULL,
+			audio_score DECIMAL(5,3) DEFAULT 0.5,
+			video_score DECIMAL(5,3) DEFAULT 0.5,
+			context_score DECIMAL(5,3) DEFAULT 0.5,
+			final_score INTEGER DEFAULT 50,
+			status VARCHAR(50) DEFAULT 'processing',
+			created_at TIMESTAMP DEFAULT NOW(),
+			updated_at TIMESTAMP DEFAULT NOW()
+		);
+		INSERT INTO analysis_results (video_id, s3_bucket, s3_key, final_score, status) VALUES
+			('scored', 'bucket', 'uploads/scored.mp4', 70, 'completed'),
+			('unscored', 'bucket', 'uploads/unscored.mp4', NULL, 'completed'),
+			('running', 'bucket', 'uploads/running.mp4', NULL, 'processing')`)
+	require.NoError(t, err)
+
+	runner, err := migrate.New(db, migrations.FS)
+	require.NoError(t, err)
+	_, err = runner.Up(ctx)
+	require.NoError(t, err)
+
+	// final_score가 없는 완료 결과는 점수 없이 실패한 Job으로 이관
+	rows, err := db.Query(`
+		SELECT u.s3_key, j.status, COALESCE(j.error_message, ''), r.safety_score
+		FROM uploads u
+		JOIN analysis_jobs j ON j.upload_id = u.upload_id
+		LEFT JOIN analysis_results r ON r.job_id = j.job_id
+		ORDER BY u.s3_key`)
+	require.NoError(t, err)
+	defer rows.Close()
+	type migrated struct {
+		key, status, errMsg string
+		score               sql.NullInt64
+	}
+	var got []migrated
+	for rows.Next() {
+		var m migrated
+		require.NoError(t, rows.Scan(&m.key, &m.status, &m.errMsg, &m.score))
+		got = append(got, m)
+	}
+	require.NoError(t, rows.Err())
+	require.Len(t, got, 3)
+	assert.Equal(t, migrated{key: "uploads/running.mp4", status: storage.StatusProcessing}, got[0])
+	assert.Equal(t, migrated{key: "uploads/scored.mp4", status: storage.StatusCompleted, score: sql.NullInt64{Int64: 30, Valid: true}}, got[1])
+	assert.Equal(t, "uploads/unscored.mp4", got[2].key)
+	assert.Equal(t, storage.StatusFailed, got[2].status)
+	assert.NotEmpty(t, got[2].errMsg)
+	assert.False(t, got[2].score.Valid)
+}
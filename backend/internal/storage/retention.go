@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// PurgeCaptions deletes up to limit captions collected before the cutoff,
+// oldest first, and returns how many were deleted.
+func (s *PostgresStore) PurgeCaptions(ctx context.Context, before time.Time, limit int) (int, error) {
+	return s.purge(ctx, `
+		DELETE FROM captions WHERE caption_id IN (
+			SELECT caption_id FROM captions
+			WHERE created_at < $1::timestamp
+			ORDER BY caption_id
+			LIMIT $2
+		)`, before, limit)
+}
+
+// PurgeComments deletes up to limit comments collected before the cutoff,
+// oldest first, and returns how many were deleted.
+func (s *PostgresStore) PurgeComments(ctx context.Context, before time.Time, limit int) (int, error) {
+	return s.purge(ctx, `
+		DELETE FROM comments WHERE comment_id IN (
+			SELECT comment_id FROM comments
+			WHERE analyzed_at < $1::timestamp
+			ORDER BY comment_id
+			LIMIT $2
+		)`, before, limit)
+}
+
+func (s *PostgresStore) purge(ctx context.Context, query string, before time.Time, limit int) (int, error) {
+	res, err := s.q.ExecContext(ctx, query, before.UTC().Format(pgTimestamp), limit)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+// ListExpiredUploads returns up to limit uploads created before the cutoff,
+// oldest first.
+func (s *PostgresStore) ListExpiredUploads(ctx context.Context, before time.Time, limit int) ([]Upload, error) {
+	rows, err := s.q.QueryContext(ctx, `
+		SELECT upload_id, COALESCE(user_id, 0), s3_bucket, s3_key, filename, content_type, size_bytes, created_at
+		FROM uploads
+		WHERE created_at < $1::timestamp
+		ORDER BY created_at, upload_id
+		LIMIT $2
+	`, before.UTC().Format(pgTimestamp), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var uploads []Upload
+	for rows.Next() {
+		var u Upload
+		if err := rows.Scan(&u.UploadID, &u.UserID, &u.S3Bucket, &u.S3Key, &u.Filename, &u.ContentType, &u.SizeBytes, &u.CreatedAt); err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, u)
+	}
+	return uploads, rows.Err()
+}
+
+// DeleteUploads deletes uploads together with their jobs and results.
+// History entries that pointed at those jobs are kept without a job.
+func (s *PostgresStore) DeleteUploads(ctx context.Context, ids []uuid.UUID) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.String()
+	}
+
+	res, err := s.q.ExecContext(ctx, `DELETE FROM uploads WHERE upload_id = ANY($1::uuid[])`, pq.Array(keys))
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CreateRefreshToken stores a new refresh token and fills in its ID.
+func (s *PostgresStore) CreateRefreshToken(ctx context.Context, t *RefreshToken) error {
+	return s.q.QueryRowContext(ctx, `
+		INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING token_id, created_at
+	`, t.UserID, t.FamilyID, t.TokenHash, t.ExpiresAt).Scan(&t.ID, &t.CreatedAt)
+}
+
+// GetRefreshToken looks a token up by its hash, including used and revoked ones
+// so callers can detect reuse.
+func (s *PostgresStore) GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	t := &RefreshToken{}
+	err := s.q.QueryRowContext(ctx, `
+		SELECT token_id, user_id, family_id, token_hash, expires_at, used_at, revoked_at, created_at
+		FROM refresh_tokens WHERE token_hash = $1
+	`, tokenHash).Scan(&t.ID, &t.UserID, &t.FamilyID, &t.TokenHash, &t.ExpiresAt, &t.UsedAt, &t.RevokedAt, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// MarkRefreshTokenUsed consumes a token. Only one of two concurrent
+// refreshes with the same token succeeds.
+func (s *PostgresStore) MarkRefreshTokenUsed(ctx context.Context, id int64) (bool, error) {
+	res, err := s.q.ExecContext(ctx, `
+		UPDATE refresh_tokens SET used_at = now()
+		WHERE token_id = $1 AND used_at IS NULL AND revoked_at IS NULL
+	`, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// RevokeRefreshFamily revokes every token rotated from the same login.
+func (s *PostgresStore) RevokeRefreshFamily(ctx context.Context, familyID uuid.UUID) (int, error) {
+	return s.revokeRefreshTokens(ctx, `family_id = $1`, familyID)
+}
+
+// RevokeUserRefreshTokens revokes all of a user's sessions (log out everywhere).
+func (s *PostgresStore) RevokeUserRefreshTokens(ctx context.Context, userID int64) (int, error) {
+	return s.revokeRefreshTokens(ctx, `user_id = $1`, userID)
+}
+
+func (s *PostgresStore) revokeRefreshTokens(ctx context.Context, where string, arg interface{}) (int, error) {
+	res, err := s.q.ExecContext(ctx, `
+		UPDATE refresh_tokens SET revoked_at = now()
+		WHERE `+where+` AND revoked_at IS NULL AND used_at IS NULL AND expires_at > now()
+	`, arg)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Not-found lookups return sql.ErrNoRows in every implementation.
+
+// VideoStore persists video metadata, channel reputation, uploads and the
+// captions/comments collected for analysis.
+type VideoStore interface {
+	CreateVideo(ctx context.Context, v *Video) error
+	GetVideo(ctx context.Context, videoID string) (*Video, error)
+	UpsertChannel(ctx context.Context, c *Channel) error
+	GetChannel(ctx context.Context, channelID string) (*Channel, error)
+	CreateUpload(ctx context.Context, u *Upload) error
+	GetUpload(ctx context.Context, uploadID uuid.UUID) (*Upload, error)
+	SaveCaptions(ctx context.Context, videoID, language, text string) error
+	SaveComments(ctx context.Context, videoID string, comments []Comment) error
+	GetComments(ctx context.Context, videoID string, limit int) ([]Comment, error)
+}
+
+// JobStore persists analysis jobs and the batches that group them.
+type JobStore interface {
+	CreateJob(ctx context.Context, videoID string, userID int64) (*AnalysisJob, error)
+	CreateBatchJob(ctx context.Context, batchID uuid.UUID, videoID string) (*AnalysisJob, error)
+	CreateUploadJob(ctx context.Context, uploadID uuid.UUID) (*AnalysisJob, error)
+	GetJob(ctx context.Context, jobID uuid.UUID) (*AnalysisJob, error)
+	UpdateJobStatus(ctx context.Context, jobID uuid.UUID, status string, progress int) error
+	UpdateJobError(ctx context.Context, jobID uuid.UUID, errMsg string) error
+
+	CreateBatch(ctx context.Context, b *AnalysisBatch) error
+	GetBatch(ctx context.Context, batchID uuid.UUID) (*AnalysisBatch, error)
+	ListBatchItems(ctx context.Context, batchID uuid.UUID) ([]BatchItem, error)
+	UpdateBatchStatus(ctx context.Context, batchID uuid.UUID, status string) error
+}
+
+// ResultStore persists analysis results. A job may have several results;
+// reads return the newest.
+type ResultStore interface {
+	SaveResult(ctx context.Context, jobID uuid.UUID, safetyScore int, categories []string, geminiResp interface{}) error
+	GetResult(ctx context.Context, jobID uuid.UUID) (*AnalysisResult, error)
+	GetLatestResult(ctx context.Context, videoOrUploadID string) (*AnalysisResult, error)
+	// SaveReviewResult records a reviewer's verdict as the newest result of
+	// a job, keeping the details of the result it overrides. It returns
+	// sql.ErrNoRows if the job has no result yet.
+	SaveReviewResult(ctx context.Context, jobID uuid.UUID, safetyScore int, reviewerID int64, reason string) error
+}
+
+// UserStore persists users and their login identities.
+type UserStore interface {
+	// UpsertUser creates or updates the user with email. Empty name, picture
+	// or providerID keep the stored value.
+	UpsertUser(ctx context.Context, email, name, picture, providerID string) (*User, error)
+	GetUserByID(ctx context.Context, id int64) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	// GetUserByIdentity finds the user a provider account is linked to.
+	GetUserByIdentity(ctx context.Context, provider, subject string) (*User, error)
+	// LinkIdentity links a provider account to a user, or records a login if
+	// it is already linked to that user. It fails if the account belongs to
+	// another user.
+	LinkIdentity(ctx context.Context, userID int64, provider, subject, email string) error
+	ListIdentities(ctx context.Context, userID int64) ([]UserIdentity, error)
+	// SetUserRole returns sql.ErrNoRows if the user does not exist.
+	SetUserRole(ctx context.Context, userID int64, role string) error
+}
+
+// SubscriptionStore persists each user's plan and the billing events that
+// changed it.
+type SubscriptionStore interface {
+	// GetSubscription returns an active free subscription if the user has none.
+	GetSubscription(ctx context.Context, userID int64) (*Subscription, error)
+	// SaveSubscription creates or replaces the user's subscription.
+	SaveSubscription(ctx context.Context, sub *Subscription) error
+	// ExpireSubscriptions moves at most limit paid subscriptions whose end
+	// date is at or before now back to the free plan.
+	ExpireSubscriptions(ctx context.Context, now time.Time, limit int) (int, error)
+	// RecordBillingEvent stores a processed event and reports false if an
+	// event with the same ID was already recorded.
+	RecordBillingEvent(ctx context.Context, e *BillingEvent) (bool, error)
+}
+
+// SessionStore persists refresh tokens, looked up by the hash of the token.
+type SessionStore interface {
+	CreateRefreshToken(ctx context.Context, t *RefreshToken) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// MarkRefreshTokenUsed reports false if the token was already used or revoked.
+	MarkRefreshTokenUsed(ctx context.Context, id int64) (bool, error)
+	RevokeRefreshFamily(ctx context.Context, familyID uuid.UUID) (int, error)
+	RevokeUserRefreshTokens(ctx context.Context, userID int64) (int, error)
+}
+
+// FamilyStore persists guardian invites and guardian–senior links.
+type FamilyStore interface {
+	CreateFamilyInvite(ctx context.Context, inv *FamilyInvite) error
+	// ClaimFamilyInvite marks an unexpired, unclaimed invite as used by
+	// seniorID. It returns sql.ErrNoRows if there is no such invite.
+	ClaimFamilyInvite(ctx context.Context, codeHash string, seniorID int64, now time.Time) (*FamilyInvite, error)
+	// RequestFamilyLink creates a pending link, or reopens a declined or
+	// revoked one with the new role. Active links are returned unchanged.
+	RequestFamilyLink(ctx context.Context, guardianID, seniorID int64, role string) (*FamilyLink, error)
+	GetFamilyLink(ctx context.Context, linkID int64) (*FamilyLink, error)
+	// GetFamilyLinkBetween returns the link from guardianID to seniorID in any status.
+	GetFamilyLinkBetween(ctx context.Context, guardianID, seniorID int64) (*FamilyLink, error)
+	// SetFamilyLinkStatus moves a link from one of the from statuses to
+	// status, and reports false if it was in none of them.
+	SetFamilyLinkStatus(ctx context.Context, linkID int64, status string, from ...string) (bool, error)
+	// ListFamilyLinks returns the links where userID is guardian or senior, oldest first.
+	ListFamilyLinks(ctx context.Context, userID int64) ([]FamilyLink, error)
+}
+
+// APIKeyStore persists partner API keys and their daily usage.
+type APIKeyStore interface {
+	CreateAPIKey(ctx context.Context, k *APIKey) error
+	// GetAPIKeyByHash returns the key with the hash, revoked or not.
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	GetAPIKey(ctx context.Context, keyID int64) (*APIKey, error)
+	// RevokeAPIKey reports false if the key does not exist or is already revoked.
+	RevokeAPIKey(ctx context.Context, keyID int64) (bool, error)
+	ListAPIKeys(ctx context.Context) ([]APIKey, error)
+	// UseAPIKey counts n requests on the key's usage for day and updates
+	// last_used_at. If quota > 0 and the day's count would exceed it, nothing
+	// is counted and ok is false. used is the day's count.
+	UseAPIKey(ctx context.Context, keyID int64, day time.Time, n, quota int) (used int, ok bool, err error)
+}
+
+// UsageStore counts how much of their plan's daily allowance each user has
+// used, per feature and UTC day.
+type UsageStore interface {
+	// UseDailyUsage counts n uses of feature by userID on day. If limit > 0
+	// and the count would go over it, nothing is counted and ok is false.
+	// used is the day's count.
+	UseDailyUsage(ctx context.Context, userID int64, feature string, day time.Time, n, limit int) (used int, ok bool, err error)
+	GetDailyUsage(ctx context.Context, userID int64, feature string, day time.Time) (int, error)
+	// UseAnonymousUsage is UseDailyUsage for callers who are not signed in,
+	// counted per client IP.
+	UseAnonymousUsage(ctx context.Context, clientIP, feature string, day time.Time, n, limit int) (used int, ok bool, err error)
+}
+
+// HistoryStore persists the per-user list of analyzed videos: one entry per
+// user and video, linked to the latest job the user ran on it.
+type HistoryStore interface {
+	AddHistory(ctx context.Context, userID int64, jobID uuid.UUID, videoID, title, thumb string) error
+	DeleteHistory(ctx context.Context, userID int64, videoID string) (bool, error)
+	ClearHistory(ctx context.Context, userID int64) (int, error)
+	BackfillHistory(ctx context.Context, videoID, title, thumbnailURL string) error
+	GetHistory(ctx context.Context, userID int64, f HistoryFilter, after *HistoryCursor, limit int) (*HistoryPage, error)
+}
+
+// WatchStore persists channel watches and the alerts they produce.
+type WatchStore interface {
+	AddWatch(ctx context.Context, w *ChannelWatch) error
+	RemoveWatch(ctx context.Context, userID int64, channelID string) (bool, error)
+	ListWatches(ctx context.Context, userID int64) ([]ChannelWatch, error)
+	ListWatchedChannels(ctx context.Context) ([]WatchedChannel, error)
+	AdvanceWatches(ctx context.Context, channelID string, uploadedAt time.Time) error
+	CreateWatchAlerts(ctx context.Context, channelID, videoID, title string, jobID uuid.UUID, safetyScore int, publishedAt time.Time) (int, error)
+	// CreateFamilyAlert records an alert for the owner of a video job when
+	// they have an active guardian, so the guardian sees it in the senior's
+	// alerts. Alerts are kept apart from channel watch alerts for the same
+	// video; re-checking a video refreshes its family alert. It reports
+	// whether an alert was created or refreshed.
+	CreateFamilyAlert(ctx context.Context, jobID uuid.UUID, safetyScore int) (bool, error)
+	ListWatchAlerts(ctx context.Context, userID int64, unreadOnly bool, limit int) ([]WatchAlert, error)
+	MarkWatchAlertsRead(ctx context.Context, userID int64, alertIDs []int64) (int, error)
+}
+
+// RetentionStore deletes expired data in bounded batches. Each call removes
+// at most limit rows so callers can purge large tables incrementally.
+type RetentionStore interface {
+	PurgeCaptions(ctx context.Context, before time.Time, limit int) (int, error)
+	PurgeComments(ctx context.Context, before time.Time, limit int) (int, error)
+	ListExpiredUploads(ctx context.Context, before time.Time, limit int) ([]Upload, error)
+	DeleteUploads(ctx context.Context, ids []uuid.UUID) (int, error)
+}
+
+// Store is everything the server needs from persistence.
+type Store interface {
+	VideoStore
+	JobStore
+	ResultStore
+	UserStore
+	SubscriptionStore
+	SessionStore
+	FamilyStore
+	APIKeyStore
+	UsageStore
+	HistoryStore
+	WatchStore
+	RetentionStore
+
+	// WithTx runs fn inside a transaction: every write made through tx is
+	// committed together if fn returns nil and discarded otherwise.
+	WithTx(ctx context.Context, fn func(tx Store) error) error
+	Close() error
+}
+
+var (
+	_ Store = (*PostgresStore)(nil)
+	_ Store = (*MemoryStore)(nil)
+)
@@ -0,0 +1,1121 @@
+// Package storetest is a conformance suite every storage.Store
+// implementation must pass, so the in-memory store used in unit tests
+// behaves like Postgres.
+package storetest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+// Run runs the suite. newStore must return an empty store for each subtest.
+func Run(t *testing.T, newStore func(t *testing.T) storage.Store) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, s storage.Store)
+	}{
+		{"Videos", testVideos},
+		{"Comments", testComments},
+		{"Jobs", testJobs},
+		{"JobOwner", testJobOwner},
+		{"Batches", testBatches},
+		{"Results", testResults},
+		{"UploadResults", testUploadResults},
+		{"Users", testUsers},
+		{"Identities", testIdentities},
+		{"Subscriptions", testSubscriptions},
+		{"Sessions", testSessions},
+		{"Family", testFamily},
+		{"APIKeys", testAPIKeys},
+		{"Usage", testUsage},
+		{"History", testHistory},
+		{"BackfillHistory", testBackfillHistory},
+		{"Watchlist", testWatchlist},
+		{"FamilyAlerts", testFamilyAlerts},
+		{"Retention", testRetention},
+		{"Transactions", testTransactions},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newStore(t))
+		})
+	}
+}
+
+func createVideo(t *testing.T, s storage.Store, videoID, title string) {
+	t.Helper()
+	require.NoError(t, s.CreateVideo(context.Background(), &storage.Video{
+		VideoID:      videoID,
+		Title:        title,
+		ChannelID:    "UCchannel",
+		ThumbnailURL: "https://i.ytimg.com/vi/" + videoID + "/hq.jpg",
+		PublishedAt:  time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	}))
+}
+
+func testVideos(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	_, err := s.GetVideo(ctx, "missing0000")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	createVideo(t, s, "video000001", "Original")
+
+	// 재분석 시 빈 channel_id/thumbnail_url은 기존 값을 지우지 않음
+	require.NoError(t, s.CreateVideo(ctx, &storage.Video{VideoID: "video000001", Title: "Renamed"}))
+
+	v, err := s.GetVideo(ctx, "video000001")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", v.Title)
+	assert.Equal(t, "youtube", v.Platform)
+	assert.Equal(t, "UCchannel", v.ChannelID)
+	assert.Equal(t, "https://i.ytimg.com/vi/video000001/hq.jpg", v.ThumbnailURL)
+}
+
+func testComments(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	createVideo(t, s, "video000001", "Video")
+
+	require.NoError(t, s.SaveComments(ctx, "video000001", []storage.Comment{
+		{Author: "b", Text: "second", Rank: 2},
+		{Author: "a", Text: "first", Rank: 1},
+	}))
+	// 다시 저장하면 이전 댓글을 대체
+	require.NoError(t, s.SaveComments(ctx, "video000001", []storage.Comment{
+		{Author: "c", Text: "third", Rank: 3},
+		{Author: "b", Text: "second", Rank: 2},
+		{Author: "a", Text: "first", Rank: 1},
+	}))
+
+	comments, err := s.GetComments(ctx, "video000001", 2)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.Equal(t, "first", comments[0].Text)
+	assert.Equal(t, "second", comments[1].Text)
+}
+
+func testJobs(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	_, err := s.GetJob(ctx, uuid.New())
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	createVideo(t, s, "video000001", "Video")
+	job, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusPending, job.Status)
+
+	require.NoError(t, s.UpdateJobStatus(ctx, job.JobID, storage.StatusProcessing, 10))
+	got, err := s.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusProcessing, got.Status)
+	assert.Equal(t, 10, got.Progress)
+	assert.True(t, got.StartedAt.Valid)
+	assert.False(t, got.CompletedAt.Valid)
+
+	require.NoError(t, s.UpdateJobStatus(ctx, job.JobID, storage.StatusCompleted, 100))
+	got, err = s.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.True(t, got.CompletedAt.Valid)
+
+	failing, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateJobError(ctx, failing.JobID, "boom"))
+	got, err = s.GetJob(ctx, failing.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusFailed, got.Status)
+	assert.Equal(t, "boom", got.ErrorMessage.String)
+}
+
+// testJobOwner: Job은 요청한 사용자를 기록하고, 배치/업로드 Job은 배치/업로드 소유자를 따른다
+func testJobOwner(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	user, err := s.UpsertUser(ctx, "senior@example.com", "Kim", "", "")
+	require.NoError(t, err)
+	createVideo(t, s, "video000001", "Video")
+
+	owned, err := s.CreateJob(ctx, "video000001", user.ID)
+	require.NoError(t, err)
+	anonymous, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	got, err := s.GetJob(ctx, owned.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.UserID)
+	got, err = s.GetJob(ctx, anonymous.JobID)
+	require.NoError(t, err)
+	assert.Zero(t, got.UserID)
+
+	batch := &storage.AnalysisBatch{UserID: user.ID, Kind: "playlist", SourceURL: "https://youtube.com/playlist?list=PL1", Total: 1}
+	require.NoError(t, s.CreateBatch(ctx, batch))
+	batchJob, err := s.CreateBatchJob(ctx, batch.BatchID, "video000001")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, batchJob.UserID)
+	got, err = s.GetJob(ctx, batchJob.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.UserID)
+
+	upload := &storage.Upload{UploadID: uuid.New(), UserID: user.ID, S3Bucket: "bucket", S3Key: "uploads/clip.mp4"}
+	require.NoError(t, s.CreateUpload(ctx, upload))
+	uploadJob, err := s.CreateUploadJob(ctx, upload.UploadID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, uploadJob.UserID)
+	got, err = s.GetJob(ctx, uploadJob.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.UserID)
+}
+
+func testBatches(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	batch := &storage.AnalysisBatch{Kind: "playlist", SourceURL: "https://youtube.com/playlist?list=PL1", Title: "List", Total: 2}
+	require.NoError(t, s.CreateBatch(ctx, batch))
+	assert.NotEqual(t, uuid.Nil, batch.BatchID)
+	assert.Equal(t, storage.StatusProcessing, batch.Status)
+
+	createVideo(t, s, "video000001", "First")
+	createVideo(t, s, "video000002", "Second")
+	first, err := s.CreateBatchJob(ctx, batch.BatchID, "video000001")
+	require.NoError(t, err)
+	_, err = s.CreateBatchJob(ctx, batch.BatchID, "video000002")
+	require.NoError(t, err)
+	require.NoError(t, s.SaveResult(ctx, first.JobID, 35, []string{"scam"}, map[string]int{"safety_score": 35}))
+
+	items, err := s.ListBatchItems(ctx, batch.BatchID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	byVideo := map[string]storage.BatchItem{}
+	for _, item := range items {
+		byVideo[item.VideoID] = item
+	}
+	assert.True(t, byVideo["video000001"].HasResult)
+	assert.Equal(t, 35, byVideo["video000001"].SafetyScore)
+	assert.Equal(t, "First", byVideo["video000001"].Title)
+	assert.False(t, byVideo["video000002"].HasResult)
+
+	require.NoError(t, s.UpdateBatchStatus(ctx, batch.BatchID, storage.StatusCompleted))
+	got, err := s.GetBatch(ctx, batch.BatchID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCompleted, got.Status)
+	assert.True(t, got.CompletedAt.Valid)
+	assert.Equal(t, 2, got.Total)
+
+	_, err = s.GetBatch(ctx, uuid.New())
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func testResults(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	assert.ErrorIs(t, s.SaveResult(ctx, uuid.New(), 50, nil, nil), sql.ErrNoRows)
+
+	createVideo(t, s, "video000001", "Video")
+	job, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+
+	_, err = s.GetResult(ctx, job.JobID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 라이브 재분석처럼 같은 Job에 결과가 여러 번 저장되면 최신 결과를 반환
+	require.NoError(t, s.SaveResult(ctx, job.JobID, 80, []string{}, map[string]int{"safety_score": 80}))
+	require.NoError(t, s.SaveResult(ctx, job.JobID, 30, []string{"impersonation"}, map[string]int{"safety_score": 30}))
+
+	r, err := s.GetResult(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, r.SafetyScore)
+	assert.Equal(t, "video000001", r.VideoID)
+	assert.Equal(t, storage.ResultSourceGemini, r.Source)
+	assert.JSONEq(t, `["impersonation"]`, r.Categories)
+	assert.JSONEq(t, `{"safety_score": 30}`, r.GeminiResponse)
+
+	latest, err := s.GetLatestResult(ctx, "video000001")
+	require.NoError(t, err)
+	assert.Equal(t, r.ResultID, latest.ResultID)
+
+	_, err = s.GetLatestResult(ctx, "missing0000")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 검토자의 판정 변경은 기존 결과의 내용을 유지한 최신 결과
+	reviewer, err := s.UpsertUser(ctx, "reviewer@example.com", "Reviewer", "", "")
+	require.NoError(t, err)
+	require.NoError(t, s.SaveReviewResult(ctx, job.JobID, 85, reviewer.ID, "official channel"))
+	reviewed, err := s.GetLatestResult(ctx, "video000001")
+	require.NoError(t, err)
+	assert.Equal(t, storage.ResultSourceReview, reviewed.Source)
+	assert.Equal(t, 85, reviewed.SafetyScore)
+	assert.Equal(t, sql.NullInt64{Int64: reviewer.ID, Valid: true}, reviewed.ReviewedBy)
+	assert.Equal(t, "official channel", reviewed.ReviewReason)
+	assert.JSONEq(t, `["impersonation"]`, reviewed.Categories)
+
+	other, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	assert.ErrorIs(t, s.SaveReviewResult(ctx, other.JobID, 85, reviewer.ID, ""), sql.ErrNoRows)
+}
+
+func testUploadResults(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	upload := &storage.Upload{
+		UploadID:    uuid.New(),
+		S3Bucket:    "bucket",
+		S3Key:       "uploads/clip.mp4",
+		Filename:    "clip.mp4",
+		ContentType: "video/mp4",
+	}
+	require.NoError(t, s.CreateUpload(ctx, upload))
+	assert.False(t, upload.CreatedAt.IsZero())
+
+	got, err := s.GetUpload(ctx, upload.UploadID)
+	require.NoError(t, err)
+	assert.Equal(t, "uploads/clip.mp4", got.S3Key)
+	assert.Zero(t, got.UserID) // 비회원 업로드
+	_, err = s.GetUpload(ctx, uuid.New())
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	job, err := s.CreateUploadJob(ctx, upload.UploadID)
+	require.NoError(t, err)
+	assert.Equal(t, upload.UploadID, job.UploadID.UUID)
+	assert.Empty(t, job.VideoID)
+
+	require.NoError(t, s.SaveResult(ctx, job.JobID, 90, nil, nil))
+	r, err := s.GetLatestResult(ctx, upload.UploadID.String())
+	require.NoError(t, err)
+	assert.True(t, r.UploadID.Valid)
+	assert.Equal(t, upload.UploadID, r.UploadID.UUID)
+	assert.Empty(t, r.VideoID)
+}
+
+func testUsers(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	user, err := s.UpsertUser(ctx, "senior@example.com", "Kim", "https://pic/1", "google-1")
+	require.NoError(t, err)
+	assert.NotZero(t, user.ID)
+
+	again, err := s.UpsertUser(ctx, "senior@example.com", "Kim Senior", "https://pic/2", "google-1")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, again.ID)
+
+	got, err := s.GetUserByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Kim Senior", got.Name)
+	assert.Equal(t, "https://pic/2", got.PictureURL)
+	assert.Equal(t, "user", got.Role)
+
+	_, err = s.GetUserByID(ctx, user.ID+1000)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, s.SetUserRole(ctx, user.ID, "admin"))
+	got, err = s.GetUserByEmail(ctx, "senior@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", got.Role)
+	assert.ErrorIs(t, s.SetUserRole(ctx, user.ID+1000, "admin"), sql.ErrNoRows)
+
+	sub, err := s.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "free", sub.PlanType)
+}
+
+func testSubscriptions(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	user, err := s.UpsertUser(ctx, "senior@example.com", "Kim", "", "")
+	require.NoError(t, err)
+	other, err := s.UpsertUser(ctx, "other@example.com", "Lee", "", "")
+	require.NoError(t, err)
+
+	sub, err := s.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "free", sub.PlanType)
+	assert.Equal(t, storage.SubscriptionActive, sub.Status)
+
+	start := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	require.NoError(t, s.SaveSubscription(ctx, &storage.Subscription{
+		UserID:     user.ID,
+		PlanType:   "pro",
+		Status:     storage.SubscriptionCanceled,
+		StartDate:  sql.NullTime{Time: start, Valid: true},
+		EndDate:    sql.NullTime{Time: end, Valid: true},
+		BillingRef: "sub_1",
+		CanceledAt: sql.NullTime{Time: start.Add(time.Hour), Valid: true},
+	}))
+	require.NoError(t, s.SaveSubscription(ctx, &storage.Subscription{
+		UserID:   other.ID,
+		PlanType: "pro",
+		Status:   storage.SubscriptionActive,
+		EndDate:  sql.NullTime{Time: end.AddDate(0, 1, 0), Valid: true},
+	}))
+	assert.Error(t, s.SaveSubscription(ctx, &storage.Subscription{UserID: other.ID + 1000, PlanType: "pro", Status: storage.SubscriptionActive}))
+
+	sub, err = s.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", sub.PlanType)
+	assert.Equal(t, storage.SubscriptionCanceled, sub.Status)
+	assert.True(t, end.Equal(sub.EndDate.Time))
+	assert.Equal(t, "sub_1", sub.BillingRef)
+	assert.True(t, sub.CanceledAt.Valid)
+
+	// 기간이 끝난 유료 구독만 free로
+	n, err := s.ExpireSubscriptions(ctx, end.Add(-time.Second), 10)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	n, err = s.ExpireSubscriptions(ctx, end, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	sub, err = s.GetSubscription(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "free", sub.PlanType)
+	assert.Equal(t, storage.SubscriptionExpired, sub.Status)
+	sub, err = s.GetSubscription(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", sub.PlanType)
+
+	// 같은 이벤트 ID는 한 번만 기록
+	event := &storage.BillingEvent{EventID: "evt_1", Type: "subscription.activated", UserID: sql.NullInt64{Int64: user.ID, Valid: true}}
+	recorded, err := s.RecordBillingEvent(ctx, event)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	assert.False(t, event.ReceivedAt.IsZero())
+	recorded, err = s.RecordBillingEvent(ctx, &storage.BillingEvent{EventID: "evt_1", Type: "subscription.activated"})
+	require.NoError(t, err)
+	assert.False(t, recorded)
+}
+
+func testIdentities(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	user, err := s.UpsertUser(ctx, "senior@example.com", "Kim", "https://pic/1", "google-1")
+	require.NoError(t, err)
+	other, err := s.UpsertUser(ctx, "other@example.com", "Lee", "", "")
+	require.NoError(t, err)
+
+	// 빈 값으로 갱신해도 기존 프로필 유지
+	again, err := s.UpsertUser(ctx, "senior@example.com", "", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Kim", again.Name)
+	assert.Equal(t, "https://pic/1", again.PictureURL)
+	assert.Equal(t, "google-1", again.ProviderID)
+
+	got, err := s.GetUserByEmail(ctx, "senior@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.ID)
+	_, err = s.GetUserByEmail(ctx, "missing@example.com")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = s.GetUserByIdentity(ctx, "kakao", "1001")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, s.LinkIdentity(ctx, user.ID, "google", "google-1", "senior@example.com"))
+	require.NoError(t, s.LinkIdentity(ctx, user.ID, "kakao", "1001", "senior@example.com"))
+	// 다시 로그인하면 로그인 시각만 갱신
+	require.NoError(t, s.LinkIdentity(ctx, user.ID, "kakao", "1001", ""))
+	// 같은 계정을 다른 사용자에 연결할 수 없음
+	assert.Error(t, s.LinkIdentity(ctx, other.ID, "kakao", "1001", "other@example.com"))
+	// 제공자가 달라 subject가 같아도 별개 계정
+	require.NoError(t, s.LinkIdentity(ctx, other.ID, "naver", "1001", ""))
+
+	got, err = s.GetUserByIdentity(ctx, "kakao", "1001")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.ID)
+	assert.Equal(t, "senior@example.com", got.Email)
+
+	identities, err := s.ListIdentities(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, identities, 2)
+	assert.Equal(t, "google", identities[0].Provider)
+	assert.Equal(t, "kakao", identities[1].Provider)
+	assert.Equal(t, "senior@example.com", identities[1].Email)
+	assert.True(t, identities[1].LastLoginAt.After(identities[1].CreatedAt))
+
+	identities, err = s.ListIdentities(ctx, other.ID)
+	require.NoError(t, err)
+	require.Len(t, identities, 1)
+	assert.Empty(t, identities[0].Email)
+}
+
+func testAPIKeys(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	admin, err := s.UpsertUser(ctx, "admin@example.com", "Admin", "", "")
+	require.NoError(t, err)
+
+	key := &storage.APIKey{
+		Name:       "Bank",
+		Prefix:     "sg_abcd1234",
+		KeyHash:    strings.Repeat("a", 64),
+		Scopes:     []string{"analysis:read", "analysis:write"},
+		DailyQuota: 2,
+		CreatedBy:  sql.NullInt64{Int64: admin.ID, Valid: true},
+	}
+	require.NoError(t, s.CreateAPIKey(ctx, key))
+	assert.NotZero(t, key.ID)
+	assert.Error(t, s.CreateAPIKey(ctx, &storage.APIKey{Name: "Dup", Prefix: "sg_dup", KeyHash: key.KeyHash}))
+
+	got, err := s.GetAPIKeyByHash(ctx, key.KeyHash)
+	require.NoError(t, err)
+	assert.Equal(t, key.ID, got.ID)
+	assert.Equal(t, []string{"analysis:read", "analysis:write"}, got.Scopes)
+	assert.False(t, got.LastUsedAt.Valid)
+	_, err = s.GetAPIKeyByHash(ctx, strings.Repeat("b", 64))
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 하루 상한까지만 카운트, 다음 날은 다시 0부터
+	day := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+	for want := 1; want <= 2; want++ {
+		used, ok, err := s.UseAPIKey(ctx, key.ID, day, 1, key.DailyQuota)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, want, used)
+	}
+	used, ok, err := s.UseAPIKey(ctx, key.ID, day, 1, key.DailyQuota)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 2, used)
+	// 일괄 분석은 영상 수만큼 한 번에 차감, 상한을 넘으면 통째로 거부
+	used, ok, err = s.UseAPIKey(ctx, key.ID, day.Add(2*time.Hour), 3, key.DailyQuota)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Zero(t, used)
+	used, ok, err = s.UseAPIKey(ctx, key.ID, day.Add(2*time.Hour), 2, key.DailyQuota)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, used)
+
+	got, err = s.GetAPIKey(ctx, key.ID)
+	require.NoError(t, err)
+	assert.True(t, got.LastUsedAt.Valid)
+
+	revoked, err := s.RevokeAPIKey(ctx, key.ID)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	revoked, err = s.RevokeAPIKey(ctx, key.ID)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	keys, err := s.ListAPIKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.True(t, keys[0].RevokedAt.Valid)
+}
+
+func testUsage(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	user, err := s.UpsertUser(ctx, "senior@example.com", "Kim", "", "")
+	require.NoError(t, err)
+
+	day := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+	used, err := s.GetDailyUsage(ctx, user.ID, "analyses", day)
+	require.NoError(t, err)
+	assert.Zero(t, used)
+
+	// 상한을 넘는 요청은 통째로 거부, 기능/날짜별로 따로 집계
+	used, ok, err := s.UseDailyUsage(ctx, user.ID, "analyses", day, 2, 3)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, used)
+	used, ok, err = s.UseDailyUsage(ctx, user.ID, "analyses", day, 2, 3)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 2, used)
+	used, ok, err = s.UseDailyUsage(ctx, user.ID, "analyses", day.Add(30*time.Minute), 1, 3)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 3, used)
+	used, ok, err = s.UseDailyUsage(ctx, user.ID, "analyses", day.Add(2*time.Hour), 3, 3)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 3, used)
+	used, ok, err = s.UseDailyUsage(ctx, user.ID, "other", day, 10, 0)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 10, used)
+
+	used, err = s.GetDailyUsage(ctx, user.ID, "analyses", day)
+	require.NoError(t, err)
+	assert.Equal(t, 3, used)
+
+	// 비회원은 IP별로 집계
+	used, ok, err = s.UseAnonymousUsage(ctx, "203.0.113.7", "analyses", day, 4, 3)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Zero(t, used)
+	used, ok, err = s.UseAnonymousUsage(ctx, "203.0.113.7", "analyses", day, 3, 3)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 3, used)
+	used, ok, err = s.UseAnonymousUsage(ctx, "203.0.113.7", "analyses", day, 1, 3)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 3, used)
+	used, ok, err = s.UseAnonymousUsage(ctx, "2001:db8::1", "analyses", day, 1, 3)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, used)
+}
+
+func testFamily(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	guardian, err := s.UpsertUser(ctx, "son@example.com", "Son", "", "")
+	require.NoError(t, err)
+	senior, err := s.UpsertUser(ctx, "mom@example.com", "Mom", "", "")
+	require.NoError(t, err)
+
+	now := time.Now()
+	inv := &storage.FamilyInvite{GuardianID: guardian.ID, CodeHash: "hash-1", Role: storage.FamilyRoleViewer, ExpiresAt: now.Add(time.Hour)}
+	require.NoError(t, s.CreateFamilyInvite(ctx, inv))
+	assert.NotZero(t, inv.ID)
+	require.NoError(t, s.CreateFamilyInvite(ctx, &storage.FamilyInvite{GuardianID: guardian.ID, CodeHash: "hash-old", Role: storage.FamilyRoleViewer, ExpiresAt: now.Add(-time.Minute)}))
+
+	// 만료된 코드, 없는 코드, 이미 사용한 코드는 거부
+	_, err = s.ClaimFamilyInvite(ctx, "hash-old", senior.ID, now)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = s.ClaimFamilyInvite(ctx, "missing", senior.ID, now)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	claimed, err := s.ClaimFamilyInvite(ctx, "hash-1", senior.ID, now)
+	require.NoError(t, err)
+	assert.Equal(t, guardian.ID, claimed.GuardianID)
+	assert.Equal(t, storage.FamilyRoleViewer, claimed.Role)
+	assert.Equal(t, senior.ID, claimed.ClaimedBy.Int64)
+	_, err = s.ClaimFamilyInvite(ctx, "hash-1", senior.ID, now)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	link, err := s.RequestFamilyLink(ctx, guardian.ID, senior.ID, storage.FamilyRoleViewer)
+	require.NoError(t, err)
+	assert.Equal(t, storage.FamilyPending, link.Status)
+	_, err = s.RequestFamilyLink(ctx, guardian.ID, guardian.ID, storage.FamilyRoleViewer)
+	assert.Error(t, err)
+
+	// 상태 전이는 허용된 이전 상태에서만
+	ok, err := s.SetFamilyLinkStatus(ctx, link.ID, storage.FamilyActive, storage.FamilyPending)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = s.SetFamilyLinkStatus(ctx, link.ID, storage.FamilyDeclined, storage.FamilyPending)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	got, err := s.GetFamilyLink(ctx, link.ID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.FamilyActive, got.Status)
+	assert.True(t, got.ApprovedAt.Valid)
+	_, err = s.GetFamilyLink(ctx, link.ID+1000)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 활성 연결은 새 요청으로 역할이 바뀌지 않음
+	again, err := s.RequestFamilyLink(ctx, guardian.ID, senior.ID, storage.FamilyRoleGuardian)
+	require.NoError(t, err)
+	assert.Equal(t, link.ID, again.ID)
+	assert.Equal(t, storage.FamilyActive, again.Status)
+	assert.Equal(t, storage.FamilyRoleViewer, again.Role)
+
+	// 해제 후 다시 요청하면 새 역할로 승인 대기
+	ok, err = s.SetFamilyLinkStatus(ctx, link.ID, storage.FamilyRevoked, storage.FamilyPending, storage.FamilyActive)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	got, err = s.GetFamilyLinkBetween(ctx, guardian.ID, senior.ID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.FamilyRevoked, got.Status)
+	assert.True(t, got.RevokedAt.Valid)
+	_, err = s.GetFamilyLinkBetween(ctx, senior.ID, guardian.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	again, err = s.RequestFamilyLink(ctx, guardian.ID, senior.ID, storage.FamilyRoleGuardian)
+	require.NoError(t, err)
+	assert.Equal(t, link.ID, again.ID)
+	assert.Equal(t, storage.FamilyPending, again.Status)
+	assert.Equal(t, storage.FamilyRoleGuardian, again.Role)
+	assert.False(t, again.RevokedAt.Valid)
+
+	other, err := s.UpsertUser(ctx, "dad@example.com", "Dad", "", "")
+	require.NoError(t, err)
+	_, err = s.RequestFamilyLink(ctx, guardian.ID, other.ID, storage.FamilyRoleGuardian)
+	require.NoError(t, err)
+
+	links, err := s.ListFamilyLinks(ctx, guardian.ID)
+	require.NoError(t, err)
+	assert.Len(t, links, 2)
+	links, err = s.ListFamilyLinks(ctx, senior.ID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, guardian.ID, links[0].GuardianID)
+}
+
+func testSessions(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	user, err := s.UpsertUser(ctx, "senior@example.com", "Kim", "", "google-1")
+	require.NoError(t, err)
+
+	family := uuid.New()
+	newToken := func(hash string, familyID uuid.UUID, expires time.Time) *storage.RefreshToken {
+		tok := &storage.RefreshToken{UserID: user.ID, FamilyID: familyID, TokenHash: hash, ExpiresAt: expires}
+		require.NoError(t, s.CreateRefreshToken(ctx, tok))
+		assert.NotZero(t, tok.ID)
+		return tok
+	}
+	first := newToken("hash-1", family, time.Now().Add(time.Hour))
+	assert.Error(t, s.CreateRefreshToken(ctx, &storage.RefreshToken{UserID: user.ID, FamilyID: family, TokenHash: "hash-1", ExpiresAt: time.Now().Add(time.Hour)}))
+
+	got, err := s.GetRefreshToken(ctx, "hash-1")
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, got.ID)
+	assert.Equal(t, family, got.FamilyID)
+	assert.WithinDuration(t, first.ExpiresAt, got.ExpiresAt, time.Second)
+	assert.False(t, got.UsedAt.Valid)
+	_, err = s.GetRefreshToken(ctx, "missing")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 교체: 한 번만 사용 가능
+	ok, err := s.MarkRefreshTokenUsed(ctx, first.ID)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = s.MarkRefreshTokenUsed(ctx, first.ID)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	got, err = s.GetRefreshToken(ctx, "hash-1")
+	require.NoError(t, err)
+	assert.True(t, got.UsedAt.Valid)
+
+	// family 폐기는 아직 유효한 토큰만 세고, 다른 family는 유지
+	second := newToken("hash-2", family, time.Now().Add(time.Hour))
+	other := newToken("hash-3", uuid.New(), time.Now().Add(time.Hour))
+	newToken("hash-4", uuid.New(), time.Now().Add(-time.Hour))
+
+	n, err := s.RevokeRefreshFamily(ctx, family)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	got, err = s.GetRefreshToken(ctx, "hash-2")
+	require.NoError(t, err)
+	assert.True(t, got.RevokedAt.Valid)
+	ok, err = s.MarkRefreshTokenUsed(ctx, second.ID)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	n, err = s.RevokeUserRefreshTokens(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	got, err = s.GetRefreshToken(ctx, "hash-3")
+	require.NoError(t, err)
+	assert.Equal(t, other.ID, got.ID)
+	assert.True(t, got.RevokedAt.Valid)
+}
+
+func testHistory(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	user, err := s.UpsertUser(ctx, "senior@example.com", "Kim", "", "google-1")
+	require.NoError(t, err)
+	other, err := s.UpsertUser(ctx, "other@example.com", "Lee", "", "google-2")
+	require.NoError(t, err)
+
+	// 분석 시작 시점에는 제목을 모름
+	scam, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	require.NoError(t, s.AddHistory(ctx, user.ID, scam.JobID, "video000001", "Processing...", ""))
+	pending, err := s.CreateJob(ctx, "video000002", 0)
+	require.NoError(t, err)
+	require.NoError(t, s.AddHistory(ctx, user.ID, pending.JobID, "video000002", "Processing...", ""))
+	safe, err := s.CreateJob(ctx, "video000003", 0)
+	require.NoError(t, err)
+	require.NoError(t, s.AddHistory(ctx, user.ID, safe.JobID, "video000003", "Processing...", ""))
+
+	createVideo(t, s, "video000001", "Suspicious Giveaway")
+	require.NoError(t, s.SaveResult(ctx, scam.JobID, 20, []string{"scam"}, nil))
+	createVideo(t, s, "video000003", "Cooking")
+	require.NoError(t, s.SaveResult(ctx, safe.JobID, 90, nil, nil))
+	require.NoError(t, s.BackfillHistory(ctx, "video000002", "Backfilled", "https://thumb/2"))
+
+	// 다른 사용자가 같은 영상을 재분석해도 내 기록의 점수는 내 Job 기준
+	rerun, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	require.NoError(t, s.AddHistory(ctx, other.ID, rerun.JobID, "video000001", "Processing...", ""))
+	require.NoError(t, s.SaveResult(ctx, rerun.JobID, 85, nil, nil))
+
+	// 최신순, 2개씩
+	first, err := s.GetHistory(ctx, user.ID, storage.HistoryFilter{}, nil, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, first.Total)
+	require.Len(t, first.Items, 2)
+	require.NotNil(t, first.Next)
+
+	assert.Equal(t, "video000003", first.Items[0].VideoID)
+	assert.Equal(t, "Cooking", first.Items[0].VideoTitle)
+	assert.Equal(t, 90, first.Items[0].SafetyScore)
+
+	assert.Equal(t, "video000002", first.Items[1].VideoID)
+	assert.Equal(t, "Backfilled", first.Items[1].VideoTitle)
+	assert.Equal(t, "https://thumb/2", first.Items[1].ThumbnailURL)
+	assert.False(t, first.Items[1].HasResult)
+	assert.Equal(t, pending.JobID.String(), first.Items[1].JobID)
+	assert.Equal(t, storage.StatusPending, first.Items[1].JobStatus)
+
+	second, err := s.GetHistory(ctx, user.ID, storage.HistoryFilter{}, first.Next, 2)
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Nil(t, second.Next)
+	assert.Equal(t, "Suspicious Giveaway", second.Items[0].VideoTitle)
+	assert.True(t, second.Items[0].HasResult)
+	assert.Equal(t, 20, second.Items[0].SafetyScore)
+	assert.Equal(t, scam.JobID.String(), second.Items[0].JobID)
+
+	// 필터: 결과가 없는 항목은 점수/판정 필터에서 제외
+	danger, err := s.GetHistory(ctx, user.ID, storage.HistoryFilter{Verdict: storage.VerdictDanger}, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, danger.Total)
+	require.Len(t, danger.Items, 1)
+	assert.Equal(t, "video000001", danger.Items[0].VideoID)
+
+	min := 50
+	above, err := s.GetHistory(ctx, user.ID, storage.HistoryFilter{MinScore: &min}, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, above.Items, 1)
+	assert.Equal(t, "video000003", above.Items[0].VideoID)
+
+	future, err := s.GetHistory(ctx, user.ID, storage.HistoryFilter{From: time.Now().Add(24 * time.Hour)}, nil, 10)
+	require.NoError(t, err)
+	assert.Zero(t, future.Total)
+	assert.Empty(t, future.Items)
+
+	_, err = s.GetHistory(ctx, user.ID, storage.HistoryFilter{Verdict: "unknown"}, nil, 10)
+	assert.Error(t, err)
+
+	// 같은 영상 재검사: 항목은 하나로 유지되고 최신 Job으로 갱신되어 맨 위로
+	recheck, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	require.NoError(t, s.AddHistory(ctx, user.ID, recheck.JobID, "video000001", "Processing...", ""))
+	latest, err := s.GetHistory(ctx, user.ID, storage.HistoryFilter{}, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, latest.Total)
+	require.Len(t, latest.Items, 3)
+	assert.Equal(t, "video000001", latest.Items[0].VideoID)
+	assert.Equal(t, "Suspicious Giveaway", latest.Items[0].VideoTitle)
+	assert.Equal(t, recheck.JobID.String(), latest.Items[0].JobID)
+	assert.Equal(t, 2, latest.Items[0].CheckCount)
+	assert.False(t, latest.Items[0].HasResult)
+	assert.Equal(t, 1, latest.Items[1].CheckCount)
+
+	// 삭제는 해당 사용자의 항목만
+	deleted, err := s.DeleteHistory(ctx, user.ID, "video000001")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	deleted, err = s.DeleteHistory(ctx, user.ID, "video000001")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	cleared, err := s.ClearHistory(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, cleared)
+	empty, err := s.GetHistory(ctx, user.ID, storage.HistoryFilter{}, nil, 10)
+	require.NoError(t, err)
+	assert.Zero(t, empty.Total)
+
+	others, err := s.GetHistory(ctx, other.ID, storage.HistoryFilter{}, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, others.Items, 1)
+	assert.Equal(t, rerun.JobID.String(), others.Items[0].JobID)
+}
+
+func testBackfillHistory(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	user, err := s.UpsertUser(ctx, "senior@example.com", "Kim", "", "google-1")
+	require.NoError(t, err)
+	other, err := s.UpsertUser(ctx, "other@example.com", "Lee", "", "google-2")
+	require.NoError(t, err)
+
+	add := func(userID int64, videoID, title, thumbnailURL string) {
+		job, err := s.CreateJob(ctx, videoID, 0)
+		require.NoError(t, err)
+		require.NoError(t, s.AddHistory(ctx, userID, job.JobID, videoID, title, thumbnailURL))
+	}
+	add(user.ID, "video000001", "Processing...", "")
+	add(other.ID, "video000001", "", "")
+	add(user.ID, "video000002", "Renamed by user", "https://thumb/original")
+	add(user.ID, "video000003", "Processing...", "")
+
+	require.NoError(t, s.BackfillHistory(ctx, "video000001", "Giveaway", "https://thumb/1"))
+	// 이미 알려진 제목/썸네일은 덮어쓰지 않음
+	require.NoError(t, s.BackfillHistory(ctx, "video000002", "Giveaway", "https://thumb/2"))
+	// 빈 값으로는 채우지 않음
+	require.NoError(t, s.BackfillHistory(ctx, "video000003", "", ""))
+
+	byVideo := map[string]*storage.AnalysisHistory{}
+	page, err := s.GetHistory(ctx, user.ID, storage.HistoryFilter{}, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 3)
+	for _, item := range page.Items {
+		byVideo[item.VideoID] = item
+	}
+	assert.Equal(t, "Giveaway", byVideo["video000001"].VideoTitle)
+	assert.Equal(t, "https://thumb/1", byVideo["video000001"].ThumbnailURL)
+	assert.Equal(t, "Renamed by user", byVideo["video000002"].VideoTitle)
+	assert.Equal(t, "https://thumb/original", byVideo["video000002"].ThumbnailURL)
+	assert.Equal(t, "Processing...", byVideo["video000003"].VideoTitle)
+	assert.Empty(t, byVideo["video000003"].ThumbnailURL)
+
+	// 같은 영상을 분석한 모든 사용자의 기록을 채움
+	others, err := s.GetHistory(ctx, other.ID, storage.HistoryFilter{}, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, others.Items, 1)
+	assert.Equal(t, "Giveaway", others.Items[0].VideoTitle)
+	assert.Equal(t, "https://thumb/1", others.Items[0].ThumbnailURL)
+}
+
+func testRetention(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	past, future := time.Now().Add(-24*time.Hour), time.Now().Add(24*time.Hour)
+
+	createVideo(t, s, "video000001", "Old")
+	for _, lang := range []string{"ko", "en", "ja"} {
+		require.NoError(t, s.SaveCaptions(ctx, "video000001", lang, "text"))
+	}
+	require.NoError(t, s.SaveComments(ctx, "video000001", []storage.Comment{
+		{Author: "a", Text: "first", Rank: 1},
+		{Author: "b", Text: "second", Rank: 2},
+	}))
+
+	// 보관 기간 안의 데이터는 남김
+	n, err := s.PurgeCaptions(ctx, past, 10)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	n, err = s.PurgeComments(ctx, past, 10)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// limit만큼씩 나눠서 삭제
+	n, err = s.PurgeCaptions(ctx, future, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	n, err = s.PurgeCaptions(ctx, future, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	n, err = s.PurgeComments(ctx, future, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	comments, err := s.GetComments(ctx, "video000001", 10)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "second", comments[0].Text)
+
+	// 업로드를 지우면 Job과 결과도 함께 삭제
+	var ids []uuid.UUID
+	for _, key := range []string{"uploads/1/a.mp4", "uploads/1/b.mp4"} {
+		u := &storage.Upload{UploadID: uuid.New(), S3Bucket: "bucket", S3Key: key}
+		require.NoError(t, s.CreateUpload(ctx, u))
+		ids = append(ids, u.UploadID)
+	}
+	job, err := s.CreateUploadJob(ctx, ids[0])
+	require.NoError(t, err)
+	require.NoError(t, s.SaveResult(ctx, job.JobID, 40, nil, nil))
+
+	none, err := s.ListExpiredUploads(ctx, past, 10)
+	require.NoError(t, err)
+	assert.Empty(t, none)
+	expired, err := s.ListExpiredUploads(ctx, future, 1)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, ids[0], expired[0].UploadID)
+	assert.Equal(t, "uploads/1/a.mp4", expired[0].S3Key)
+
+	n, err = s.DeleteUploads(ctx, ids)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	_, err = s.GetJob(ctx, job.JobID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = s.GetLatestResult(ctx, ids[0].String())
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	n, err = s.DeleteUploads(ctx, nil)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+// testFamilyAlerts: 보호자가 있는 어르신의 Job 결과만 어르신의 위험 알림으로 기록
+func testFamilyAlerts(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	guardian, err := s.UpsertUser(ctx, "son@example.com", "Son", "", "")
+	require.NoError(t, err)
+	senior, err := s.UpsertUser(ctx, "mom@example.com", "Mom", "", "")
+	require.NoError(t, err)
+	other, err := s.UpsertUser(ctx, "other@example.com", "Other", "", "")
+	require.NoError(t, err)
+	createVideo(t, s, "video000001", "Scam")
+	createVideo(t, s, "video000002", "Giveaway")
+
+	// 어르신이 구독한 채널의 새 영상으로 먼저 알림을 받은 경우
+	w := &storage.ChannelWatch{UserID: senior.ID, ChannelID: "UCchannel", ChannelTitle: "Channel", UploadsPlaylistID: "UUchannel"}
+	require.NoError(t, s.AddWatch(ctx, w))
+	watchJob, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	n, err := s.CreateWatchAlerts(ctx, "UCchannel", "video000001", "Scam", watchJob.JobID, 30, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	// 연결 전(대기 중)에는 알림 없음
+	link, err := s.RequestFamilyLink(ctx, guardian.ID, senior.ID, storage.FamilyRoleGuardian)
+	require.NoError(t, err)
+	job, err := s.CreateJob(ctx, "video000001", senior.ID)
+	require.NoError(t, err)
+	created, err := s.CreateFamilyAlert(ctx, job.JobID, 20)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	// 채널 알림이 있어도 가족 알림은 따로 기록
+	ok, err := s.SetFamilyLinkStatus(ctx, link.ID, storage.FamilyActive, storage.FamilyPending)
+	require.NoError(t, err)
+	require.True(t, ok)
+	created, err = s.CreateFamilyAlert(ctx, job.JobID, 20)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	// 보호자가 없는 사용자, 비회원 Job은 알림 없음
+	for _, ownerID := range []int64{other.ID, 0} {
+		j, err := s.CreateJob(ctx, "video000002", ownerID)
+		require.NoError(t, err)
+		created, err = s.CreateFamilyAlert(ctx, j.JobID, 20)
+		require.NoError(t, err)
+		assert.False(t, created)
+	}
+
+	alerts, err := s.ListWatchAlerts(ctx, senior.ID, false, 10)
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	byKind := map[string]storage.WatchAlert{}
+	for _, a := range alerts {
+		byKind[a.Kind] = a
+	}
+	watch, family := byKind[storage.AlertKindWatch], byKind[storage.AlertKindFamily]
+	assert.Equal(t, watchJob.JobID, watch.JobID.UUID)
+	assert.Equal(t, 30, watch.SafetyScore)
+	assert.Equal(t, "video000001", family.VideoID)
+	assert.Equal(t, "Scam", family.VideoTitle)
+	assert.Equal(t, "UCchannel", family.ChannelID)
+	assert.Equal(t, job.JobID, family.JobID.UUID)
+	assert.Equal(t, 20, family.SafetyScore)
+	assert.Equal(t, storage.VerdictDanger, family.Verdict)
+
+	// 같은 영상을 다시 검사하면 가족 알림을 새 결과로 갱신하고 다시 읽지 않음으로
+	updated, err := s.MarkWatchAlertsRead(ctx, senior.ID, []int64{family.AlertID})
+	require.NoError(t, err)
+	require.Equal(t, 1, updated)
+	again, err := s.CreateJob(ctx, "video000001", senior.ID)
+	require.NoError(t, err)
+	created, err = s.CreateFamilyAlert(ctx, again.JobID, 50)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	alerts, err = s.ListWatchAlerts(ctx, senior.ID, true, 10)
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	byKind = map[string]storage.WatchAlert{}
+	for _, a := range alerts {
+		byKind[a.Kind] = a
+	}
+	refreshed := byKind[storage.AlertKindFamily]
+	assert.Equal(t, family.AlertID, refreshed.AlertID)
+	assert.Equal(t, again.JobID, refreshed.JobID.UUID)
+	assert.Equal(t, 50, refreshed.SafetyScore)
+	assert.Equal(t, storage.VerdictCaution, refreshed.Verdict)
+	assert.False(t, refreshed.CreatedAt.Before(family.CreatedAt))
+	assert.Equal(t, 30, byKind[storage.AlertKindWatch].SafetyScore)
+}
+
+func testWatchlist(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	alice, err := s.UpsertUser(ctx, "alice@example.com", "Alice", "", "google-1")
+	require.NoError(t, err)
+	bob, err := s.UpsertUser(ctx, "bob@example.com", "Bob", "", "google-2")
+	require.NoError(t, err)
+
+	for _, userID := range []int64{alice.ID, bob.ID} {
+		w := &storage.ChannelWatch{UserID: userID, ChannelID: "UCchannel", ChannelTitle: "Channel", UploadsPlaylistID: "UUchannel"}
+		require.NoError(t, s.AddWatch(ctx, w))
+		assert.NotZero(t, w.WatchID)
+	}
+
+	watches, err := s.ListWatches(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, watches, 1)
+	assert.Equal(t, "Channel", watches[0].ChannelTitle)
+
+	channels, err := s.ListWatchedChannels(ctx)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	assert.Equal(t, "UUchannel", channels[0].UploadsPlaylistID)
+
+	// 구독 이후 올라온 영상만 알림 대상, 같은 영상은 한 번만
+	createVideo(t, s, "video000001", "Upload")
+	job, err := s.CreateJob(ctx, "video000001", 0)
+	require.NoError(t, err)
+	publishedAt := time.Now().Add(time.Hour)
+
+	created, err := s.CreateWatchAlerts(ctx, "UCchannel", "video000001", "Upload", job.JobID, 25, publishedAt)
+	require.NoError(t, err)
+	assert.Equal(t, 2, created)
+	created, err = s.CreateWatchAlerts(ctx, "UCchannel", "video000001", "Upload", job.JobID, 25, publishedAt)
+	require.NoError(t, err)
+	assert.Zero(t, created)
+
+	require.NoError(t, s.AdvanceWatches(ctx, "UCchannel", publishedAt))
+	created, err = s.CreateWatchAlerts(ctx, "UCchannel", "video000002", "Older", job.JobID, 25, publishedAt.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, created)
+
+	alerts, err := s.ListWatchAlerts(ctx, alice.ID, true, 10)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, storage.VerdictDanger, alerts[0].Verdict)
+
+	// 다른 사용자의 알림은 읽음 처리되지 않음
+	updated, err := s.MarkWatchAlertsRead(ctx, bob.ID, []int64{alerts[0].AlertID})
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+	updated, err = s.MarkWatchAlertsRead(ctx, alice.ID, []int64{alerts[0].AlertID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+
+	unread, err := s.ListWatchAlerts(ctx, alice.ID, true, 10)
+	require.NoError(t, err)
+	assert.Empty(t, unread)
+
+	removed, err := s.RemoveWatch(ctx, alice.ID, "UCchannel")
+	require.NoError(t, err)
+	assert.True(t, removed)
+	removed, err = s.RemoveWatch(ctx, alice.ID, "UCchannel")
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func testTransactions(t *testing.T, s storage.Store) {
+	ctx := context.Background()
+	createVideo(t, s, "video000001", "Video")
+
+	// 에러를 반환하면 트랜잭션 안의 쓰기는 모두 취소
+	var rolledBack uuid.UUID
+	errBoom := errors.New("boom")
+	err := s.WithTx(ctx, func(tx storage.Store) error {
+		job, err := tx.CreateJob(ctx, "video000001", 0)
+		if err != nil {
+			return err
+		}
+		rolledBack = job.JobID
+		return errBoom
+	})
+	assert.ErrorIs(t, err, errBoom)
+	_, err = s.GetJob(ctx, rolledBack)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 중첩된 WithTx는 바깥 트랜잭션에 합류
+	var committed uuid.UUID
+	err = s.WithTx(ctx, func(tx storage.Store) error {
+		job, err := tx.CreateJob(ctx, "video000001", 0)
+		if err != nil {
+			return err
+		}
+		committed = job.JobID
+		return tx.WithTx(ctx, func(inner storage.Store) error {
+			return inner.UpdateJobStatus(ctx, job.JobID, storage.StatusProcessing, 5)
+		})
+	})
+	require.NoError(t, err)
+	got, err := s.GetJob(ctx, committed)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusProcessing, got.Status)
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const subscriptionColumns = `user_id, plan_type, status, start_date, end_date, COALESCE(billing_ref, ''), canceled_at`
+
+func (s *PostgresStore) GetSubscription(ctx context.Context, userID int64) (*Subscription, error) {
+	sub := &Subscription{}
+	err := s.q.QueryRowContext(ctx, `SELECT `+subscriptionColumns+` FROM subscriptions WHERE user_id = $1`, userID).Scan(
+		&sub.UserID, &sub.PlanType, &sub.Status, &sub.StartDate, &sub.EndDate, &sub.BillingRef, &sub.CanceledAt,
+	)
+	if err == sql.ErrNoRows {
+		// 없으면 Free 리턴
+		return &Subscription{UserID: userID, PlanType: "free", Status: SubscriptionActive}, nil
+	}
+	return sub, err
+}
+
+func (s *PostgresStore) SaveSubscription(ctx context.Context, sub *Subscription) error {
+	_, err := s.q.ExecContext(ctx, `
+		INSERT INTO subscriptions (user_id, plan_type, status, start_date, end_date, billing_ref, canceled_at, updated_at)
+		VALUES ($1, $2, $3, $4::timestamp, $5::timestamp, NULLIF($6, ''), $7::timestamp, now())
+		ON CONFLICT (user_id) DO UPDATE SET
+			plan_type = EXCLUDED.plan_type,
+			status = EXCLUDED.status,
+			start_date = EXCLUDED.start_date,
+			end_date = EXCLUDED.end_date,
+			billing_ref = EXCLUDED.billing_ref,
+			canceled_at = EXCLUDED.canceled_at,
+			updated_at = now()
+	`, sub.UserID, sub.PlanType, sub.Status, pgNullTimestamp(sub.StartDate), pgNullTimestamp(sub.EndDate),
+		sub.BillingRef, pgNullTimestamp(sub.CanceledAt))
+	return err
+}
+
+// ExpireSubscriptions keeps end_date so the profile shows when the plan ended.
+func (s *PostgresStore) ExpireSubscriptions(ctx context.Context, now time.Time, limit int) (int, error) {
+	res, err := s.q.ExecContext(ctx, `
+		UPDATE subscriptions SET plan_type = 'free', status = $3, updated_at = now()
+		WHERE user_id IN (
+			SELECT user_id FROM subscriptions
+			WHERE plan_type <> 'free' AND end_date <= $1::timestamp
+			ORDER BY end_date
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)`, now.UTC().Format(pgTimestamp), limit, SubscriptionExpired)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (s *PostgresStore) RecordBillingEvent(ctx context.Context, e *BillingEvent) (bool, error) {
+	err := s.q.QueryRowContext(ctx, `
+		INSERT INTO billing_events (event_id, event_type, user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id) DO NOTHING
+		RETURNING received_at
+	`, e.EventID, e.Type, e.UserID).Scan(&e.ReceivedAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// pgNullTimestamp formats t for a TIMESTAMP (without time zone) column,
+// which stores UTC like the other timestamps.
+func pgNullTimestamp(t sql.NullTime) interface{} {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time.UTC().Format(pgTimestamp)
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+func (s *PostgresStore) UseDailyUsage(ctx context.Context, userID int64, feature string, day time.Time, n, limit int) (int, bool, error) {
+	// 상한을 넘게 되는 요청은 갱신되는 행이 없음 (동시 요청도 상한을 넘지 않음)
+	var used int
+	err := s.q.QueryRowContext(ctx, `
+		INSERT INTO usage_counters (user_id, feature, day, used)
+		SELECT $1, $2, $3, $4 WHERE $5 <= 0 OR $4 <= $5
+		ON CONFLICT (user_id, feature, day) DO UPDATE SET used = usage_counters.used + EXCLUDED.used
+		WHERE $5 <= 0 OR usage_counters.used + EXCLUDED.used <= $5
+		RETURNING used
+	`, userID, feature, day.UTC().Format("2006-01-02"), n, limit).Scan(&used)
+	if err == sql.ErrNoRows {
+		used, err = s.GetDailyUsage(ctx, userID, feature, day)
+		return used, false, err
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return used, true, nil
+}
+
+func (s *PostgresStore) GetDailyUsage(ctx context.Context, userID int64, feature string, day time.Time) (int, error) {
+	var used int
+	err := s.q.QueryRowContext(ctx,
+		`SELECT used FROM usage_counters WHERE user_id = $1 AND feature = $2 AND day = $3`,
+		userID, feature, day.UTC().Format("2006-01-02")).Scan(&used)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return used, err
+}
+
+func (s *PostgresStore) UseAnonymousUsage(ctx context.Context, clientIP, feature string, day time.Time, n, limit int) (int, bool, error) {
+	var used int
+	err := s.q.QueryRowContext(ctx, `
+		INSERT INTO anonymous_usage (client_ip, feature, day, used)
+		SELECT $1, $2, $3, $4 WHERE $5 <= 0 OR $4 <= $5
+		ON CONFLICT (client_ip, feature, day) DO UPDATE SET used = anonymous_usage.used + EXCLUDED.used
+		WHERE $5 <= 0 OR anonymous_usage.used + EXCLUDED.used <= $5
+		RETURNING used
+	`, clientIP, feature, day.UTC().Format("2006-01-02"), n, limit).Scan(&used)
+	if err == sql.ErrNoRows {
+		err = s.q.QueryRowContext(ctx,
+			`SELECT used FROM anonymous_usage WHERE client_ip = $1 AND feature = $2 AND day = $3`,
+			clientIP, feature, day.UTC().Format("2006-01-02")).Scan(&used)
+		if err == sql.ErrNoRows {
+			return 0, false, nil
+		}
+		return used, false, err
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return used, true, nil
+}
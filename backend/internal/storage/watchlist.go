@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// AddWatch subscribes a user to a channel. Re-adding an existing watch
+// refreshes its title and playlist but keeps the upload cursor.
+func (s *PostgresStore) AddWatch(ctx context.Context, w *ChannelWatch) error {
+	query := `
+        INSERT INTO channel_watches (user_id, channel_id, channel_title, uploads_playlist_id)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (user_id, channel_id) DO UPDATE SET
+            channel_title = EXCLUDED.channel_title,
+            uploads_playlist_id = EXCLUDED.uploads_playlist_id
+        RETURNING watch_id, last_upload_at, last_checked_at, created_at
+    `
+	return s.q.QueryRowContext(ctx, query, w.UserID, w.ChannelID, w.ChannelTitle, w.UploadsPlaylistID).
+		Scan(&w.WatchID, &w.LastUploadAt, &w.LastCheckedAt, &w.CreatedAt)
+}
+
+// RemoveWatch unsubscribes a user from a channel. It reports whether a watch existed.
+func (s *PostgresStore) RemoveWatch(ctx context.Context, userID int64, channelID string) (bool, error) {
+	res, err := s.q.ExecContext(ctx, `DELETE FROM channel_watches WHERE user_id = $1 AND channel_id = $2`, userID, channelID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// ListWatches returns a user's watched channels, newest first
+func (s *PostgresStore) ListWatches(ctx context.Context, userID int64) ([]ChannelWatch, error) {
+	rows, err := s.q.QueryContext(ctx, `
+        SELECT watch_id, user_id, channel_id, channel_title, uploads_playlist_id,
+               last_upload_at, last_checked_at, created_at
+        FROM channel_watches
+        WHERE user_id = $1
+        ORDER BY created_at DESC
+    `, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var watches []ChannelWatch
+	for rows.Next() {
+		var w ChannelWatch
+		if err := rows.Scan(&w.WatchID, &w.UserID, &w.ChannelID, &w.ChannelTitle, &w.UploadsPlaylistID,
+			&w.LastUploadAt, &w.LastCheckedAt, &w.CreatedAt); err != nil {
+			return nil, err
+		}
+		watches = append(watches, w)
+	}
+	return watches, rows.Err()
+}
+
+// ListWatchedChannels returns every channel with at least one watcher
+func (s *PostgresStore) ListWatchedChannels(ctx context.Context) ([]WatchedChannel, error) {
+	rows, err := s.q.QueryContext(ctx, `
+        SELECT channel_id, MAX(uploads_playlist_id), MIN(last_upload_at)
+        FROM channel_watches
+        GROUP BY channel_id
+        ORDER BY MIN(last_checked_at) ASC NULLS FIRST
+    `)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var channels []WatchedChannel
+	for rows.Next() {
+		var c WatchedChannel
+		if err := rows.Scan(&c.ChannelID, &c.UploadsPlaylistID, &c.Since); err != nil {
+			return nil, err
+		}
+		channels = append(channels, c)
+	}
+	return channels, rows.Err()
+}
+
+// AdvanceWatches moves the upload cursor of every watcher of a channel to
+// uploadedAt (never backwards) and records the check time.
+func (s *PostgresStore) AdvanceWatches(ctx context.Context, channelID string, uploadedAt time.Time) error {
+	_, err := s.q.ExecContext(ctx, `
+        UPDATE channel_watches SET
+            last_upload_at = GREATEST(last_upload_at, $2),
+            last_checked_at = CURRENT_TIMESTAMP
+        WHERE channel_id = $1
+    `, channelID, uploadedAt)
+	return err
+}
+
+// CreateWatchAlerts records an alert for every watcher of the channel that
+// had not yet seen an upload published at publishedAt. It returns the number
+// of alerts created.
+func (s *PostgresStore) CreateWatchAlerts(ctx context.Context, channelID, videoID, title string, jobID uuid.UUID, safetyScore int, publishedAt time.Time) (int, error) {
+	res, err := s.q.ExecContext(ctx, `
+        INSERT INTO watch_alerts (user_id, kind, channel_id, video_id, job_id, video_title, safety_score, verdict)
+        SELECT user_id, $8, channel_id, $2, $3, $4, $5, $6
+        FROM channel_watches
+        WHERE channel_id = $1 AND last_upload_at < $7
+        ON CONFLICT (user_id, kind, video_id) DO NOTHING
+    `, channelID, videoID, jobID, title, safetyScore, VerdictForScore(safetyScore), publishedAt, AlertKindWatch)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+// CreateFamilyAlert records an alert for the job's owner if a guardian
+// watches over them. Upload jobs have no video and are skipped. Re-checking
+// a video refreshes its alert and marks it unread again.
+func (s *PostgresStore) CreateFamilyAlert(ctx context.Context, jobID uuid.UUID, safetyScore int) (bool, error) {
+	res, err := s.q.ExecContext(ctx, `
+        INSERT INTO watch_alerts (user_id, kind, channel_id, video_id, job_id, video_title, safety_score, verdict)
+        SELECT aj.user_id, $6, COALESCE(v.channel_id, ''), aj.video_id, aj.job_id, COALESCE(v.title, ''), $2, $3
+        FROM analysis_jobs aj
+        JOIN videos v ON v.video_id = aj.video_id
+        WHERE aj.job_id = $1 AND EXISTS (
+            SELECT 1 FROM family_links fl
+            WHERE fl.senior_id = aj.user_id AND fl.status = $4 AND fl.role = $5
+        )
+        ON CONFLICT (user_id, kind, video_id) DO UPDATE SET
+            job_id = EXCLUDED.job_id,
+            video_title = EXCLUDED.video_title,
+            safety_score = EXCLUDED.safety_score,
+            verdict = EXCLUDED.verdict,
+            read_at = NULL,
+            created_at = CURRENT_TIMESTAMP
+    `, jobID, safetyScore, VerdictForScore(safetyScore), FamilyActive, FamilyRoleGuardian, AlertKindFamily)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// ListWatchAlerts returns a user's alerts, newest first
+func (s *PostgresStore) ListWatchAlerts(ctx context.Context, userID int64, unreadOnly bool, limit int) ([]WatchAlert, error) {
+	rows, err := s.q.QueryContext(ctx, `
+        SELECT alert_id, user_id, kind, channel_id, video_id, job_id, video_title,
+               safety_score, verdict, read_at, created_at
+        FROM watch_alerts
+        WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL)
+        ORDER BY created_at DESC
+        LIMIT $3
+    `, userID, unreadOnly, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []WatchAlert
+	for rows.Next() {
+		var a WatchAlert
+		if err := rows.Scan(&a.AlertID, &a.UserID, &a.Kind, &a.ChannelID, &a.VideoID, &a.JobID, &a.VideoTitle,
+			&a.SafetyScore, &a.Verdict, &a.ReadAt, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, a)
+	}
+	return alerts, rows.Err()
+}
+
+// MarkWatchAlertsRead marks the given alerts of a user as read
+func (s *PostgresStore) MarkWatchAlertsRead(ctx context.Context, userID int64, alertIDs []int64) (int, error) {
+	res, err := s.q.ExecContext(ctx, `
+        UPDATE watch_alerts SET read_at = CURRENT_TIMESTAMP
+        WHERE user_id = $1 AND alert_id = ANY($2) AND read_at IS NULL
+    `, userID, pq.Array(alertIDs))
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
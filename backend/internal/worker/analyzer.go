@@ -5,45 +5,80 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/gemini"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/s3"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
 	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
 )
 
 type Analyzer struct {
-	youtubeClient *youtube.Client
-	geminiClient  *gemini.Client
-	store         *storage.PostgresStore
+	sources       *source.Registry // 플랫폼별 메타데이터/자막/댓글 수집
+	youtubeClient youtubeAPI       // YouTube 전용 기능 (채널 평판 등)
+	geminiClient  contentAnalyzer
+	store         storage.Store
 	redisClient   *redis.Client // [추가] Redis 클라이언트
+	thumbMirror   *s3.Mirror    // nil이면 썸네일 미러링 비활성화
+	live          liveSettings
+	sleep         func(time.Duration) // 진행 단계 사이 연출용 대기 (테스트에서는 생략)
+}
+
+// youtubeAPI: Analyzer가 사용하는 YouTube Data API 기능 (*youtube.Client)
+type youtubeAPI interface {
+	GetMetadata(ctx context.Context, videoID string) (*youtube.VideoMetadata, error)
+	GetLiveChatMessages(ctx context.Context, liveChatID, pageToken string) (*youtube.LiveChatPage, error)
+	GetChannel(ctx context.Context, channelID string) (*youtube.ChannelInfo, error)
+	ResolveCollection(ctx context.Context, ref *youtube.CollectionRef) (*youtube.Collection, error)
+	ListPlaylistVideos(ctx context.Context, playlistID string, max int) ([]youtube.PlaylistVideo, error)
+}
+
+// contentAnalyzer: 수집한 내용을 판정하는 모델 (*gemini.Client)
+type contentAnalyzer interface {
+	AnalyzeContent(ctx context.Context, req *gemini.AnalysisRequest) (*gemini.AnalysisResponse, error)
 }
 
 type ProgressEvent struct {
-	JobID    uuid.UUID `json:"job_id"`
-	Type     string    `json:"type"` // "log", "progress", "complete", "error"
-	Message  string    `json:"message"`
-	Progress int       `json:"progress"`
+	JobID       uuid.UUID `json:"job_id"`
+	Type        string    `json:"type"` // "log", "progress", "verdict", "complete", "error"
+	Message     string    `json:"message"`
+	Progress    int       `json:"progress"`
+	SafetyScore int       `json:"safety_score,omitempty"` // "verdict" 이벤트에만 설정
 }
 
 // NewAnalyzer 생성자에 redisClient 파라미터가 추가되었습니다.
-func NewAnalyzer(ytClient *youtube.Client, geminiClient *gemini.Client, store *storage.PostgresStore, rdb *redis.Client) *Analyzer {
+// thumbMirror가 nil이 아니면 썸네일을 S3에 복사해 둡니다.
+// liveCfg는 라이브 방송 모니터링 주기/종료 조건입니다.
+func NewAnalyzer(sources *source.Registry, ytClient *youtube.Client, geminiClient *gemini.Client, store storage.Store, rdb *redis.Client, thumbMirror *s3.Mirror, liveCfg config.LiveConfig) *Analyzer {
 	return &Analyzer{
+		sources:       sources,
 		youtubeClient: ytClient,
 		geminiClient:  geminiClient,
 		store:         store,
 		redisClient:   rdb,
+		thumbMirror:   thumbMirror,
+		live:          newLiveSettings(liveCfg),
+		sleep:         time.Sleep,
 	}
 }
 
 func (a *Analyzer) Analyze(ctx context.Context, jobID uuid.UUID, videoURL string, analyzeComments bool, commentCount int) {
 	// 백그라운드 작업 시작
-	go a.runAnalysis(context.Background(), jobID, videoURL, analyzeComments, commentCount)
+	go a.runAnalysis(context.Background(), jobID, videoURL, analyzeComments, commentCount, true)
+}
+
+// AnalyzeSync: 분석이 끝날 때까지 기다린다 (스케줄러용, 라이브 모니터링 없음)
+func (a *Analyzer) AnalyzeSync(ctx context.Context, jobID uuid.UUID, videoURL string, analyzeComments bool, commentCount int) {
+	a.runAnalysis(ctx, jobID, videoURL, analyzeComments, commentCount, false)
 }
 
-func (a *Analyzer) runAnalysis(ctx context.Context, jobID uuid.UUID, videoURL string, analyzeComments bool, commentCount int) {
+// runAnalysis: 단일 영상 분석. monitor가 true이고 영상이 라이브 방송이면 분석 후 모니터링 모드로 전환한다.
+func (a *Analyzer) runAnalysis(ctx context.Context, jobID uuid.UUID, videoURL string, analyzeComments bool, commentCount int, monitor bool) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Printf("Panic in analysis: %v", r)
@@ -51,63 +86,90 @@ func (a *Analyzer) runAnalysis(ctx context.Context, jobID uuid.UUID, videoURL st
 		}
 	}()
 
+	// 시작 전에 취소된 Job은 Processing으로 되돌리지 않음
+	if a.stopIfCancelled(ctx, jobID) {
+		return
+	}
+
 	// DB: Job 상태를 Processing으로 업데이트
-	if err := a.store.UpdateJobStatus(jobID, storage.StatusProcessing, 0); err != nil {
+	if err := a.store.UpdateJobStatus(ctx, jobID, storage.StatusProcessing, 0); err != nil {
 		log.Printf("Failed to update job status: %v", err)
 		return
 	}
 
 	a.sendProgress(jobID, "log", "Initializing monitoring session...", 5)
-	time.Sleep(500 * time.Millisecond)
+	a.sleep(500 * time.Millisecond)
 
-	// 1. Video ID 추출
+	// 1. 플랫폼 판별 및 Video ID 추출
 	a.sendProgress(jobID, "log", "Connecting to video stream...", 10)
-	videoID, err := youtube.ExtractVideoID(videoURL)
+	provider, ref, err := a.sources.Resolve(videoURL)
 	if err != nil {
-		a.handleError(jobID, "Invalid YouTube URL", err)
+		a.handleError(jobID, "Unsupported video URL", err)
 		return
 	}
-	time.Sleep(800 * time.Millisecond)
+	videoID := ref.Key()
+	a.sleep(800 * time.Millisecond)
 
 	// 2. 메타데이터 조회
+	if a.stopIfCancelled(ctx, jobID) {
+		return
+	}
 	a.sendProgress(jobID, "log", "Stream connection established", 15)
 	a.sendProgress(jobID, "log", "Extracting video metadata...", 20)
-	metadata, err := a.youtubeClient.GetMetadata(ctx, videoID)
+	metadata, err := provider.GetMetadata(ctx, *ref)
 	if err != nil {
 		a.handleError(jobID, "Failed to get video metadata", err)
 		return
 	}
 
+	thumbnail := metadata.ThumbnailURL
+	if thumbnail == "" {
+		thumbnail = ref.ThumbnailURL
+	}
+
 	// DB: 비디오 정보 저장
 	video := &storage.Video{
-		VideoID:     metadata.VideoID,
-		Title:       metadata.Title,
-		Description: metadata.Description,
-		Channel:     metadata.Channel,
-		Duration:    metadata.Duration,
-		ViewCount:   metadata.ViewCount,
-		PublishedAt: metadata.PublishedAt,
+		VideoID:      videoID,
+		Platform:     ref.Platform,
+		Title:        metadata.Title,
+		Description:  metadata.Description,
+		Channel:      metadata.Channel,
+		ChannelID:    metadata.ChannelID,
+		Duration:     metadata.Duration,
+		ViewCount:    metadata.ViewCount,
+		PublishedAt:  metadata.PublishedAt,
+		ThumbnailURL: a.thumbnailURL(ctx, videoID, thumbnail),
 	}
-	if err := a.store.CreateVideo(video); err != nil {
+	if err := a.store.CreateVideo(ctx, video); err != nil {
 		log.Printf("Failed to save video: %v", err)
 	}
-	time.Sleep(1 * time.Second)
+	// 메타데이터 확인 전에 기록된 History 행의 제목/썸네일 채우기
+	if err := a.store.BackfillHistory(ctx, videoID, video.Title, video.ThumbnailURL); err != nil {
+		log.Printf("Failed to backfill history: %v", err)
+	}
+	a.sleep(1 * time.Second)
+
+	// 2-1. 채널 평판 조회 (신규 채널의 유명인/은행 사칭 여부) - 현재 YouTube만 지원
+	var channelData *gemini.ChannelData
+	if ref.Platform == source.PlatformYouTube {
+		channelData = a.checkChannel(ctx, jobID, metadata.ChannelID)
+	}
 
 	// 3. 자막 다운로드
 	a.sendProgress(jobID, "log", "Extracting video frames for analysis...", 30)
-	time.Sleep(1500 * time.Millisecond)
+	a.sleep(1500 * time.Millisecond)
 
 	a.sendProgress(jobID, "log", "Downloading captions...", 40)
-	captions, err := a.youtubeClient.GetCaptions(ctx, videoID)
+	captions, err := provider.GetTranscript(ctx, *ref)
 	if err != nil {
 		log.Printf("Warning: Failed to get captions: %v", err)
 		captions = "" // 자막 없어도 계속 진행
 	} else {
-		if err := a.store.SaveCaptions(videoID, "en", captions); err != nil {
+		if err := a.store.SaveCaptions(ctx, videoID, "en", captions); err != nil {
 			log.Printf("Failed to save captions: %v", err)
 		}
 	}
-	time.Sleep(2 * time.Second)
+	a.sleep(2 * time.Second)
 
 	a.sendProgress(jobID, "log", "Captions extracted successfully", 50)
 
@@ -115,11 +177,11 @@ func (a *Analyzer) runAnalysis(ctx context.Context, jobID uuid.UUID, videoURL st
 	var comments []storage.Comment
 	if analyzeComments {
 		a.sendProgress(jobID, "log", "Collecting top comments...", 55)
-		ytComments, err := a.youtubeClient.GetTopComments(ctx, videoID, commentCount)
+		sourceComments, err := provider.GetComments(ctx, *ref, commentCount)
 		if err != nil {
 			log.Printf("Warning: Failed to get comments: %v", err)
 		} else {
-			for _, c := range ytComments {
+			for _, c := range sourceComments {
 				comments = append(comments, storage.Comment{
 					VideoID: videoID,
 					Author:  c.Author,
@@ -128,16 +190,19 @@ func (a *Analyzer) runAnalysis(ctx context.Context, jobID uuid.UUID, videoURL st
 					Rank:    c.Rank,
 				})
 			}
-			if err := a.store.SaveComments(videoID, comments); err != nil {
+			if err := a.store.SaveComments(ctx, videoID, comments); err != nil {
 				log.Printf("Failed to save comments: %v", err)
 			}
 		}
-		time.Sleep(1 * time.Second)
+		a.sleep(1 * time.Second)
 	}
 
 	// 5. Gemini 분석 요청
+	if a.stopIfCancelled(ctx, jobID) {
+		return
+	}
 	a.sendProgress(jobID, "log", "Sending frames to Gemini AI engine...", 60)
-	time.Sleep(1 * time.Second)
+	a.sleep(1 * time.Second)
 
 	a.sendProgress(jobID, "log", "Analyzing content with Gemini Vision...", 70)
 
@@ -146,6 +211,7 @@ func (a *Analyzer) runAnalysis(ctx context.Context, jobID uuid.UUID, videoURL st
 		Description: metadata.Description,
 		Channel:     metadata.Channel,
 		Captions:    captions,
+		ChannelInfo: channelData,
 	}
 
 	for _, c := range comments {
@@ -161,40 +227,153 @@ func (a *Analyzer) runAnalysis(ctx context.Context, jobID uuid.UUID, videoURL st
 		a.handleError(jobID, "Failed to analyze content", err)
 		return
 	}
-	time.Sleep(2500 * time.Millisecond)
+	a.sleep(2500 * time.Millisecond)
+
+	// 분석 도중 취소된 Job은 결과를 저장하지 않음
+	if a.stopIfCancelled(ctx, jobID) {
+		return
+	}
 
 	// 6. 결과 저장
-	a.sendProgress(jobID, "log", "Content analysis complete — Status: Safe ✓", 90)
-	if err := a.store.SaveResult(jobID, result.SafetyScore, result.Concerns, result); err != nil {
+	a.sendProgress(jobID, "log", fmt.Sprintf("Content analysis complete — safety score %d (%s)",
+		result.SafetyScore, storage.VerdictForScore(result.SafetyScore)), 90)
+	if err := a.store.SaveResult(ctx, jobID, result.SafetyScore, result.Concerns, result); err != nil {
 		a.handleError(jobID, "Failed to save result", err)
 		return
 	}
-	time.Sleep(1800 * time.Millisecond)
+	a.alertGuardians(ctx, jobID, result.SafetyScore)
+	a.sleep(1800 * time.Millisecond)
+
+	// 7. 라이브 방송이면 모니터링 모드로 전환 (방송 종료/취소/시청자 없음까지 주기적 재분석)
+	if monitor && metadata.Live && ref.Platform == source.PlatformYouTube {
+		a.monitorLive(ctx, jobID, ref.ID, geminiReq, result)
+		return
+	}
 
-	// 7. 완료 처리
-	a.sendProgress(jobID, "log", "Monitoring active — next scan in 30s", 95)
-	if err := a.store.UpdateJobStatus(jobID, storage.StatusCompleted, 100); err != nil {
+	// 8. 완료 처리
+	if err := a.store.UpdateJobStatus(ctx, jobID, storage.StatusCompleted, 100); err != nil {
 		log.Printf("Failed to update job status: %v", err)
 	}
-	time.Sleep(1 * time.Second)
+	a.sleep(1 * time.Second)
 
 	finalMessage := fmt.Sprintf("%s\n\nConcerns: %v", result.Reasoning, result.Concerns)
 	a.sendProgress(jobID, "complete", finalMessage, 100)
 }
 
+// isCancelled: CancelAnalysis로 취소된 Job인지 확인
+func (a *Analyzer) isCancelled(ctx context.Context, jobID uuid.UUID) bool {
+	job, err := a.store.GetJob(ctx, jobID)
+	if err != nil {
+		return false
+	}
+	return job.Status == storage.StatusCancelled
+}
+
+// stopIfCancelled: 취소된 Job이면 구독자에게 알리고 true를 반환 (상태는 cancelled로 유지)
+func (a *Analyzer) stopIfCancelled(ctx context.Context, jobID uuid.UUID) bool {
+	if !a.isCancelled(ctx, jobID) {
+		return false
+	}
+	a.sendProgress(jobID, "complete", "Analysis cancelled", 100)
+	return true
+}
+
+// alertGuardians: 위험/주의 판정이면 Job을 요청한 어르신의 위험 알림에 추가 (연결된 보호자가 조회)
+func (a *Analyzer) alertGuardians(ctx context.Context, jobID uuid.UUID, safetyScore int) {
+	if storage.VerdictForScore(safetyScore) == storage.VerdictSafe {
+		return
+	}
+	created, err := a.store.CreateFamilyAlert(ctx, jobID, safetyScore)
+	if err != nil {
+		log.Printf("Failed to create family alert for job %s: %v", jobID, err)
+		return
+	}
+	if created {
+		log.Printf("Family alert for job %s (score %d)", jobID, safetyScore)
+	}
+}
+
+// thumbnailURL: 미러링이 켜져 있으면 썸네일을 S3에 복사하고 공개(CDN) URL을 반환한다.
+func (a *Analyzer) thumbnailURL(ctx context.Context, videoID, thumbnail string) string {
+	if a.thumbMirror == nil || thumbnail == "" {
+		return thumbnail
+	}
+
+	// "tiktok:123" 같은 키는 S3 경로로 변환 (thumbnails/tiktok/123.jpg)
+	key := fmt.Sprintf("thumbnails/%s.jpg", strings.ReplaceAll(videoID, ":", "/"))
+	mirrored, err := a.thumbMirror.MirrorURL(ctx, thumbnail, key)
+	if err != nil {
+		log.Printf("Warning: Failed to mirror thumbnail for %s: %v", videoID, err)
+		return thumbnail
+	}
+	return mirrored
+}
+
+// checkChannel: 채널 정보를 조회/저장하고 Gemini에 넘길 평판 신호를 만든다.
+// 채널 조회 실패는 분석을 중단시키지 않는다 (nil 반환).
+func (a *Analyzer) checkChannel(ctx context.Context, jobID uuid.UUID, channelID string) *gemini.ChannelData {
+	if channelID == "" {
+		return nil
+	}
+
+	a.sendProgress(jobID, "log", "Checking channel reputation...", 25)
+	channel, err := a.youtubeClient.GetChannel(ctx, channelID)
+	if err != nil {
+		log.Printf("Warning: Failed to get channel %s: %v", channelID, err)
+		return nil
+	}
+
+	if err := a.store.UpsertChannel(ctx, &storage.Channel{
+		ChannelID:             channel.ChannelID,
+		Title:                 channel.Title,
+		CustomURL:             channel.CustomURL,
+		Country:               channel.Country,
+		SubscriberCount:       channel.SubscriberCount,
+		VideoCount:            channel.VideoCount,
+		HiddenSubscriberCount: channel.HiddenSubscriberCount,
+		Verified:              channel.Verified,
+		ChannelCreatedAt:      channel.CreatedAt,
+	}); err != nil {
+		log.Printf("Failed to save channel: %v", err)
+	}
+
+	assessment := youtube.AssessChannel(channel, time.Now())
+	if assessment.ImpersonatedName != "" && assessment.NewChannel {
+		a.sendProgress(jobID, "log", fmt.Sprintf("Warning: new channel using the name '%s'", assessment.ImpersonatedName), 27)
+	}
+
+	return &gemini.ChannelData{
+		CreatedAt:       channel.CreatedAt,
+		SubscriberCount: channel.SubscriberCount,
+		VideoCount:      channel.VideoCount,
+		Country:         channel.Country,
+		Verified:        channel.Verified,
+		Signals:         assessment.Signals,
+	}
+}
+
 func (a *Analyzer) sendProgress(jobID uuid.UUID, eventType, message string, progress int) {
-	event := ProgressEvent{
+	a.publishProgress(ProgressEvent{
 		JobID:    jobID,
 		Type:     eventType,
 		Message:  message,
 		Progress: progress,
+	})
+
+	// DB 업데이트: 진행률만 반영 (complete/error 상태는 호출 측에서 기록)
+	if eventType == "progress" {
+		if err := a.store.UpdateJobStatus(context.Background(), jobID, storage.StatusProcessing, progress); err != nil {
+			log.Printf("Failed to update progress in DB: %v", err)
+		}
 	}
+}
 
+func (a *Analyzer) publishProgress(event ProgressEvent) {
 	// [핵심 변경] Redis Pub/Sub 발행
 	// 이 부분이 추가되어야 다른 포드(Pod)나 재접속 시에도 상태를 받을 수 있습니다.
 	if a.redisClient != nil {
 		ctx := context.Background()
-		channel := fmt.Sprintf("job-progress:%s", jobID.String())
+		channel := fmt.Sprintf("job-progress:%s", event.JobID.String())
 
 		// JSON 직렬화
 		if payload, err := json.Marshal(event); err == nil {
@@ -209,13 +388,6 @@ func (a *Analyzer) sendProgress(jobID uuid.UUID, eventType, message string, prog
 			// a.redisClient.Set(ctx, key, payload, 1*time.Hour)
 		}
 	}
-
-	// DB 업데이트 (기존 로직 유지)
-	if eventType != "log" {
-		if err := a.store.UpdateJobStatus(jobID, storage.StatusProcessing, progress); err != nil {
-			log.Printf("Failed to update progress in DB: %v", err)
-		}
-	}
 }
 
 func (a *Analyzer) handleError(jobID uuid.UUID, message string, err error) {
@@ -224,30 +396,37 @@ func (a *Analyzer) handleError(jobID uuid.UUID, message string, err error) {
 
 	a.sendProgress(jobID, "error", message, 0)
 
-	if err := a.store.UpdateJobError(jobID, fullMsg); err != nil {
+	// 분석 컨텍스트가 취소된 뒤에도 실패 기록은 남긴다 (사용자가 취소한 Job은 cancelled 유지)
+	if a.isCancelled(context.Background(), jobID) {
+		return
+	}
+	if err := a.store.UpdateJobError(context.Background(), jobID, fullMsg); err != nil {
 		log.Printf("Failed to update job error: %v", err)
 	}
 }
 
 func (a *Analyzer) SubscribeToJob(ctx context.Context, jobIDStr string) (<-chan ProgressEvent, error) {
-    // 결과를 내보낼 채널 생성
-    ch := make(chan ProgressEvent, 10)
+    // Redis 클라이언트 방어 코드
+    if a.redisClient == nil {
+        return nil, fmt.Errorf("redis client is nil")
+    }
 
     // Redis Pub/Sub 채널명 (sendProgress와 동일한 규칙)
     redisChannel := fmt.Sprintf("job-progress:%s", jobIDStr)
 
-    // 별도 고루틴에서 구독 수행
-    go func() {
-        defer close(ch)
+    // 구독 확인까지 기다린 뒤 반환 (호출자가 이후에 읽은 Job 상태보다 늦은 이벤트는 놓치지 않음)
+    pubsub := a.redisClient.Subscribe(ctx, redisChannel)
+    if _, err := pubsub.Receive(ctx); err != nil {
+        pubsub.Close()
+        return nil, fmt.Errorf("subscribe %s: %w", redisChannel, err)
+    }
 
-        // Redis 클라이언트 방어 코드
-        if a.redisClient == nil {
-            log.Printf("Error: Redis client is nil")
-            return
-        }
+    // 결과를 내보낼 채널 생성
+    ch := make(chan ProgressEvent, 10)
 
-        // Redis 구독 시작
-        pubsub := a.redisClient.Subscribe(ctx, redisChannel)
+    // 별도 고루틴에서 메시지 전달
+    go func() {
+        defer close(ch)
         defer pubsub.Close()
 
         // Redis 메시지 채널
@@ -0,0 +1,352 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/gemini"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
+)
+
+// fakeProvider: "https://video.test/<id>" URL의 메타데이터를 메모리에서 제공
+type fakeProvider struct {
+	platform string
+	videos   map[string]*source.Metadata
+}
+
+func (p *fakeProvider) Name() string { return p.platform }
+
+func (p *fakeProvider) Parse(rawURL string) (*source.Ref, error) {
+	id, ok := strings.CutPrefix(rawURL, "https://video.test/")
+	if !ok {
+		return nil, source.ErrNotMatched
+	}
+	return &source.Ref{Platform: p.platform, ID: id, URL: rawURL}, nil
+}
+
+func (p *fakeProvider) GetMetadata(ctx context.Context, ref source.Ref) (*source.Metadata, error) {
+	m, ok := p.videos[ref.ID]
+	if !ok {
+		return nil, errors.New("video not found")
+	}
+	return m, nil
+}
+
+func (p *fakeProvider) GetTranscript(ctx context.Context, ref source.Ref) (string, error) {
+	return "", errors.New("no captions")
+}
+
+func (p *fakeProvider) GetComments(ctx context.Context, ref source.Ref, count int) ([]source.Comment, error) {
+	return nil, nil
+}
+
+// fakeGemini: 제목별로 정해 둔 점수를 반환. onAnalyze는 분석 도중의 동작(취소 등)을 흉내낸다.
+type fakeGemini struct {
+	mu        sync.Mutex
+	scores    map[string]int
+	calls     int
+	onAnalyze func(req *gemini.AnalysisRequest)
+}
+
+func (g *fakeGemini) AnalyzeContent(ctx context.Context, req *gemini.AnalysisRequest) (*gemini.AnalysisResponse, error) {
+	g.mu.Lock()
+	g.calls++
+	score, ok := g.scores[req.Title]
+	onAnalyze := g.onAnalyze
+	g.mu.Unlock()
+
+	if onAnalyze != nil {
+		onAnalyze(req)
+	}
+	if !ok {
+		return nil, errors.New("model unavailable")
+	}
+	return &gemini.AnalysisResponse{SafetyScore: score, Reasoning: "test"}, nil
+}
+
+// fakeYouTube: 라이브 방송 재샘플링용 메타데이터/채팅
+type fakeYouTube struct {
+	mu       sync.Mutex
+	metadata []*youtube.VideoMetadata // GetMetadata 호출마다 하나씩 (마지막 값 유지)
+	chat     map[string]*youtube.LiveChatPage
+}
+
+func (y *fakeYouTube) GetMetadata(ctx context.Context, videoID string) (*youtube.VideoMetadata, error) {
+	y.mu.Lock()
+	defer y.mu.Unlock()
+	if len(y.metadata) == 0 {
+		return nil, errors.New("video not found")
+	}
+	m := y.metadata[0]
+	if len(y.metadata) > 1 {
+		y.metadata = y.metadata[1:]
+	}
+	return m, nil
+}
+
+func (y *fakeYouTube) GetLiveChatMessages(ctx context.Context, liveChatID, pageToken string) (*youtube.LiveChatPage, error) {
+	y.mu.Lock()
+	defer y.mu.Unlock()
+	if page, ok := y.chat[pageToken]; ok {
+		return page, nil
+	}
+	return &youtube.LiveChatPage{NextPageToken: pageToken}, nil
+}
+
+func (y *fakeYouTube) GetChannel(ctx context.Context, channelID string) (*youtube.ChannelInfo, error) {
+	return nil, errors.New("channel not found")
+}
+
+func (y *fakeYouTube) ResolveCollection(ctx context.Context, ref *youtube.CollectionRef) (*youtube.Collection, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (y *fakeYouTube) ListPlaylistVideos(ctx context.Context, playlistID string, max int) ([]youtube.PlaylistVideo, error) {
+	return nil, errors.New("not implemented")
+}
+
+// fakeRedis: 서버 없이 PUBLISH/PUBSUB NUMSUB에 응답하는 go-redis 훅
+type fakeRedis struct {
+	mu          sync.Mutex
+	published   map[string][]string
+	subscribers map[string]int64
+	err         error // NUMSUB 실패 흉내
+}
+
+func newFakeRedis() (*redis.Client, *fakeRedis) {
+	f := &fakeRedis{published: map[string][]string{}, subscribers: map[string]int64{}}
+	rdb := redis.NewClient(&redis.Options{Addr: "fake:6379"})
+	rdb.AddHook(f)
+	return rdb, f
+}
+
+func (f *fakeRedis) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (f *fakeRedis) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+func (f *fakeRedis) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		args := cmd.Args()
+		switch {
+		case cmd.Name() == "publish":
+			channel, payload := args[1].(string), string(args[2].([]byte))
+			f.published[channel] = append(f.published[channel], payload)
+			cmd.(*redis.IntCmd).SetVal(f.subscribers[channel])
+		case cmd.Name() == "pubsub" && args[1] == "numsub":
+			if f.err != nil {
+				cmd.SetErr(f.err)
+				return f.err
+			}
+			counts := map[string]int64{}
+			for _, channel := range args[2:] {
+				counts[channel.(string)] = f.subscribers[channel.(string)]
+			}
+			cmd.(*redis.MapStringIntCmd).SetVal(counts)
+		default:
+			return next(ctx, cmd)
+		}
+		return nil
+	}
+}
+
+func (f *fakeRedis) setSubscribers(channel string, n int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.subscribers[channel] = n
+}
+
+// jobEvents: 발행된 Job 진행 이벤트
+func (f *fakeRedis) jobEvents(t *testing.T, jobID string) []ProgressEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var events []ProgressEvent
+	for _, payload := range f.published["job-progress:"+jobID] {
+		var event ProgressEvent
+		require.NoError(t, json.Unmarshal([]byte(payload), &event))
+		events = append(events, event)
+	}
+	return events
+}
+
+func lastEvent(events []ProgressEvent) ProgressEvent {
+	if len(events) == 0 {
+		return ProgressEvent{}
+	}
+	return events[len(events)-1]
+}
+
+type testAnalyzer struct {
+	*Analyzer
+	store    *storage.MemoryStore
+	provider *fakeProvider
+	gemini   *fakeGemini
+	youtube  *fakeYouTube
+	redis    *fakeRedis
+}
+
+func newTestAnalyzer(t *testing.T) *testAnalyzer {
+	store := storage.NewMemoryStore()
+	provider := &fakeProvider{platform: "test", videos: map[string]*source.Metadata{}}
+	gem := &fakeGemini{scores: map[string]int{}}
+	yt := &fakeYouTube{chat: map[string]*youtube.LiveChatPage{}}
+	rdb, fake := newFakeRedis()
+	t.Cleanup(func() { rdb.Close() })
+
+	a := NewAnalyzer(source.NewRegistry(provider), nil, nil, store, rdb, nil, config.LiveConfig{})
+	a.youtubeClient = yt
+	a.geminiClient = gem
+	a.sleep = func(time.Duration) {}
+	return &testAnalyzer{Analyzer: a, store: store, provider: provider, gemini: gem, youtube: yt, redis: fake}
+}
+
+func TestRunAnalysis(t *testing.T) {
+	ctx := context.Background()
+	ta := newTestAnalyzer(t)
+	ta.provider.videos["v1"] = &source.Metadata{Title: "Giveaway", ThumbnailURL: "https://thumb/v1"}
+	ta.gemini.scores["Giveaway"] = 25
+
+	job, err := ta.store.CreateJob(ctx, "test:v1", 0)
+	require.NoError(t, err)
+	ta.runAnalysis(ctx, job.JobID, "https://video.test/v1", true, 10, true)
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCompleted, got.Status)
+	result, err := ta.store.GetResult(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, 25, result.SafetyScore)
+	video, err := ta.store.GetVideo(ctx, "test:v1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://thumb/v1", video.ThumbnailURL)
+
+	// 진행 로그는 실제 점수/판정을 보여줌 (항상 "Safe"가 아님)
+	events := ta.redis.jobEvents(t, job.JobID.String())
+	var messages []string
+	for _, e := range events {
+		messages = append(messages, e.Message)
+	}
+	assert.Contains(t, messages, "Content analysis complete — safety score 25 (danger)")
+	assert.Equal(t, "complete", lastEvent(events).Type)
+}
+
+func TestRunAnalysisAlertsGuardian(t *testing.T) {
+	ctx := context.Background()
+	ta := newTestAnalyzer(t)
+	ta.provider.videos["v1"] = &source.Metadata{Title: "Giveaway"}
+	ta.provider.videos["v2"] = &source.Metadata{Title: "Cooking"}
+	ta.gemini.scores["Giveaway"] = 45
+	ta.gemini.scores["Cooking"] = 90
+
+	guardian, err := ta.store.UpsertUser(ctx, "son@example.com", "Son", "", "")
+	require.NoError(t, err)
+	senior, err := ta.store.UpsertUser(ctx, "mom@example.com", "Mom", "", "")
+	require.NoError(t, err)
+	link, err := ta.store.RequestFamilyLink(ctx, guardian.ID, senior.ID, storage.FamilyRoleGuardian)
+	require.NoError(t, err)
+	_, err = ta.store.SetFamilyLinkStatus(ctx, link.ID, storage.FamilyActive, storage.FamilyPending)
+	require.NoError(t, err)
+
+	// 주의 판정은 알림, 안전 판정은 알림 없음
+	for _, id := range []string{"v1", "v2"} {
+		job, err := ta.store.CreateJob(ctx, "test:"+id, senior.ID)
+		require.NoError(t, err)
+		ta.runAnalysis(ctx, job.JobID, "https://video.test/"+id, false, 0, false)
+	}
+
+	alerts, err := ta.store.ListWatchAlerts(ctx, senior.ID, false, 10)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "test:v1", alerts[0].VideoID)
+	assert.Equal(t, storage.VerdictCaution, alerts[0].Verdict)
+}
+
+func TestRunAnalysisFailure(t *testing.T) {
+	ctx := context.Background()
+	ta := newTestAnalyzer(t)
+
+	job, err := ta.store.CreateJob(ctx, "test:missing", 0)
+	require.NoError(t, err)
+	ta.runAnalysis(ctx, job.JobID, "https://video.test/missing", false, 0, false)
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusFailed, got.Status)
+	assert.Contains(t, got.ErrorMessage.String, "Failed to get video metadata")
+	assert.Equal(t, "error", lastEvent(ta.redis.jobEvents(t, job.JobID.String())).Type)
+	assert.Zero(t, ta.gemini.calls)
+}
+
+func TestRunAnalysisCancelledBeforeStart(t *testing.T) {
+	ctx := context.Background()
+	ta := newTestAnalyzer(t)
+	ta.provider.videos["v1"] = &source.Metadata{Title: "Giveaway"}
+	ta.gemini.scores["Giveaway"] = 25
+
+	job, err := ta.store.CreateJob(ctx, "test:v1", 0)
+	require.NoError(t, err)
+	require.NoError(t, ta.store.UpdateJobStatus(ctx, job.JobID, storage.StatusCancelled, 0))
+	ta.runAnalysis(ctx, job.JobID, "https://video.test/v1", false, 0, false)
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCancelled, got.Status)
+	assert.Zero(t, ta.gemini.calls)
+	_, err = ta.store.GetVideo(ctx, "test:v1")
+	assert.Error(t, err)
+	assert.Equal(t, "Analysis cancelled", lastEvent(ta.redis.jobEvents(t, job.JobID.String())).Message)
+}
+
+func TestRunAnalysisCancelledDuringAnalysis(t *testing.T) {
+	ctx := context.Background()
+	ta := newTestAnalyzer(t)
+	ta.provider.videos["v1"] = &source.Metadata{Title: "Giveaway"}
+	ta.gemini.scores["Giveaway"] = 25
+
+	job, err := ta.store.CreateJob(ctx, "test:v1", 0)
+	require.NoError(t, err)
+	ta.gemini.onAnalyze = func(*gemini.AnalysisRequest) {
+		require.NoError(t, ta.store.UpdateJobStatus(ctx, job.JobID, storage.StatusCancelled, 70))
+	}
+	ta.runAnalysis(ctx, job.JobID, "https://video.test/v1", false, 0, false)
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCancelled, got.Status)
+	_, err = ta.store.GetResult(ctx, job.JobID)
+	assert.Error(t, err)
+}
+
+func TestRunAnalysisCancelledThenFailed(t *testing.T) {
+	ctx := context.Background()
+	ta := newTestAnalyzer(t)
+	// 점수가 없으면 fakeGemini가 실패 → handleError가 cancelled를 failed로 덮어쓰면 안 됨
+	ta.provider.videos["v1"] = &source.Metadata{Title: "Unknown"}
+
+	job, err := ta.store.CreateJob(ctx, "test:v1", 0)
+	require.NoError(t, err)
+	ta.gemini.onAnalyze = func(*gemini.AnalysisRequest) {
+		require.NoError(t, ta.store.UpdateJobStatus(ctx, job.JobID, storage.StatusCancelled, 70))
+	}
+	ta.runAnalysis(ctx, job.JobID, "https://video.test/v1", false, 0, false)
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCancelled, got.Status)
+	assert.False(t, got.ErrorMessage.Valid)
+}
@@ -0,0 +1,188 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
+)
+
+// BatchJob: 배치에 속한 자식 Job 하나
+type BatchJob struct {
+	JobID    uuid.UUID
+	VideoURL string
+}
+
+// BatchProgressEvent: 배치 전체 진행 상황 (자식 Job이 끝날 때마다 발행)
+type BatchProgressEvent struct {
+	BatchID     uuid.UUID `json:"batch_id"`
+	Type        string    `json:"type"` // "progress", "complete"
+	JobID       uuid.UUID `json:"job_id"`
+	VideoID     string    `json:"video_id"`
+	SafetyScore int       `json:"safety_score"`
+	Completed   int       `json:"completed"`
+	Failed      int       `json:"failed"`
+	Total       int       `json:"total"`
+	Message     string    `json:"message"`
+}
+
+// ResolveCollection: 재생목록/채널의 제목과 영상 재생목록(채널은 업로드 목록) 조회
+func (a *Analyzer) ResolveCollection(ctx context.Context, ref *youtube.CollectionRef) (*youtube.Collection, error) {
+	return a.youtubeClient.ResolveCollection(ctx, ref)
+}
+
+// ExpandCollection: 재생목록/채널 URL을 영상 목록으로 펼친다 (최대 max개)
+func (a *Analyzer) ExpandCollection(ctx context.Context, ref *youtube.CollectionRef, max int) (*youtube.Collection, []youtube.PlaylistVideo, error) {
+	collection, err := a.ResolveCollection(ctx, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	if collection.PlaylistID == "" {
+		return collection, nil, nil
+	}
+
+	videos, err := a.youtubeClient.ListPlaylistVideos(ctx, collection.PlaylistID, max)
+	if err != nil {
+		return nil, nil, err
+	}
+	return collection, videos, nil
+}
+
+// AnalyzeBatch: 자식 Job들을 concurrency개씩 병렬로 분석 (백그라운드)
+func (a *Analyzer) AnalyzeBatch(batchID uuid.UUID, jobs []BatchJob, analyzeComments bool, commentCount, concurrency int) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	go a.runBatch(context.Background(), batchID, jobs, analyzeComments, commentCount, concurrency)
+}
+
+func (a *Analyzer) runBatch(ctx context.Context, batchID uuid.UUID, jobs []BatchJob, analyzeComments bool, commentCount, concurrency int) {
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		completed int
+		failed    int
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, job := range jobs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(job BatchJob) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			// 배치에서는 라이브 방송도 1회만 분석 (모니터링하면 배치가 끝나지 않음)
+			a.runAnalysis(ctx, job.JobID, job.VideoURL, analyzeComments, commentCount, false)
+
+			event := BatchProgressEvent{
+				BatchID: batchID,
+				Type:    "progress",
+				JobID:   job.JobID,
+				Total:   len(jobs),
+			}
+			ok := false
+			if j, err := a.store.GetJob(ctx, job.JobID); err == nil {
+				event.VideoID = j.VideoID
+				ok = j.Status == storage.StatusCompleted
+			}
+			if ok {
+				if result, err := a.store.GetResult(ctx, job.JobID); err == nil {
+					event.SafetyScore = result.SafetyScore
+				}
+			}
+
+			mu.Lock()
+			if ok {
+				completed++
+			} else {
+				failed++
+			}
+			event.Completed, event.Failed = completed, failed
+			mu.Unlock()
+
+			event.Message = fmt.Sprintf("%d/%d videos analyzed", event.Completed+event.Failed, event.Total)
+			a.publishBatchProgress(event)
+		}(job)
+	}
+	wg.Wait()
+
+	if err := a.store.UpdateBatchStatus(ctx, batchID, storage.StatusCompleted); err != nil {
+		log.Printf("Failed to update batch status: %v", err)
+	}
+
+	a.publishBatchProgress(BatchProgressEvent{
+		BatchID:   batchID,
+		Type:      "complete",
+		Completed: completed,
+		Failed:    failed,
+		Total:     len(jobs),
+		Message:   "Batch analysis complete",
+	})
+}
+
+func (a *Analyzer) publishBatchProgress(event BatchProgressEvent) {
+	if a.redisClient == nil {
+		return
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	channel := fmt.Sprintf("batch-progress:%s", event.BatchID.String())
+	if err := a.redisClient.Publish(context.Background(), channel, payload).Err(); err != nil {
+		log.Printf("Failed to publish batch progress to Redis: %v", err)
+	}
+}
+
+// SubscribeToBatch: 배치 진행 상황 구독 (complete 이벤트 수신 시 채널 종료)
+func (a *Analyzer) SubscribeToBatch(ctx context.Context, batchIDStr string) (<-chan BatchProgressEvent, error) {
+	if a.redisClient == nil {
+		return nil, fmt.Errorf("redis client is nil")
+	}
+
+	// 구독 확인까지 기다린 뒤 반환 (호출자가 이후에 읽은 배치 상태보다 늦은 이벤트는 놓치지 않음)
+	channel := fmt.Sprintf("batch-progress:%s", batchIDStr)
+	pubsub := a.redisClient.Subscribe(ctx, channel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("subscribe %s: %w", channel, err)
+	}
+	ch := make(chan BatchProgressEvent, 10)
+
+	go func() {
+		defer close(ch)
+		defer pubsub.Close()
+
+		msgCh := pubsub.Channel()
+		for {
+			select {
+			case msg, ok := <-msgCh:
+				if !ok {
+					return
+				}
+				var event BatchProgressEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					continue
+				}
+				select {
+				case ch <- event:
+				case <-ctx.Done():
+					return
+				}
+				if event.Type == "complete" {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
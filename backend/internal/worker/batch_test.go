@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+func TestRunBatch(t *testing.T) {
+	ctx := context.Background()
+	ta := newTestAnalyzer(t)
+	ta.provider.videos["safe"] = &source.Metadata{Title: "Cooking"}
+	ta.provider.videos["scam"] = &source.Metadata{Title: "Giveaway"}
+	ta.gemini.scores["Cooking"] = 90
+	ta.gemini.scores["Giveaway"] = 20
+
+	batch := &storage.AnalysisBatch{Kind: "playlist", Title: "List", Total: 3}
+	require.NoError(t, ta.store.CreateBatch(ctx, batch))
+	var jobs []BatchJob
+	for _, id := range []string{"safe", "scam", "missing"} {
+		job, err := ta.store.CreateBatchJob(ctx, batch.BatchID, "test:"+id)
+		require.NoError(t, err)
+		jobs = append(jobs, BatchJob{JobID: job.JobID, VideoURL: "https://video.test/" + id})
+	}
+
+	ta.runBatch(ctx, batch.BatchID, jobs, false, 0, 2)
+
+	got, err := ta.store.GetBatch(ctx, batch.BatchID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCompleted, got.Status)
+	items, err := ta.store.ListBatchItems(ctx, batch.BatchID)
+	require.NoError(t, err)
+	statuses := map[string]string{}
+	for _, item := range items {
+		statuses[item.VideoID] = item.Status
+	}
+	assert.Equal(t, map[string]string{
+		"test:safe":    storage.StatusCompleted,
+		"test:scam":    storage.StatusCompleted,
+		"test:missing": storage.StatusFailed,
+	}, statuses)
+
+	// 자식 Job마다 progress, 마지막에 complete
+	ta.redis.mu.Lock()
+	payloads := ta.redis.published["batch-progress:"+batch.BatchID.String()]
+	ta.redis.mu.Unlock()
+	require.Len(t, payloads, 4)
+	var events []BatchProgressEvent
+	for _, payload := range payloads {
+		var event BatchProgressEvent
+		require.NoError(t, json.Unmarshal([]byte(payload), &event))
+		events = append(events, event)
+	}
+
+	// 병렬 실행이라 발행 순서는 정해져 있지 않음
+	scores := map[string]int{}
+	var analyzed []int
+	for _, event := range events[:3] {
+		assert.Equal(t, "progress", event.Type)
+		assert.Equal(t, 3, event.Total)
+		scores[event.VideoID] = event.SafetyScore
+		analyzed = append(analyzed, event.Completed+event.Failed)
+	}
+	assert.Equal(t, map[string]int{"test:safe": 90, "test:scam": 20, "test:missing": 0}, scores)
+	assert.ElementsMatch(t, []int{1, 2, 3}, analyzed)
+
+	complete := events[3]
+	assert.Equal(t, "complete", complete.Type)
+	assert.Equal(t, 2, complete.Completed)
+	assert.Equal(t, 1, complete.Failed)
+}
@@ -0,0 +1,182 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/gemini"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+)
+
+const (
+	defaultLiveScanInterval = 30 * time.Second
+	defaultLiveMaxDuration  = 2 * time.Hour
+	defaultLiveIdleTimeout  = 2 * time.Minute
+
+	// 재분석에 넘길 최근 채팅 메시지 수
+	liveChatWindow = 50
+)
+
+// liveSettings: 라이브 방송 모니터링 주기/종료 조건
+type liveSettings struct {
+	interval    time.Duration
+	maxDuration time.Duration
+	idleTimeout time.Duration
+}
+
+// newLiveSettings: 설정값이 비어 있으면 기본값 사용
+func newLiveSettings(cfg config.LiveConfig) liveSettings {
+	live := liveSettings{defaultLiveScanInterval, defaultLiveMaxDuration, defaultLiveIdleTimeout}
+	if cfg.ScanIntervalSeconds > 0 {
+		live.interval = time.Duration(cfg.ScanIntervalSeconds) * time.Second
+	}
+	if cfg.MaxDurationMinutes > 0 {
+		live.maxDuration = time.Duration(cfg.MaxDurationMinutes) * time.Minute
+	}
+	if cfg.IdleTimeoutSeconds > 0 {
+		live.idleTimeout = time.Duration(cfg.IdleTimeoutSeconds) * time.Second
+	}
+	return live
+}
+
+// monitorLive: 라이브 방송을 주기적으로 다시 샘플링(메타데이터 + 새 채팅)해 재분석하고
+// 갱신된 판정을 "verdict" 이벤트로 발행한다.
+// 방송 종료, 취소(CancelAnalysis), 최대 모니터링 시간 초과, 구독자 없음(idle) 중 하나로 종료된다.
+func (a *Analyzer) monitorLive(ctx context.Context, jobID uuid.UUID, videoID string, req *gemini.AnalysisRequest, last *gemini.AnalysisResponse) {
+	interval, maxDuration, idleTimeout := a.live.interval, a.live.maxDuration, a.live.idleTimeout
+
+	if err := a.store.UpdateJobStatus(ctx, jobID, storage.StatusMonitoring, 95); err != nil {
+		log.Printf("Failed to update job status: %v", err)
+	}
+	a.sendVerdict(jobID, last, nil)
+	a.sendProgress(jobID, "log", fmt.Sprintf("Monitoring active — next scan in %s", interval), 95)
+
+	deadline := time.Now().Add(maxDuration)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var (
+		pageToken string
+		chat      []gemini.CommentData
+		idleSince time.Time
+		scans     int
+		reason    string
+	)
+
+	for reason == "" {
+		select {
+		case <-ctx.Done():
+			reason = "Monitoring stopped"
+			continue
+		case <-ticker.C:
+		}
+
+		// 종료 조건 확인
+		switch {
+		case a.isCancelled(ctx, jobID):
+			reason = "Monitoring cancelled"
+			continue
+		case time.Now().After(deadline):
+			reason = fmt.Sprintf("Monitoring stopped after %s", maxDuration)
+			continue
+		}
+		if a.hasViewers(ctx, jobID) {
+			idleSince = time.Time{}
+		} else if idleSince.IsZero() {
+			idleSince = time.Now()
+		} else if time.Since(idleSince) >= idleTimeout {
+			reason = "Monitoring stopped — no one is watching"
+			continue
+		}
+
+		// 1. 방송 상태 다시 샘플링
+		metadata, err := a.youtubeClient.GetMetadata(ctx, videoID)
+		if err != nil {
+			log.Printf("Warning: Failed to refresh live metadata for %s: %v", videoID, err)
+			continue
+		}
+		if !metadata.IsLive() {
+			reason = "Live stream ended"
+			continue
+		}
+		req.Title = metadata.Title
+		req.Description = metadata.Description
+
+		// 2. 지난 스캔 이후의 새 채팅만 가져와 최근 메시지 창 유지
+		if metadata.ActiveLiveChatID != "" {
+			page, err := a.youtubeClient.GetLiveChatMessages(ctx, metadata.ActiveLiveChatID, pageToken)
+			if err != nil {
+				log.Printf("Warning: Failed to get live chat for %s: %v", videoID, err)
+			} else {
+				pageToken = page.NextPageToken
+				for _, m := range page.Messages {
+					chat = append(chat, gemini.CommentData{Author: m.Author, Text: m.Text})
+				}
+				if len(chat) > liveChatWindow {
+					chat = chat[len(chat)-liveChatWindow:]
+				}
+			}
+		}
+		req.LiveChat = chat
+
+		// 3. 재분석 및 결과 저장
+		scans++
+		a.sendProgress(jobID, "log", fmt.Sprintf("Live scan #%d (%d viewers, %d chat messages)", scans, metadata.ConcurrentViewers, len(chat)), 95)
+		result, err := a.geminiClient.AnalyzeContent(ctx, req)
+		if err != nil {
+			log.Printf("Warning: Live re-analysis failed for %s: %v", videoID, err)
+			continue
+		}
+		if err := a.store.SaveResult(ctx, jobID, result.SafetyScore, result.Concerns, result); err != nil {
+			log.Printf("Failed to save live result: %v", err)
+			continue
+		}
+		a.alertGuardians(ctx, jobID, result.SafetyScore)
+		a.sendVerdict(jobID, result, last)
+		last = result
+	}
+
+	// 취소된 Job은 상태를 덮어쓰지 않음
+	if !a.isCancelled(ctx, jobID) {
+		if err := a.store.UpdateJobStatus(ctx, jobID, storage.StatusCompleted, 100); err != nil {
+			log.Printf("Failed to update job status: %v", err)
+		}
+	}
+
+	finalMessage := fmt.Sprintf("%s (%d scans)\n\n%s\n\nConcerns: %v", reason, scans, last.Reasoning, last.Concerns)
+	a.sendProgress(jobID, "complete", finalMessage, 100)
+}
+
+// sendVerdict: 갱신된 안전 점수 발행 (previous가 nil이면 첫 판정, 0점도 유효한 점수)
+func (a *Analyzer) sendVerdict(jobID uuid.UUID, result, previous *gemini.AnalysisResponse) {
+	message := fmt.Sprintf("Safety score: %d (%s)", result.SafetyScore, storage.VerdictForScore(result.SafetyScore))
+	if previous != nil && previous.SafetyScore != result.SafetyScore {
+		message = fmt.Sprintf("Safety score changed: %d → %d (%s)", previous.SafetyScore, result.SafetyScore, storage.VerdictForScore(result.SafetyScore))
+	}
+
+	a.publishProgress(ProgressEvent{
+		JobID:       jobID,
+		Type:        "verdict",
+		Message:     message,
+		Progress:    95,
+		SafetyScore: result.SafetyScore,
+	})
+}
+
+// hasViewers: 이 Job의 진행 상황을 구독 중인 클라이언트가 있는지 확인
+// Redis가 없으면 확인할 수 없으므로 시청 중으로 간주한다.
+func (a *Analyzer) hasViewers(ctx context.Context, jobID uuid.UUID) bool {
+	if a.redisClient == nil {
+		return true
+	}
+	channel := fmt.Sprintf("job-progress:%s", jobID.String())
+	counts, err := a.redisClient.PubSubNumSub(ctx, channel).Result()
+	if err != nil {
+		return true
+	}
+	return counts[channel] > 0
+}
@@ -0,0 +1,195 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/config"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/gemini"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/source"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/storage"
+	"github.com/vanillaturtlechips/silver-guardian/backend/internal/youtube"
+)
+
+func TestNewLiveSettings(t *testing.T) {
+	live := newLiveSettings(config.LiveConfig{})
+	assert.Equal(t, liveSettings{defaultLiveScanInterval, defaultLiveMaxDuration, defaultLiveIdleTimeout}, live)
+
+	live = newLiveSettings(config.LiveConfig{ScanIntervalSeconds: 10, MaxDurationMinutes: 30, IdleTimeoutSeconds: 60})
+	assert.Equal(t, liveSettings{10 * time.Second, 30 * time.Minute, time.Minute}, live)
+}
+
+func TestHasViewers(t *testing.T) {
+	ctx := context.Background()
+	ta := newTestAnalyzer(t)
+	jobID := uuid.New()
+	channel := "job-progress:" + jobID.String()
+
+	assert.False(t, ta.hasViewers(ctx, jobID))
+	ta.redis.setSubscribers(channel, 2)
+	assert.True(t, ta.hasViewers(ctx, jobID))
+
+	// 확인할 수 없으면 시청 중으로 간주 (모니터링을 끊지 않음)
+	ta.redis.err = errors.New("connection refused")
+	assert.True(t, ta.hasViewers(ctx, jobID))
+	ta.redisClient = nil
+	assert.True(t, ta.hasViewers(ctx, jobID))
+}
+
+func TestSendVerdict(t *testing.T) {
+	ta := newTestAnalyzer(t)
+	jobID := uuid.New()
+
+	// 0점도 첫 판정/이전 판정으로 취급
+	ta.sendVerdict(jobID, &gemini.AnalysisResponse{SafetyScore: 0}, nil)
+	ta.sendVerdict(jobID, &gemini.AnalysisResponse{SafetyScore: 45}, &gemini.AnalysisResponse{SafetyScore: 0})
+	ta.sendVerdict(jobID, &gemini.AnalysisResponse{SafetyScore: 45}, &gemini.AnalysisResponse{SafetyScore: 45})
+
+	events := ta.redis.jobEvents(t, jobID.String())
+	require.Len(t, events, 3)
+	assert.Equal(t, "verdict", events[0].Type)
+	assert.Equal(t, "Safety score: 0 (danger)", events[0].Message)
+	assert.Equal(t, "Safety score changed: 0 → 45 (caution)", events[1].Message)
+	assert.Equal(t, 45, events[1].SafetyScore)
+	assert.Equal(t, "Safety score: 45 (caution)", events[2].Message)
+}
+
+// newLiveTest: 1ms마다 재샘플링하는 라이브 모니터링 테스트 환경
+func newLiveTest(t *testing.T) (*testAnalyzer, *storage.AnalysisJob) {
+	ta := newTestAnalyzer(t)
+	ta.live = liveSettings{interval: time.Millisecond, maxDuration: 5 * time.Second, idleTimeout: time.Minute}
+	ctx := context.Background()
+	require.NoError(t, ta.store.CreateVideo(ctx, &storage.Video{VideoID: "live0000001", Platform: source.PlatformYouTube, Title: "Live Q&A"}))
+	job, err := ta.store.CreateJob(ctx, "live0000001", 0)
+	require.NoError(t, err)
+	ta.redis.setSubscribers("job-progress:"+job.JobID.String(), 1)
+	return ta, job
+}
+
+func liveMetadata(title string, live bool) *youtube.VideoMetadata {
+	m := &youtube.VideoMetadata{VideoID: "live0000001", Title: title, ActiveLiveChatID: "chat1"}
+	if live {
+		m.LiveBroadcastContent = "live"
+	}
+	return m
+}
+
+func TestMonitorLiveUntilStreamEnds(t *testing.T) {
+	ctx := context.Background()
+	ta, job := newLiveTest(t)
+	ta.youtube.metadata = []*youtube.VideoMetadata{
+		liveMetadata("Live Q&A", true),
+		liveMetadata("Live Giveaway", true),
+		liveMetadata("Live Giveaway", false),
+	}
+	ta.youtube.chat[""] = &youtube.LiveChatPage{
+		Messages:      []youtube.LiveChatMessage{{Author: "a", Text: "hello"}},
+		NextPageToken: "p2",
+	}
+	ta.youtube.chat["p2"] = &youtube.LiveChatPage{
+		Messages:      []youtube.LiveChatMessage{{Author: "b", Text: "send 1 BTC"}},
+		NextPageToken: "p3",
+	}
+	ta.gemini.scores["Live Q&A"] = 80
+	ta.gemini.scores["Live Giveaway"] = 0
+	var chats [][]gemini.CommentData
+	ta.gemini.onAnalyze = func(req *gemini.AnalysisRequest) {
+		chats = append(chats, append([]gemini.CommentData(nil), req.LiveChat...))
+	}
+
+	ta.monitorLive(ctx, job.JobID, "live0000001", &gemini.AnalysisRequest{}, &gemini.AnalysisResponse{SafetyScore: 80})
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCompleted, got.Status)
+	result, err := ta.store.GetResult(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.SafetyScore)
+
+	// 새 채팅만 이어받아 누적
+	require.Len(t, chats, 2)
+	assert.Len(t, chats[0], 1)
+	assert.Equal(t, "send 1 BTC", chats[1][1].Text)
+
+	var verdicts []string
+	for _, event := range ta.redis.jobEvents(t, job.JobID.String()) {
+		if event.Type == "verdict" {
+			verdicts = append(verdicts, event.Message)
+		}
+	}
+	assert.Equal(t, []string{
+		"Safety score: 80 (safe)",
+		"Safety score: 80 (safe)",
+		"Safety score changed: 80 → 0 (danger)",
+	}, verdicts)
+	last := lastEvent(ta.redis.jobEvents(t, job.JobID.String()))
+	assert.Equal(t, "complete", last.Type)
+	assert.Contains(t, last.Message, "Live stream ended (2 scans)")
+}
+
+func TestMonitorLiveCancelled(t *testing.T) {
+	ctx := context.Background()
+	ta, job := newLiveTest(t)
+	ta.youtube.metadata = []*youtube.VideoMetadata{liveMetadata("Live Q&A", true)}
+	ta.gemini.scores["Live Q&A"] = 80
+	ta.gemini.onAnalyze = func(*gemini.AnalysisRequest) {
+		require.NoError(t, ta.store.UpdateJobStatus(ctx, job.JobID, storage.StatusCancelled, 95))
+	}
+
+	ta.monitorLive(ctx, job.JobID, "live0000001", &gemini.AnalysisRequest{}, &gemini.AnalysisResponse{SafetyScore: 80})
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCancelled, got.Status)
+	assert.Equal(t, 1, ta.gemini.calls)
+	assert.Contains(t, lastEvent(ta.redis.jobEvents(t, job.JobID.String())).Message, "Monitoring cancelled")
+}
+
+func TestMonitorLiveStopsWithoutViewers(t *testing.T) {
+	ctx := context.Background()
+	ta, job := newLiveTest(t)
+	ta.live.idleTimeout = 20 * time.Millisecond
+	ta.redis.setSubscribers("job-progress:"+job.JobID.String(), 0)
+	ta.youtube.metadata = []*youtube.VideoMetadata{liveMetadata("Live Q&A", true)}
+	ta.gemini.scores["Live Q&A"] = 80
+
+	ta.monitorLive(ctx, job.JobID, "live0000001", &gemini.AnalysisRequest{}, &gemini.AnalysisResponse{SafetyScore: 80})
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCompleted, got.Status)
+	assert.Contains(t, lastEvent(ta.redis.jobEvents(t, job.JobID.String())).Message, "no one is watching")
+}
+
+func TestMonitorLiveMaxDuration(t *testing.T) {
+	ctx := context.Background()
+	ta, job := newLiveTest(t)
+	ta.live.maxDuration = 20 * time.Millisecond
+	ta.youtube.metadata = []*youtube.VideoMetadata{liveMetadata("Live Q&A", true)}
+	ta.gemini.scores["Live Q&A"] = 80
+
+	ta.monitorLive(ctx, job.JobID, "live0000001", &gemini.AnalysisRequest{}, &gemini.AnalysisResponse{SafetyScore: 80})
+
+	assert.Contains(t, lastEvent(ta.redis.jobEvents(t, job.JobID.String())).Message, "Monitoring stopped after")
+}
+
+func TestRunAnalysisStartsLiveMonitoring(t *testing.T) {
+	ctx := context.Background()
+	ta, job := newLiveTest(t)
+	ta.provider.platform = source.PlatformYouTube
+	ta.provider.videos["live0000001"] = &source.Metadata{Title: "Live Q&A", Live: true}
+	ta.youtube.metadata = []*youtube.VideoMetadata{liveMetadata("Live Q&A", false)}
+	ta.gemini.scores["Live Q&A"] = 80
+
+	ta.runAnalysis(ctx, job.JobID, "https://video.test/live0000001", false, 0, true)
+
+	got, err := ta.store.GetJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.StatusCompleted, got.Status)
+	assert.Contains(t, lastEvent(ta.redis.jobEvents(t, job.JobID.String())).Message, "Live stream ended (0 scans)")
+}
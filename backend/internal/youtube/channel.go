@@ -0,0 +1,188 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ChannelInfo holds the reputation-relevant fields of a YouTube channel.
+type ChannelInfo struct {
+	ChannelID             string
+	Title                 string
+	CustomURL             string
+	Country               string
+	SubscriberCount       int64
+	VideoCount            int64
+	HiddenSubscriberCount bool
+	// Verified reports whether the channel passed YouTube's phone verification
+	// (status.longUploadsStatus == "allowed"). The Data API does not expose the
+	// checkmark badge itself.
+	Verified  bool
+	CreatedAt time.Time
+}
+
+// GetChannel retrieves channel details using YouTube Data API
+func (c *Client) GetChannel(ctx context.Context, channelID string) (*ChannelInfo, error) {
+	apiURL := fmt.Sprintf(
+		"https://www.googleapis.com/youtube/v3/channels?part=snippet,statistics,status&id=%s&key=%s",
+		channelID, c.apiKey,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("YouTube API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result struct {
+		Items []struct {
+			ID      string `json:"id"`
+			Snippet struct {
+				Title       string    `json:"title"`
+				CustomURL   string    `json:"customUrl"`
+				Country     string    `json:"country"`
+				PublishedAt time.Time `json:"publishedAt"`
+			} `json:"snippet"`
+			Statistics struct {
+				SubscriberCount       string `json:"subscriberCount"`
+				VideoCount            string `json:"videoCount"`
+				HiddenSubscriberCount bool   `json:"hiddenSubscriberCount"`
+			} `json:"statistics"`
+			Status struct {
+				LongUploadsStatus string `json:"longUploadsStatus"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	if len(result.Items) == 0 {
+		return nil, fmt.Errorf("channel not found")
+	}
+
+	item := result.Items[0]
+
+	var subscribers, videos int64
+	fmt.Sscanf(item.Statistics.SubscriberCount, "%d", &subscribers)
+	fmt.Sscanf(item.Statistics.VideoCount, "%d", &videos)
+
+	return &ChannelInfo{
+		ChannelID:             item.ID,
+		Title:                 item.Snippet.Title,
+		CustomURL:             item.Snippet.CustomURL,
+		Country:               item.Snippet.Country,
+		SubscriberCount:       subscribers,
+		VideoCount:            videos,
+		HiddenSubscriberCount: item.Statistics.HiddenSubscriberCount,
+		Verified:              item.Status.LongUploadsStatus == "allowed",
+		CreatedAt:             item.Snippet.PublishedAt,
+	}, nil
+}
+
+// newChannelAge is how young a channel must be to count as "brand-new".
+const newChannelAge = 90 * 24 * time.Hour
+
+// famousNames lists brands and public figures that scam channels commonly
+// impersonate. Entries are matched against the normalized channel title.
+var famousNames = []string{
+	// 금융기관
+	"KB국민은행", "국민은행", "신한은행", "우리은행", "하나은행", "NH농협", "농협은행",
+	"기업은행", "카카오뱅크", "토스뱅크", "케이뱅크", "삼성증권", "미래에셋",
+	// 공공기관
+	"금융감독원", "금융위원회", "국민연금", "국세청", "경찰청", "검찰청",
+	// 유명인
+	"Elon Musk", "일론 머스크", "Tesla", "테슬라", "SpaceX", "Bill Gates", "빌 게이츠",
+	"Warren Buffett", "워런 버핏", "Binance", "바이낸스", "유재석", "백종원", "손흥민",
+}
+
+// ChannelAssessment summarizes reputation signals derived from ChannelInfo.
+type ChannelAssessment struct {
+	AgeDays          int
+	NewChannel       bool
+	LowSubscribers   bool
+	ImpersonatedName string
+	Signals          []string
+}
+
+// AssessChannel derives scam-related reputation signals for a channel.
+// The most important one is a brand-new, unverified channel whose title
+// contains the name of a well-known bank, agency or celebrity.
+func AssessChannel(ch *ChannelInfo, now time.Time) ChannelAssessment {
+	var a ChannelAssessment
+	if ch == nil {
+		return a
+	}
+
+	if !ch.CreatedAt.IsZero() {
+		age := now.Sub(ch.CreatedAt)
+		a.AgeDays = int(age.Hours() / 24)
+		a.NewChannel = age < newChannelAge
+	}
+	a.LowSubscribers = !ch.HiddenSubscriberCount && ch.SubscriberCount < 1000
+	a.ImpersonatedName = matchFamousName(ch.Title)
+
+	if a.NewChannel {
+		a.Signals = append(a.Signals, fmt.Sprintf("채널 개설 %d일 이내의 신규 채널", a.AgeDays))
+	}
+	if a.LowSubscribers {
+		a.Signals = append(a.Signals, fmt.Sprintf("구독자 수가 매우 적음 (%d명)", ch.SubscriberCount))
+	}
+	if ch.HiddenSubscriberCount {
+		a.Signals = append(a.Signals, "구독자 수 비공개")
+	}
+	if !ch.Verified {
+		a.Signals = append(a.Signals, "본인 인증되지 않은 채널")
+	}
+	// 오래된 인증 채널은 은행/기관의 공식 채널일 수 있으므로 이름만으로는 신호로 보지 않음
+	if a.ImpersonatedName != "" && (a.NewChannel || !ch.Verified) {
+		a.Signals = append(a.Signals, fmt.Sprintf("유명 브랜드/인물 이름 사용: %s", a.ImpersonatedName))
+		if a.NewChannel {
+			a.Signals = append(a.Signals, fmt.Sprintf("신규 채널이 '%s'을(를) 사칭하는 것으로 의심됨", a.ImpersonatedName))
+		}
+	}
+
+	return a
+}
+
+// matchFamousName returns the first famous name contained in title, ignoring
+// case, spaces and punctuation.
+func matchFamousName(title string) string {
+	normalized := normalizeName(title)
+	if normalized == "" {
+		return ""
+	}
+	for _, name := range famousNames {
+		if strings.Contains(normalized, normalizeName(name)) {
+			return name
+		}
+	}
+	return ""
+}
+
+func normalizeName(s string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
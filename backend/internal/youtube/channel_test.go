@@ -0,0 +1,66 @@
+package youtube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssessChannelImpersonation(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// 개설 10일 된 "국민은행" 사칭 채널
+	ch := &ChannelInfo{
+		Title:           "KB 국민은행 고객센터 (공식)",
+		SubscriberCount: 12,
+		CreatedAt:       now.Add(-10 * 24 * time.Hour),
+	}
+
+	a := AssessChannel(ch, now)
+	assert.True(t, a.NewChannel)
+	assert.True(t, a.LowSubscribers)
+	assert.Equal(t, 10, a.AgeDays)
+	assert.Equal(t, "KB국민은행", a.ImpersonatedName)
+	assert.Contains(t, a.Signals, "신규 채널이 'KB국민은행'을(를) 사칭하는 것으로 의심됨")
+}
+
+func TestAssessChannelEstablished(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	ch := &ChannelInfo{
+		Title:           "요리하는 할머니",
+		SubscriberCount: 250000,
+		Verified:        true,
+		CreatedAt:       now.AddDate(-5, 0, 0),
+	}
+
+	a := AssessChannel(ch, now)
+	assert.False(t, a.NewChannel)
+	assert.False(t, a.LowSubscribers)
+	assert.Empty(t, a.ImpersonatedName)
+	assert.Empty(t, a.Signals)
+}
+
+func TestAssessChannelOfficialBrand(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// 오래된 인증 채널(은행 공식 채널)은 이름만으로 신호를 내지 않음
+	official := &ChannelInfo{
+		Title:           "KB국민은행",
+		SubscriberCount: 300000,
+		Verified:        true,
+		CreatedAt:       now.AddDate(-8, 0, 0),
+	}
+	a := AssessChannel(official, now)
+	assert.Equal(t, "KB국민은행", a.ImpersonatedName)
+	assert.Empty(t, a.Signals)
+
+	// 오래됐어도 인증되지 않았으면 신호 유지
+	official.Verified = false
+	assert.Contains(t, AssessChannel(official, now).Signals, "유명 브랜드/인물 이름 사용: KB국민은행")
+}
+
+func TestAssessChannelNil(t *testing.T) {
+	assert.Empty(t, AssessChannel(nil, time.Now()).Signals)
+}
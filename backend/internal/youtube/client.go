@@ -22,9 +22,16 @@ type VideoMetadata struct {
     Title       string
     Description string
     Channel     string
+    ChannelID   string
     Duration    int64
     ViewCount   int64
     PublishedAt time.Time
+    // Thumbnails는 해상도가 높은 순서로 정렬된 썸네일 URL 목록
+    Thumbnails  []string
+    // 라이브 방송 정보 (LiveBroadcastContent: "live", "upcoming", "none")
+    LiveBroadcastContent string
+    ActiveLiveChatID     string
+    ConcurrentViewers    int64
 }
 
 type Comment struct {
@@ -43,38 +50,10 @@ func NewClient(apiKey string) *Client {
     }
 }
 
-// ExtractVideoID extracts YouTube video ID from URL
-func ExtractVideoID(videoURL string) (string, error) {
-	fmt.Printf("[DEBUG] Parsing Input: %s\n", videoURL)
-
-	// 1. 입력값이 이미 깔끔한 11자리 ID인 경우 (프론트엔드가 처리해준 경우)
-	// 예: "6NNaHMzFnac"
-	if len(videoURL) == 11 {
-		match, _ := regexp.MatchString(`^[a-zA-Z0-9_-]{11}$`, videoURL)
-		if match {
-			fmt.Printf("[DEBUG] Input is already a raw ID: %s\n", videoURL)
-			return videoURL, nil
-		}
-	}
-
-	// 2. URL 형식이면 정규식으로 추출 (Shorts, Live, Embed, 공유 링크 등 모두 지원)
-	// 예: "https://www.youtube.com/shorts/6NNaHMzFnac"
-	regex := regexp.MustCompile(`(?:v=|be\/|embed\/|shorts\/|live\/)([\w-]{11})`)
-	matches := regex.FindStringSubmatch(videoURL)
-	
-	if len(matches) < 2 {
-		fmt.Printf("[DEBUG] Regex failed to match\n")
-		return "", fmt.Errorf("invalid YouTube URL")
-	}
-
-	fmt.Printf("[DEBUG] Extracted ID from URL: %s\n", matches[1])
-	return matches[1], nil
-}
-
 // GetMetadata retrieves video metadata using YouTube Data API
 func (c *Client) GetMetadata(ctx context.Context, videoID string) (*VideoMetadata, error) {
     apiURL := fmt.Sprintf(
-        "https://www.googleapis.com/youtube/v3/videos?part=snippet,contentDetails,statistics&id=%s&key=%s",
+        "https://www.googleapis.com/youtube/v3/videos?part=snippet,contentDetails,statistics,liveStreamingDetails&id=%s&key=%s",
         videoID, c.apiKey,
     )
 
@@ -100,7 +79,10 @@ func (c *Client) GetMetadata(ctx context.Context, videoID string) (*VideoMetadat
                 Title       string    `json:"title"`
                 Description string    `json:"description"`
                 ChannelTitle string   `json:"channelTitle"`
+                ChannelID   string    `json:"channelId"`
                 PublishedAt time.Time `json:"publishedAt"`
+                Thumbnails  thumbnailSet `json:"thumbnails"`
+                LiveBroadcastContent string `json:"liveBroadcastContent"`
             } `json:"snippet"`
             ContentDetails struct {
                 Duration string `json:"duration"`
@@ -108,6 +90,10 @@ func (c *Client) GetMetadata(ctx context.Context, videoID string) (*VideoMetadat
             Statistics struct {
                 ViewCount string `json:"viewCount"`
             } `json:"statistics"`
+            LiveStreamingDetails struct {
+                ActiveLiveChatID  string `json:"activeLiveChatId"`
+                ConcurrentViewers string `json:"concurrentViewers"`
+            } `json:"liveStreamingDetails"`
         } `json:"items"`
     }
 
@@ -128,14 +114,22 @@ func (c *Client) GetMetadata(ctx context.Context, videoID string) (*VideoMetadat
     var viewCount int64
     fmt.Sscanf(item.Statistics.ViewCount, "%d", &viewCount)
 
+    var concurrentViewers int64
+    fmt.Sscanf(item.LiveStreamingDetails.ConcurrentViewers, "%d", &concurrentViewers)
+
     return &VideoMetadata{
         VideoID:     videoID,
         Title:       item.Snippet.Title,
         Description: item.Snippet.Description,
         Channel:     item.Snippet.ChannelTitle,
+        ChannelID:   item.Snippet.ChannelID,
         Duration:    duration,
         ViewCount:   viewCount,
         PublishedAt: item.Snippet.PublishedAt,
+        Thumbnails:  item.Snippet.Thumbnails.best(),
+        LiveBroadcastContent: item.Snippet.LiveBroadcastContent,
+        ActiveLiveChatID:     item.LiveStreamingDetails.ActiveLiveChatID,
+        ConcurrentViewers:    concurrentViewers,
     }, nil
 }
 
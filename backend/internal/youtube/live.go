@@ -0,0 +1,73 @@
+package youtube
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// LiveChatMessage is one message of a live stream's chat.
+type LiveChatMessage struct {
+	Author      string
+	Text        string
+	PublishedAt time.Time
+}
+
+// LiveChatPage is one page of live chat messages. Pass NextPageToken to the
+// next call to receive only newer messages, and wait at least PollingInterval
+// between calls as the API requests.
+type LiveChatPage struct {
+	Messages        []LiveChatMessage
+	NextPageToken   string
+	PollingInterval time.Duration
+}
+
+// IsLive reports whether the video is a live stream that is currently on air.
+func (m *VideoMetadata) IsLive() bool {
+	return m.LiveBroadcastContent == "live"
+}
+
+// GetLiveChatMessages retrieves live chat messages using YouTube Data API
+func (c *Client) GetLiveChatMessages(ctx context.Context, liveChatID, pageToken string) (*LiveChatPage, error) {
+	params := url.Values{
+		"part":       {"snippet,authorDetails"},
+		"liveChatId": {liveChatID},
+		"maxResults": {"200"},
+	}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+
+	var result struct {
+		NextPageToken         string `json:"nextPageToken"`
+		PollingIntervalMillis int64  `json:"pollingIntervalMillis"`
+		Items                 []struct {
+			Snippet struct {
+				DisplayMessage string    `json:"displayMessage"`
+				PublishedAt    time.Time `json:"publishedAt"`
+			} `json:"snippet"`
+			AuthorDetails struct {
+				DisplayName string `json:"displayName"`
+			} `json:"authorDetails"`
+		} `json:"items"`
+	}
+	if err := c.getJSON(ctx, "liveChat/messages", params, &result); err != nil {
+		return nil, err
+	}
+
+	page := &LiveChatPage{
+		NextPageToken:   result.NextPageToken,
+		PollingInterval: time.Duration(result.PollingIntervalMillis) * time.Millisecond,
+	}
+	for _, item := range result.Items {
+		if item.Snippet.DisplayMessage == "" {
+			continue
+		}
+		page.Messages = append(page.Messages, LiveChatMessage{
+			Author:      item.AuthorDetails.DisplayName,
+			Text:        item.Snippet.DisplayMessage,
+			PublishedAt: item.Snippet.PublishedAt,
+		})
+	}
+	return page, nil
+}
@@ -0,0 +1,236 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Collection kinds
+const (
+	CollectionPlaylist = "playlist"
+	CollectionChannel  = "channel"
+)
+
+// CollectionRef is a parsed playlist or channel URL. Exactly one of
+// PlaylistID, ChannelID, Handle or Username is set.
+type CollectionRef struct {
+	Kind       string
+	PlaylistID string
+	ChannelID  string // UC...
+	Handle     string // @name (without "@")
+	Username   string // legacy /user/<name> and /c/<name>
+}
+
+// Collection is a resolved playlist or channel with the playlist that
+// holds its videos (for channels: the uploads playlist).
+type Collection struct {
+	Kind       string
+	Title      string
+	ChannelID  string
+	PlaylistID string
+}
+
+// PlaylistVideo is one entry of a playlist.
+type PlaylistVideo struct {
+	VideoID     string
+	Title       string
+	PublishedAt time.Time
+}
+
+// ParseCollectionURL parses playlist and channel URLs:
+// /playlist?list=, /watch?v=...&list=, /channel/UC..., /@handle, /c/name, /user/name.
+func ParseCollectionURL(raw string) (*CollectionRef, error) {
+	u, err := parseLooseURL(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if !youtubeHosts[host] {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedHost, host)
+	}
+
+	if list := u.Query().Get("list"); list != "" {
+		return &CollectionRef{Kind: CollectionPlaylist, PlaylistID: list}, nil
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	first := segments[0]
+	switch {
+	case strings.HasPrefix(first, "@") && len(first) > 1:
+		return &CollectionRef{Kind: CollectionChannel, Handle: strings.TrimPrefix(first, "@")}, nil
+	case first == "channel" && strings.HasPrefix(segmentAt(segments, 1), "UC"):
+		return &CollectionRef{Kind: CollectionChannel, ChannelID: segments[1]}, nil
+	case (first == "c" || first == "user") && segmentAt(segments, 1) != "":
+		return &CollectionRef{Kind: CollectionChannel, Username: segments[1]}, nil
+	}
+
+	return nil, ErrInvalidURL
+}
+
+// ResolveCollection looks up the title and video playlist of a collection.
+func (c *Client) ResolveCollection(ctx context.Context, ref *CollectionRef) (*Collection, error) {
+	if ref.Kind == CollectionPlaylist {
+		return c.resolvePlaylist(ctx, ref.PlaylistID)
+	}
+
+	params := url.Values{"part": {"snippet,contentDetails"}}
+	switch {
+	case ref.ChannelID != "":
+		params.Set("id", ref.ChannelID)
+	case ref.Handle != "":
+		params.Set("forHandle", "@"+ref.Handle)
+	default:
+		// /c/<name> 커스텀 URL은 API로 직접 조회할 수 없어 대부분 같은 핸들로 조회
+		params.Set("forHandle", "@"+ref.Username)
+	}
+
+	var result struct {
+		Items []struct {
+			ID      string `json:"id"`
+			Snippet struct {
+				Title string `json:"title"`
+			} `json:"snippet"`
+			ContentDetails struct {
+				RelatedPlaylists struct {
+					Uploads string `json:"uploads"`
+				} `json:"relatedPlaylists"`
+			} `json:"contentDetails"`
+		} `json:"items"`
+	}
+	if err := c.getJSON(ctx, "channels", params, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Items) == 0 && ref.Username != "" {
+		params.Del("forHandle")
+		params.Set("forUsername", ref.Username)
+		if err := c.getJSON(ctx, "channels", params, &result); err != nil {
+			return nil, err
+		}
+	}
+	if len(result.Items) == 0 {
+		return nil, fmt.Errorf("channel not found")
+	}
+
+	item := result.Items[0]
+	return &Collection{
+		Kind:       CollectionChannel,
+		Title:      item.Snippet.Title,
+		ChannelID:  item.ID,
+		PlaylistID: item.ContentDetails.RelatedPlaylists.Uploads,
+	}, nil
+}
+
+func (c *Client) resolvePlaylist(ctx context.Context, playlistID string) (*Collection, error) {
+	var result struct {
+		Items []struct {
+			Snippet struct {
+				Title     string `json:"title"`
+				ChannelID string `json:"channelId"`
+			} `json:"snippet"`
+		} `json:"items"`
+	}
+	params := url.Values{"part": {"snippet"}, "id": {playlistID}}
+	if err := c.getJSON(ctx, "playlists", params, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Items) == 0 {
+		return nil, fmt.Errorf("playlist not found")
+	}
+
+	return &Collection{
+		Kind:       CollectionPlaylist,
+		Title:      result.Items[0].Snippet.Title,
+		ChannelID:  result.Items[0].Snippet.ChannelID,
+		PlaylistID: playlistID,
+	}, nil
+}
+
+// ListPlaylistVideos returns up to max videos of a playlist in playlist order.
+func (c *Client) ListPlaylistVideos(ctx context.Context, playlistID string, max int) ([]PlaylistVideo, error) {
+	var videos []PlaylistVideo
+	pageToken := ""
+
+	for len(videos) < max {
+		pageSize := max - len(videos)
+		if pageSize > 50 {
+			pageSize = 50
+		}
+		params := url.Values{
+			"part":       {"snippet,contentDetails"},
+			"playlistId": {playlistID},
+			"maxResults": {fmt.Sprint(pageSize)},
+		}
+		if pageToken != "" {
+			params.Set("pageToken", pageToken)
+		}
+
+		var result struct {
+			NextPageToken string `json:"nextPageToken"`
+			Items         []struct {
+				Snippet struct {
+					Title string `json:"title"`
+				} `json:"snippet"`
+				ContentDetails struct {
+					VideoID          string    `json:"videoId"`
+					VideoPublishedAt time.Time `json:"videoPublishedAt"`
+				} `json:"contentDetails"`
+			} `json:"items"`
+		}
+		if err := c.getJSON(ctx, "playlistItems", params, &result); err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			// 비공개/삭제된 영상은 videoId가 비어 있거나 게시일이 없음
+			if !IsVideoID(item.ContentDetails.VideoID) {
+				continue
+			}
+			videos = append(videos, PlaylistVideo{
+				VideoID:     item.ContentDetails.VideoID,
+				Title:       item.Snippet.Title,
+				PublishedAt: item.ContentDetails.VideoPublishedAt,
+			})
+			if len(videos) == max {
+				break
+			}
+		}
+
+		if result.NextPageToken == "" {
+			break
+		}
+		pageToken = result.NextPageToken
+	}
+
+	return videos, nil
+}
+
+// getJSON calls a YouTube Data API v3 endpoint and decodes the response.
+func (c *Client) getJSON(ctx context.Context, resource string, params url.Values, out interface{}) error {
+	params.Set("key", c.apiKey)
+	apiURL := fmt.Sprintf("https://www.googleapis.com/youtube/v3/%s?%s", resource, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("YouTube API error: %s - %s", resp.Status, string(body))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
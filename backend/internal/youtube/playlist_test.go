@@ -0,0 +1,93 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripFunc: API 요청을 로컬 핸들러로 보내는 http.RoundTripper
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func newTestClient(handler http.HandlerFunc) *Client {
+	c := NewClient("test-key")
+	c.httpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec.Result()
+	})}
+	return c
+}
+
+func playlistItem(videoID, title string) map[string]interface{} {
+	return map[string]interface{}{
+		"snippet":        map[string]string{"title": title},
+		"contentDetails": map[string]string{"videoId": videoID, "videoPublishedAt": "2024-05-01T09:00:00Z"},
+	}
+}
+
+func TestListPlaylistVideos(t *testing.T) {
+	pages := map[string]map[string]interface{}{
+		"": {
+			"nextPageToken": "page2",
+			"items": []interface{}{
+				playlistItem("aaaaaaaaaaa", "First"),
+				// 비공개/삭제된 영상은 videoId가 비어 있음
+				playlistItem("", "Private video"),
+				playlistItem("bbbbbbbbbbb", "Second"),
+			},
+		},
+		"page2": {
+			"nextPageToken": "page3",
+			"items":         []interface{}{playlistItem("ccccccccccc", "Third"), playlistItem("ddddddddddd", "Fourth")},
+		},
+		"page3": {
+			"items": []interface{}{playlistItem("eeeeeeeeeee", "Fifth")},
+		},
+	}
+	var requests []string
+	c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/youtube/v3/playlistItems", r.URL.Path)
+		assert.Equal(t, "PL1", r.URL.Query().Get("playlistId"))
+		token := r.URL.Query().Get("pageToken")
+		requests = append(requests, token+":"+r.URL.Query().Get("maxResults"))
+		json.NewEncoder(w).Encode(pages[token])
+	})
+	ctx := context.Background()
+
+	videos, err := c.ListPlaylistVideos(ctx, "PL1", 10)
+	require.NoError(t, err)
+	require.Len(t, videos, 5)
+	assert.Equal(t, "aaaaaaaaaaa", videos[0].VideoID)
+	assert.Equal(t, "First", videos[0].Title)
+	assert.Equal(t, 2024, videos[0].PublishedAt.Year())
+	assert.Equal(t, "bbbbbbbbbbb", videos[1].VideoID)
+	assert.Equal(t, "eeeeeeeeeee", videos[4].VideoID)
+	// 남은 개수만큼만 요청하고 마지막 페이지에서 멈춤
+	assert.Equal(t, []string{":10", "page2:8", "page3:6"}, requests)
+
+	// max에 도달하면 다음 페이지를 요청하지 않음
+	requests = nil
+	videos, err = c.ListPlaylistVideos(ctx, "PL1", 3)
+	require.NoError(t, err)
+	require.Len(t, videos, 3)
+	assert.Equal(t, "ccccccccccc", videos[2].VideoID)
+	assert.Equal(t, []string{":3", "page2:1"}, requests)
+}
+
+func TestListPlaylistVideosError(t *testing.T) {
+	c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":{"message":"playlist not found"}}`, http.StatusNotFound)
+	})
+	_, err := c.ListPlaylistVideos(context.Background(), "PL404", 10)
+	assert.ErrorContains(t, err, "404")
+}
@@ -0,0 +1,45 @@
+package youtube
+
+import "fmt"
+
+type thumbnail struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// thumbnailSet mirrors snippet.thumbnails of the Data API. Not every size is
+// present for every video (maxres only exists for HD uploads).
+type thumbnailSet struct {
+	Default  *thumbnail `json:"default"`
+	Medium   *thumbnail `json:"medium"`
+	High     *thumbnail `json:"high"`
+	Standard *thumbnail `json:"standard"`
+	Maxres   *thumbnail `json:"maxres"`
+}
+
+// best returns the available thumbnail URLs, highest resolution first.
+func (t thumbnailSet) best() []string {
+	var urls []string
+	for _, th := range []*thumbnail{t.Maxres, t.Standard, t.High, t.Medium, t.Default} {
+		if th != nil && th.URL != "" {
+			urls = append(urls, th.URL)
+		}
+	}
+	return urls
+}
+
+// BestThumbnail returns the highest resolution thumbnail of the metadata,
+// falling back to the static i.ytimg.com URL when the API returned none.
+func (m *VideoMetadata) BestThumbnail() string {
+	if len(m.Thumbnails) > 0 {
+		return m.Thumbnails[0]
+	}
+	return DefaultThumbnailURL(m.VideoID)
+}
+
+// DefaultThumbnailURL returns the 480x360 thumbnail that YouTube serves for
+// every video ID, usable before metadata has been fetched.
+func DefaultThumbnailURL(videoID string) string {
+	return fmt.Sprintf("https://i.ytimg.com/vi/%s/hqdefault.jpg", videoID)
+}
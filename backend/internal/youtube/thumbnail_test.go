@@ -0,0 +1,29 @@
+package youtube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThumbnailSetBest(t *testing.T) {
+	set := thumbnailSet{
+		Default: &thumbnail{URL: "https://i.ytimg.com/vi/abc/default.jpg"},
+		High:    &thumbnail{URL: "https://i.ytimg.com/vi/abc/hqdefault.jpg"},
+		Maxres:  &thumbnail{URL: ""}, // 크기만 있고 URL이 빈 경우는 건너뜀
+	}
+	assert.Equal(t, []string{
+		"https://i.ytimg.com/vi/abc/hqdefault.jpg",
+		"https://i.ytimg.com/vi/abc/default.jpg",
+	}, set.best())
+	assert.Empty(t, thumbnailSet{}.best())
+}
+
+func TestBestThumbnail(t *testing.T) {
+	m := &VideoMetadata{VideoID: "abc", Thumbnails: []string{"https://i.ytimg.com/vi/abc/maxresdefault.jpg"}}
+	assert.Equal(t, "https://i.ytimg.com/vi/abc/maxresdefault.jpg", m.BestThumbnail())
+
+	// API가 썸네일을 주지 않으면 정적 URL
+	m = &VideoMetadata{VideoID: "abc"}
+	assert.Equal(t, "https://i.ytimg.com/vi/abc/hqdefault.jpg", m.BestThumbnail())
+}
@@ -0,0 +1,189 @@
+package youtube
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidURL      = errors.New("invalid YouTube URL")
+	ErrUnsupportedHost = errors.New("not a YouTube host")
+)
+
+// VideoRef is a parsed reference to a single YouTube video.
+type VideoRef struct {
+	VideoID string
+	// Start is the playback offset requested by t= / start= (zero if absent).
+	Start time.Duration
+	// PlaylistID and PlaylistIndex are set for watch?v=...&list=...&index=N links.
+	PlaylistID    string
+	PlaylistIndex int
+	// Live is true for youtube.com/live/<id> links.
+	Live bool
+}
+
+var (
+	videoIDPattern   = regexp.MustCompile(`^[a-zA-Z0-9_-]{11}$`)
+	timestampPattern = regexp.MustCompile(`^(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s?)?$`)
+)
+
+// youtubeHosts lists the hosts that serve YouTube watch pages.
+var youtubeHosts = map[string]bool{
+	"youtube.com":              true,
+	"www.youtube.com":          true,
+	"m.youtube.com":            true,
+	"music.youtube.com":        true,
+	"gaming.youtube.com":       true,
+	"youtube-nocookie.com":     true,
+	"www.youtube-nocookie.com": true,
+}
+
+// IsVideoID reports whether s has the shape of a YouTube video ID.
+func IsVideoID(s string) bool {
+	return videoIDPattern.MatchString(s)
+}
+
+// ParseURL parses any supported YouTube URL shape (watch, shorts, live,
+// embed, youtu.be, attribution_link, mobile/music/nocookie hosts) or a bare
+// 11-character video ID. Hosts other than YouTube are rejected.
+func ParseURL(raw string) (*VideoRef, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, ErrInvalidURL
+	}
+
+	// 프론트엔드가 이미 ID만 넘긴 경우
+	if IsVideoID(raw) {
+		return &VideoRef{VideoID: raw}, nil
+	}
+
+	u, err := parseLooseURL(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(u.Hostname())
+	query := u.Query()
+	ref := &VideoRef{}
+
+	switch {
+	case host == "youtu.be" || host == "www.youtu.be":
+		ref.VideoID = firstPathSegment(u.Path)
+	case youtubeHosts[host]:
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		switch segments[0] {
+		case "watch":
+			ref.VideoID = query.Get("v")
+		case "shorts", "embed", "v", "e":
+			ref.VideoID = segmentAt(segments, 1)
+		case "live":
+			ref.VideoID = segmentAt(segments, 1)
+			ref.Live = true
+		case "attribution_link":
+			// /attribution_link?a=...&u=/watch%3Fv%3D<id>%26feature%3Dshare
+			inner := query.Get("u")
+			if inner == "" {
+				return nil, ErrInvalidURL
+			}
+			if strings.HasPrefix(inner, "/") {
+				inner = "https://www.youtube.com" + inner
+			}
+			return ParseURL(inner)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedHost, host)
+	}
+
+	if !IsVideoID(ref.VideoID) {
+		return nil, ErrInvalidURL
+	}
+
+	ref.PlaylistID = query.Get("list")
+	if index := query.Get("index"); index != "" {
+		if n, err := strconv.Atoi(index); err == nil && n > 0 {
+			ref.PlaylistIndex = n
+		}
+	}
+
+	ref.Start = parseStart(query, u.Fragment)
+	return ref, nil
+}
+
+// ExtractVideoID extracts YouTube video ID from URL
+func ExtractVideoID(videoURL string) (string, error) {
+	ref, err := ParseURL(videoURL)
+	if err != nil {
+		return "", err
+	}
+	return ref.VideoID, nil
+}
+
+// parseLooseURL accepts URLs with or without a scheme ("youtu.be/abc").
+func parseLooseURL(raw string) (*url.URL, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil, ErrInvalidURL
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, ErrInvalidURL
+	}
+	return u, nil
+}
+
+func firstPathSegment(path string) string {
+	return segmentAt(strings.Split(strings.Trim(path, "/"), "/"), 0)
+}
+
+func segmentAt(segments []string, i int) string {
+	if i < len(segments) {
+		return segments[i]
+	}
+	return ""
+}
+
+// parseStart reads the start offset from t=, start= or a #t= fragment.
+func parseStart(query url.Values, fragment string) time.Duration {
+	for _, v := range []string{query.Get("t"), query.Get("start")} {
+		if d, ok := parseTimestamp(v); ok {
+			return d
+		}
+	}
+	if strings.HasPrefix(fragment, "t=") {
+		if d, ok := parseTimestamp(strings.TrimPrefix(fragment, "t=")); ok {
+			return d
+		}
+	}
+	return 0
+}
+
+// parseTimestamp parses "90", "90s", "1m30s" and "1h2m3s".
+func parseTimestamp(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	m := timestampPattern.FindStringSubmatch(strings.ToLower(v))
+	if m == nil {
+		return 0, false
+	}
+	var total time.Duration
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	for i, unit := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return 0, false
+		}
+		total += time.Duration(n) * unit
+	}
+	return total, true
+}
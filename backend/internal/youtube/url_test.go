@@ -0,0 +1,115 @@
+package youtube
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  VideoRef
+	}{
+		{"raw id", "dQw4w9WgXcQ", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"watch", "https://www.youtube.com/watch?v=dQw4w9WgXcQ", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"watch without scheme", "youtube.com/watch?v=dQw4w9WgXcQ", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"watch http", "http://youtube.com/watch?feature=share&v=dQw4w9WgXcQ", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"mobile", "https://m.youtube.com/watch?v=dQw4w9WgXcQ", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"music", "https://music.youtube.com/watch?v=dQw4w9WgXcQ&feature=share", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"nocookie embed", "https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ?rel=0", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"embed", "https://www.youtube.com/embed/dQw4w9WgXcQ", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"legacy v", "https://www.youtube.com/v/dQw4w9WgXcQ", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"shorts", "https://www.youtube.com/shorts/6NNaHMzFnac", VideoRef{VideoID: "6NNaHMzFnac"}},
+		{"shorts with share param", "https://youtube.com/shorts/6NNaHMzFnac?si=abc123", VideoRef{VideoID: "6NNaHMzFnac"}},
+		{"live", "https://www.youtube.com/live/6NNaHMzFnac?feature=share", VideoRef{VideoID: "6NNaHMzFnac", Live: true}},
+		{"short link", "https://youtu.be/dQw4w9WgXcQ", VideoRef{VideoID: "dQw4w9WgXcQ"}},
+		{"short link with seconds", "https://youtu.be/dQw4w9WgXcQ?t=42", VideoRef{VideoID: "dQw4w9WgXcQ", Start: 42 * time.Second}},
+		{"timestamp h m s", "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=1h2m3s", VideoRef{VideoID: "dQw4w9WgXcQ", Start: time.Hour + 2*time.Minute + 3*time.Second}},
+		{"timestamp s suffix", "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=90s", VideoRef{VideoID: "dQw4w9WgXcQ", Start: 90 * time.Second}},
+		{"embed start", "https://www.youtube.com/embed/dQw4w9WgXcQ?start=30", VideoRef{VideoID: "dQw4w9WgXcQ", Start: 30 * time.Second}},
+		{"fragment timestamp", "https://www.youtube.com/watch?v=dQw4w9WgXcQ#t=1m5s", VideoRef{VideoID: "dQw4w9WgXcQ", Start: 65 * time.Second}},
+		{"playlist with index", "https://www.youtube.com/watch?v=dQw4w9WgXcQ&list=PLFgquLnL59alCl_2TQvOiD5Vgm1hCaGSI&index=3",
+			VideoRef{VideoID: "dQw4w9WgXcQ", PlaylistID: "PLFgquLnL59alCl_2TQvOiD5Vgm1hCaGSI", PlaylistIndex: 3}},
+		{"attribution link", "https://www.youtube.com/attribution_link?a=8g8kPrPIi-ecwIsS&u=/watch%3Fv%3DdQw4w9WgXcQ%26feature%3Dshare",
+			VideoRef{VideoID: "dQw4w9WgXcQ"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := ParseURL(tt.input)
+			assert.NoError(t, err)
+			if assert.NotNil(t, ref) {
+				assert.Equal(t, tt.want, *ref)
+			}
+		})
+	}
+}
+
+func TestParseURLRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"empty", "", ErrInvalidURL},
+		{"foreign host with v param", "https://evil.example/?v=dQw4w9WgXcQ", ErrUnsupportedHost},
+		{"lookalike host", "https://youtube.com.evil.example/watch?v=dQw4w9WgXcQ", ErrUnsupportedHost},
+		{"short id", "https://www.youtube.com/watch?v=abc", ErrInvalidURL},
+		{"long id", "https://youtu.be/dQw4w9WgXcQextra", ErrInvalidURL},
+		{"channel page", "https://www.youtube.com/@someone", ErrInvalidURL},
+		{"playlist only", "https://www.youtube.com/playlist?list=PLFgquLnL59alCl_2TQvOiD5Vgm1hCaGSI", ErrInvalidURL},
+		{"javascript scheme", "javascript:alert(1)", ErrInvalidURL},
+		{"attribution without target", "https://www.youtube.com/attribution_link?a=x", ErrInvalidURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseURL(tt.input)
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v, want %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestExtractVideoID(t *testing.T) {
+	id, err := ExtractVideoID("https://youtu.be/dQw4w9WgXcQ?si=share")
+	assert.NoError(t, err)
+	assert.Equal(t, "dQw4w9WgXcQ", id)
+}
+
+func TestParseCollectionURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  CollectionRef
+	}{
+		{"playlist", "https://www.youtube.com/playlist?list=PLFgquLnL59alCl_2TQvOiD5Vgm1hCaGSI",
+			CollectionRef{Kind: CollectionPlaylist, PlaylistID: "PLFgquLnL59alCl_2TQvOiD5Vgm1hCaGSI"}},
+		{"watch in playlist", "https://www.youtube.com/watch?v=dQw4w9WgXcQ&list=PLFgquLnL59alCl_2TQvOiD5Vgm1hCaGSI&index=2",
+			CollectionRef{Kind: CollectionPlaylist, PlaylistID: "PLFgquLnL59alCl_2TQvOiD5Vgm1hCaGSI"}},
+		{"handle", "https://www.youtube.com/@scamchannel", CollectionRef{Kind: CollectionChannel, Handle: "scamchannel"}},
+		{"handle videos tab", "https://m.youtube.com/@scamchannel/videos", CollectionRef{Kind: CollectionChannel, Handle: "scamchannel"}},
+		{"channel id", "https://www.youtube.com/channel/UC_x5XG1OV2P6uZZ5FSM9Ttw",
+			CollectionRef{Kind: CollectionChannel, ChannelID: "UC_x5XG1OV2P6uZZ5FSM9Ttw"}},
+		{"custom url", "https://www.youtube.com/c/GoogleDevelopers", CollectionRef{Kind: CollectionChannel, Username: "GoogleDevelopers"}},
+		{"legacy user", "https://www.youtube.com/user/GoogleDevelopers", CollectionRef{Kind: CollectionChannel, Username: "GoogleDevelopers"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := ParseCollectionURL(tt.input)
+			assert.NoError(t, err)
+			if assert.NotNil(t, ref) {
+				assert.Equal(t, tt.want, *ref)
+			}
+		})
+	}
+
+	_, err := ParseCollectionURL("https://www.youtube.com/watch?v=dQw4w9WgXcQ")
+	assert.ErrorIs(t, err, ErrInvalidURL)
+	_, err = ParseCollectionURL("https://evil.example/playlist?list=PL123")
+	assert.ErrorIs(t, err, ErrUnsupportedHost)
+}
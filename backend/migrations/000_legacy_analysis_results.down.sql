@@ -0,0 +1,7 @@
+-- 009에서 이관되지 않은 legacy 테이블만 원래 이름으로 되돌림
+DO $$
+BEGIN
+    IF to_regclass('analysis_results_legacy') IS NOT NULL AND to_regclass('analysis_results') IS NULL THEN
+        ALTER TABLE analysis_results_legacy RENAME TO analysis_results;
+    END IF;
+END $$;
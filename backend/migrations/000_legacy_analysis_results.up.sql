@@ -0,0 +1,12 @@
+-- ML 파이프라인(003)의 스키마로 먼저 만들어진 analysis_results가 있으면 001이 만드는
+-- Job 결과 테이블과 이름이 겹치므로 analysis_results_legacy로 옮겨 둔다.
+-- 009_unify_analysis_results에서 통합 스키마로 이관한다.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_schema = current_schema() AND table_name = 'analysis_results' AND column_name = 'final_score')
+       AND NOT EXISTS (SELECT 1 FROM information_schema.columns
+                       WHERE table_schema = current_schema() AND table_name = 'analysis_results' AND column_name = 'safety_score') THEN
+        ALTER TABLE analysis_results RENAME TO analysis_results_legacy;
+    END IF;
+END $$;
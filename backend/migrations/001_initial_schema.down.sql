@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS analysis_history;
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS comments;
+DROP TABLE IF EXISTS captions;
+DROP TABLE IF EXISTS analysis_results;
+DROP TABLE IF EXISTS analysis_jobs;
+DROP TABLE IF EXISTS videos;
@@ -0,0 +1,8 @@
+-- 009 down이 통합 컬럼을 이미 제거했으므로 남은 호환용 컬럼만 제거
+ALTER TABLE analysis_results
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS final_score,
+    DROP COLUMN IF EXISTS context_score,
+    DROP COLUMN IF EXISTS video_score,
+    DROP COLUMN IF EXISTS audio_score,
+    DROP COLUMN IF EXISTS video_id;
@@ -0,0 +1,20 @@
+-- 003은 ML 파이프라인 스키마로 analysis_results를 CREATE TABLE IF NOT EXISTS 하지만,
+-- 001이 같은 이름의 Job 결과 테이블을 먼저 만들기 때문에 003의 인덱스/코멘트가 참조하는
+-- 컬럼이 없다. 003을 수정하면 체크섬이 바뀌므로, 여기서 해당 컬럼을 미리 추가한다.
+-- status/final_score는 020에서 제거하고, 나머지는 009의 통합 스키마에서 그대로 사용한다.
+DO $$
+BEGIN
+    -- 009 이후(source 컬럼 존재)에 늦게 적용되는 경우에는 아무것도 하지 않음
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_schema = current_schema() AND table_name = 'analysis_results' AND column_name = 'source') THEN
+        RETURN;
+    END IF;
+
+    ALTER TABLE analysis_results
+        ADD COLUMN IF NOT EXISTS video_id VARCHAR(255),
+        ADD COLUMN IF NOT EXISTS audio_score DECIMAL(5,3),
+        ADD COLUMN IF NOT EXISTS video_score DECIMAL(5,3),
+        ADD COLUMN IF NOT EXISTS context_score DECIMAL(5,3),
+        ADD COLUMN IF NOT EXISTS final_score INTEGER,
+        ADD COLUMN IF NOT EXISTS status VARCHAR(50);
+END $$;
@@ -0,0 +1,4 @@
+-- 테이블은 001이 소유하므로 이 마이그레이션이 만든 인덱스만 제거
+DROP INDEX IF EXISTS idx_analysis_results_video_id;
+DROP INDEX IF EXISTS idx_analysis_results_status;
+DROP INDEX IF EXISTS idx_analysis_results_created_at;
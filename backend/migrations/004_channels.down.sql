@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_videos_channel;
+ALTER TABLE videos DROP COLUMN IF EXISTS channel_id;
+DROP TABLE IF EXISTS channels;
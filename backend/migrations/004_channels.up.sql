@@ -0,0 +1,21 @@
+-- 채널 평판 정보 (사칭 채널 탐지용)
+CREATE TABLE IF NOT EXISTS channels (
+    channel_id VARCHAR(64) PRIMARY KEY,
+    title TEXT NOT NULL,
+    custom_url VARCHAR(255) NOT NULL DEFAULT '',
+    country VARCHAR(8) NOT NULL DEFAULT '',
+    subscriber_count BIGINT NOT NULL DEFAULT 0,
+    video_count BIGINT NOT NULL DEFAULT 0,
+    hidden_subscriber_count BOOLEAN NOT NULL DEFAULT FALSE,
+    verified BOOLEAN NOT NULL DEFAULT FALSE,
+    channel_created_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_channels_created ON channels(channel_created_at DESC);
+
+-- 영상 -> 채널 연결
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS channel_id VARCHAR(64);
+CREATE INDEX IF NOT EXISTS idx_videos_channel ON videos(channel_id);
+
+COMMENT ON COLUMN channels.verified IS '휴대폰 인증 여부 (status.longUploadsStatus = allowed)';
@@ -0,0 +1,11 @@
+-- 영상 썸네일 (S3 미러링 시 미러 URL 저장)
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS thumbnail_url TEXT;
+
+-- 기존 데이터 백필: YouTube는 모든 영상 ID에 대해 hqdefault 썸네일을 제공
+UPDATE videos
+SET thumbnail_url = 'https://i.ytimg.com/vi/' || video_id || '/hqdefault.jpg'
+WHERE thumbnail_url IS NULL;
+
+UPDATE analysis_history
+SET thumbnail_url = 'https://i.ytimg.com/vi/' || video_id || '/hqdefault.jpg'
+WHERE COALESCE(thumbnail_url, '') = '';
@@ -0,0 +1,3 @@
+-- video_id 길이는 되돌리지 않음 (다른 플랫폼의 긴 ID가 이미 저장되어 있을 수 있음)
+DROP INDEX IF EXISTS idx_videos_platform;
+ALTER TABLE videos DROP COLUMN IF EXISTS platform;
@@ -0,0 +1,11 @@
+-- 멀티 플랫폼 지원 (YouTube 외 TikTok, Instagram Reels, Facebook, Naver TV)
+-- YouTube는 기존처럼 11자리 ID를, 그 외 플랫폼은 "platform:id" 형식으로 video_id에 저장
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS platform VARCHAR(32) NOT NULL DEFAULT 'youtube';
+CREATE INDEX IF NOT EXISTS idx_videos_platform ON videos(platform);
+
+-- video_id 길이 제한 완화 (VARCHAR(20) -> VARCHAR(255))
+ALTER TABLE videos ALTER COLUMN video_id TYPE VARCHAR(255);
+ALTER TABLE analysis_jobs ALTER COLUMN video_id TYPE VARCHAR(255);
+ALTER TABLE captions ALTER COLUMN video_id TYPE VARCHAR(255);
+ALTER TABLE comments ALTER COLUMN video_id TYPE VARCHAR(255);
+ALTER TABLE analysis_history ALTER COLUMN video_id TYPE VARCHAR(255);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_jobs_batch;
+ALTER TABLE analysis_jobs DROP COLUMN IF EXISTS batch_id;
+DROP TABLE IF EXISTS analysis_batches;
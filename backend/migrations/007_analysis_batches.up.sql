@@ -0,0 +1,17 @@
+-- 재생목록/채널 일괄 분석 (부모 배치 + 자식 Job)
+CREATE TABLE IF NOT EXISTS analysis_batches (
+    batch_id UUID PRIMARY KEY,
+    user_id INT REFERENCES users(id),
+    kind VARCHAR(20) NOT NULL,            -- playlist, channel
+    source_url TEXT NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    channel_id VARCHAR(64) NOT NULL DEFAULT '',
+    total INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'processing',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_batches_user ON analysis_batches(user_id, created_at DESC);
+
+ALTER TABLE analysis_jobs ADD COLUMN IF NOT EXISTS batch_id UUID REFERENCES analysis_batches(batch_id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_jobs_batch ON analysis_jobs(batch_id);
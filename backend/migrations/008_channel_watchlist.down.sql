@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS watch_alerts;
+DROP TABLE IF EXISTS channel_watches;
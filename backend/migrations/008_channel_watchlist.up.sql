@@ -0,0 +1,29 @@
+-- 보호자가 구독한 채널 (새 업로드를 주기적으로 분석)
+CREATE TABLE IF NOT EXISTS channel_watches (
+    watch_id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel_id VARCHAR(64) NOT NULL,
+    channel_title TEXT NOT NULL DEFAULT '',
+    uploads_playlist_id VARCHAR(64) NOT NULL DEFAULT '',
+    last_upload_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 이 시각 이후 게시된 영상만 새 업로드로 취급
+    last_checked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, channel_id)
+);
+CREATE INDEX IF NOT EXISTS idx_watches_channel ON channel_watches(channel_id);
+
+-- 구독 채널의 위험 업로드 알림
+CREATE TABLE IF NOT EXISTS watch_alerts (
+    alert_id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel_id VARCHAR(64) NOT NULL,
+    video_id VARCHAR(255) NOT NULL,
+    job_id UUID REFERENCES analysis_jobs(job_id) ON DELETE CASCADE,
+    video_title TEXT NOT NULL DEFAULT '',
+    safety_score INT NOT NULL,
+    verdict VARCHAR(20) NOT NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, video_id)
+);
+CREATE INDEX IF NOT EXISTS idx_alerts_user ON watch_alerts(user_id, created_at DESC);
@@ -0,0 +1,19 @@
+-- 업로드 결과(pipeline)는 되돌릴 곳이 없으므로 함께 삭제된다
+DELETE FROM analysis_results WHERE source = 'pipeline';
+DELETE FROM analysis_jobs WHERE upload_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_results_upload;
+DROP INDEX IF EXISTS idx_results_video;
+ALTER TABLE analysis_results
+    DROP CONSTRAINT IF EXISTS fk_results_video,
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS source,
+    DROP COLUMN IF EXISTS context_score,
+    DROP COLUMN IF EXISTS video_score,
+    DROP COLUMN IF EXISTS audio_score,
+    DROP COLUMN IF EXISTS upload_id,
+    DROP COLUMN IF EXISTS video_id;
+
+DROP INDEX IF EXISTS idx_jobs_upload;
+ALTER TABLE analysis_jobs DROP COLUMN IF EXISTS upload_id;
+DROP TABLE IF EXISTS uploads;
@@ -0,0 +1,93 @@
+-- 분석 결과 통합: 모든 결과는 Job 단위로 저장하고, 영상(videos) 또는 업로드(uploads)에 연결한다.
+--   - Gemini(YouTube 등 URL 분석): safety_score + categories/gemini_response
+--   - ML 파이프라인(S3 업로드): audio/video/context 신호별 점수 + safety_score(= 100 - final_score)
+
+-- 1. 사용자가 S3에 올린 영상
+CREATE TABLE IF NOT EXISTS uploads (
+    upload_id UUID PRIMARY KEY,
+    user_id INT REFERENCES users(id) ON DELETE SET NULL,
+    s3_bucket VARCHAR(255) NOT NULL,
+    s3_key VARCHAR(512) NOT NULL UNIQUE,
+    filename TEXT NOT NULL DEFAULT '',
+    content_type VARCHAR(100) NOT NULL DEFAULT '',
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_uploads_user ON uploads(user_id, created_at DESC);
+
+ALTER TABLE analysis_jobs ADD COLUMN IF NOT EXISTS upload_id UUID REFERENCES uploads(upload_id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_jobs_upload ON analysis_jobs(upload_id);
+
+-- 2. 결과 테이블 확장
+ALTER TABLE analysis_results
+    ADD COLUMN IF NOT EXISTS video_id VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS upload_id UUID REFERENCES uploads(upload_id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS audio_score DECIMAL(5,3),
+    ADD COLUMN IF NOT EXISTS video_score DECIMAL(5,3),
+    ADD COLUMN IF NOT EXISTS context_score DECIMAL(5,3),
+    ADD COLUMN IF NOT EXISTS source VARCHAR(20) NOT NULL DEFAULT 'gemini',
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+-- 기존 Gemini 결과: Job의 영상으로 연결 (videos에 없는 ID는 연결하지 않음)
+UPDATE analysis_results ar
+SET video_id = aj.video_id
+FROM analysis_jobs aj
+WHERE ar.job_id = aj.job_id
+  AND ar.video_id IS NULL
+  AND EXISTS (SELECT 1 FROM videos v WHERE v.video_id = aj.video_id);
+
+ALTER TABLE analysis_results
+    ADD CONSTRAINT fk_results_video FOREIGN KEY (video_id) REFERENCES videos(video_id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_results_video ON analysis_results(video_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_results_upload ON analysis_results(upload_id, created_at DESC);
+
+-- 3. ML 파이프라인 결과(001 이전에 만들어져 legacy로 옮겨진 테이블)를 이관
+--    업로드 1건당 업로드 행 + Job + (완료된 경우) 결과를 만든다.
+DO $$
+BEGIN
+    IF to_regclass('analysis_results_legacy') IS NULL THEN
+        RETURN;
+    END IF;
+
+    CREATE TEMP TABLE legacy_map ON COMMIT DROP AS
+    SELECT l.*,
+           CASE WHEN l.video_id ~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$'
+                THEN l.video_id::uuid ELSE gen_random_uuid() END AS new_upload_id,
+           gen_random_uuid() AS new_job_id
+    FROM analysis_results_legacy l;
+
+    INSERT INTO uploads (upload_id, s3_bucket, s3_key, created_at)
+    SELECT new_upload_id, s3_bucket, s3_key, created_at FROM legacy_map
+    ON CONFLICT DO NOTHING;
+
+    -- 같은 s3_key가 이미 있으면 그 업로드에 연결
+    UPDATE legacy_map m SET new_upload_id = u.upload_id
+    FROM uploads u WHERE u.s3_key = m.s3_key;
+
+    -- 완료됐지만 final_score가 없는 결과는 점수를 지어내지 않고 실패한 Job으로 남긴다
+    INSERT INTO analysis_jobs (job_id, upload_id, status, progress, created_at, started_at, completed_at, error_message)
+    SELECT new_job_id, new_upload_id,
+           CASE WHEN status = 'completed' AND final_score IS NULL THEN 'failed'
+                WHEN status IN ('completed', 'failed') THEN status ELSE 'processing' END,
+           CASE WHEN status = 'completed' AND final_score IS NOT NULL THEN 100 ELSE 0 END,
+           created_at, created_at,
+           CASE WHEN status IN ('completed', 'failed') THEN updated_at END,
+           CASE WHEN status = 'completed' AND final_score IS NULL THEN 'legacy pipeline result has no final_score' END
+    FROM legacy_map;
+
+    INSERT INTO analysis_results (job_id, upload_id, safety_score, audio_score, video_score, context_score,
+                                  source, created_at, updated_at)
+    SELECT new_job_id, new_upload_id, 100 - final_score, audio_score, video_score, context_score,
+           'pipeline', created_at, updated_at
+    FROM legacy_map
+    WHERE status = 'completed' AND final_score IS NOT NULL;
+
+    DROP TABLE analysis_results_legacy;
+END $$;
+
+COMMENT ON COLUMN analysis_results.safety_score IS '안전 점수 (0 = 사기 확실, 100 = 안전)';
+COMMENT ON COLUMN analysis_results.audio_score IS '오디오 딥페이크 확률 (0.0-1.0, ML 파이프라인)';
+COMMENT ON COLUMN analysis_results.video_score IS '비디오 조작 확률 (0.0-1.0, ML 파이프라인)';
+COMMENT ON COLUMN analysis_results.context_score IS '컨텍스트 사기 확률 (0.0-1.0, ML 파이프라인)';
+COMMENT ON COLUMN analysis_results.source IS 'gemini | pipeline';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_history_user_cursor;
+DROP INDEX IF EXISTS idx_history_job;
+ALTER TABLE analysis_history DROP COLUMN IF EXISTS job_id;
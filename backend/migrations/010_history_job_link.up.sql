@@ -0,0 +1,18 @@
+-- History 행을 유저가 실제로 실행한 Job에 연결
+-- (기존에는 같은 영상의 "최신 Job" 점수를 보여줘서, 다른 사람이 재분석하면 내 기록의 점수가 바뀜)
+ALTER TABLE analysis_history ADD COLUMN IF NOT EXISTS job_id UUID REFERENCES analysis_jobs(job_id) ON DELETE SET NULL;
+
+-- 기존 행: 기록 시각 이전에 생성된 같은 영상의 가장 최근 Job으로 연결
+UPDATE analysis_history ah
+SET job_id = (
+    SELECT aj.job_id FROM analysis_jobs aj
+    WHERE aj.video_id = ah.video_id AND aj.created_at <= ah.created_at
+    ORDER BY aj.created_at DESC
+    LIMIT 1
+)
+WHERE ah.job_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_history_job ON analysis_history(job_id);
+
+-- 키셋 페이지네이션: (created_at, id) 내림차순
+CREATE INDEX IF NOT EXISTS idx_history_user_cursor ON analysis_history(user_id, created_at DESC, id DESC);
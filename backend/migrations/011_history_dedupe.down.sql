@@ -0,0 +1,2 @@
+ALTER TABLE analysis_history DROP CONSTRAINT IF EXISTS uq_history_user_video;
+ALTER TABLE analysis_history DROP COLUMN IF EXISTS check_count;
@@ -0,0 +1,23 @@
+-- 유저당 영상 하나의 History 행만 유지 (재검사 시 최신 분석으로 갱신하고 횟수 증가)
+ALTER TABLE analysis_history ADD COLUMN IF NOT EXISTS check_count INT NOT NULL DEFAULT 1;
+
+-- 기존 중복 행 정리: 가장 최근 행만 남기고 나머지 개수를 check_count로 합산
+WITH ranked AS (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY user_id, video_id ORDER BY created_at DESC, id DESC) AS rn,
+           COUNT(*) OVER (PARTITION BY user_id, video_id) AS total
+    FROM analysis_history
+)
+UPDATE analysis_history ah
+SET check_count = ranked.total
+FROM ranked
+WHERE ah.id = ranked.id AND ranked.rn = 1 AND ranked.total > 1;
+
+DELETE FROM analysis_history ah
+USING (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id, video_id ORDER BY created_at DESC, id DESC) AS rn
+    FROM analysis_history
+) ranked
+WHERE ah.id = ranked.id AND ranked.rn > 1;
+
+ALTER TABLE analysis_history ADD CONSTRAINT uq_history_user_video UNIQUE (user_id, video_id);
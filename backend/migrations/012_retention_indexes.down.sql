@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_uploads_created;
+DROP INDEX IF EXISTS idx_comments_analyzed;
+DROP INDEX IF EXISTS idx_captions_created;
@@ -0,0 +1,4 @@
+-- 보관 기간 정리(purge) 작업용 인덱스: 오래된 행을 시각 기준으로 찾는다
+CREATE INDEX IF NOT EXISTS idx_captions_created ON captions(created_at);
+CREATE INDEX IF NOT EXISTS idx_comments_analyzed ON comments(analyzed_at);
+CREATE INDEX IF NOT EXISTS idx_uploads_created ON uploads(created_at);
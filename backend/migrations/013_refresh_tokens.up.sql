@@ -0,0 +1,15 @@
+-- 리프레시 토큰 (원문은 저장하지 않고 SHA-256 해시만 보관)
+-- 사용할 때마다 같은 family의 새 토큰으로 교체하고, 이미 사용된 토큰이 다시 오면 탈취로 보고 family 전체를 폐기한다.
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    family_id UUID NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,    -- 교체되어 더 이상 쓸 수 없음
+    revoked_at TIMESTAMPTZ, -- 로그아웃/탈취 감지로 폐기
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user ON refresh_tokens(user_id) WHERE revoked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family ON refresh_tokens(family_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires ON refresh_tokens(expires_at);
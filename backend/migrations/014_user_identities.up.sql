@@ -0,0 +1,19 @@
+-- 로그인 계정 연결: 한 사용자가 구글/카카오/네이버 계정을 함께 사용
+-- users.provider_id는 구글 전용 시절의 값으로, 이 테이블로 옮긴 뒤 더 이상 갱신하지 않는다.
+CREATE TABLE IF NOT EXISTS user_identities (
+    identity_id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(20) NOT NULL,  -- google, kakao, naver
+    subject VARCHAR(255) NOT NULL,  -- 제공자의 계정 ID
+    email VARCHAR(255),             -- 연결 당시 제공자가 알려준 이메일
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_login_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (provider, subject)
+);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user ON user_identities(user_id);
+
+-- 기존 구글 사용자
+INSERT INTO user_identities (user_id, provider, subject, email)
+SELECT id, 'google', provider_id, email FROM users
+WHERE provider_id IS NOT NULL AND provider_id <> ''
+ON CONFLICT (provider, subject) DO NOTHING;
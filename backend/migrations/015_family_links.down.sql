@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS family_links;
+DROP TABLE IF EXISTS family_invites;
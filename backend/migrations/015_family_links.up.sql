@@ -0,0 +1,27 @@
+-- 가족 연결: 보호자가 일회용 코드로 어르신을 초대하고, 어르신이 승인하면
+-- 보호자가 어르신의 분석 기록(과 역할에 따라 위험 알림)을 볼 수 있다.
+CREATE TABLE IF NOT EXISTS family_invites (
+    invite_id BIGSERIAL PRIMARY KEY,
+    guardian_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash CHAR(64) NOT NULL UNIQUE,   -- 코드 원문은 저장하지 않음 (SHA-256)
+    role VARCHAR(20) NOT NULL,            -- 승인되면 연결에 부여할 역할
+    expires_at TIMESTAMPTZ NOT NULL,
+    claimed_by INT REFERENCES users(id) ON DELETE CASCADE,
+    claimed_at TIMESTAMPTZ,               -- 한 번 사용하면 재사용 불가
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_family_invites_expires ON family_invites(expires_at);
+
+CREATE TABLE IF NOT EXISTS family_links (
+    link_id BIGSERIAL PRIMARY KEY,
+    guardian_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    senior_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL,                        -- guardian: 기록+알림, viewer: 기록만
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',    -- pending, active, declined, revoked
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    approved_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    UNIQUE (guardian_id, senior_id),
+    CHECK (guardian_id <> senior_id)
+);
+CREATE INDEX IF NOT EXISTS idx_family_links_senior ON family_links(senior_id);
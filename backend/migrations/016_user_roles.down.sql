@@ -0,0 +1,5 @@
+COMMENT ON COLUMN analysis_results.source IS 'gemini | pipeline';
+ALTER TABLE analysis_results DROP COLUMN IF EXISTS review_reason;
+ALTER TABLE analysis_results DROP COLUMN IF EXISTS reviewed_by;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
@@ -0,0 +1,11 @@
+-- 사용자 역할: 운영 작업(재분석, 사용자 조회, 판정 변경)을 SQL 대신 관리자 API로
+-- user: 일반 사용자, guardian: 보호자 계정, reviewer: 판정 검토, admin: 전체 관리
+-- 첫 관리자는 직접 지정: UPDATE users SET role = 'admin' WHERE email = '...';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'user';
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('user', 'guardian', 'reviewer', 'admin'));
+
+-- 검토자가 바꾼 판정은 source = 'review'인 새 결과로 저장 (가장 최신 결과가 우선)
+ALTER TABLE analysis_results ADD COLUMN IF NOT EXISTS reviewed_by INT REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE analysis_results ADD COLUMN IF NOT EXISTS review_reason TEXT;
+COMMENT ON COLUMN analysis_results.source IS 'gemini | pipeline | review';
@@ -0,0 +1,22 @@
+-- 파트너(은행, 복지관 등) 시스템 연동용 API 키
+-- 키 원문은 발급 시 한 번만 보여주고 SHA-256 해시만 저장
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,             -- 파트너/용도
+    prefix VARCHAR(16) NOT NULL,            -- 키 앞부분 (목록에서 구분용)
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',    -- analysis:read, analysis:write
+    daily_quota INT NOT NULL DEFAULT 0,     -- 하루 요청 수 상한 (0 = 무제한)
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+-- 키별 일일 사용량 (UTC 기준)
+CREATE TABLE IF NOT EXISTS api_key_usage (
+    key_id BIGINT NOT NULL REFERENCES api_keys(key_id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    request_count INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (key_id, day)
+);
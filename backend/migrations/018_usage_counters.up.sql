@@ -0,0 +1,9 @@
+-- 요금제 한도 확인용 사용자별 일일 사용량 (UTC 기준)
+-- feature: analyses (단일/일괄 분석한 영상 수)
+CREATE TABLE IF NOT EXISTS usage_counters (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    feature VARCHAR(32) NOT NULL,
+    day DATE NOT NULL,
+    used INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, feature, day)
+);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS billing_events;
+DROP INDEX IF EXISTS idx_subscriptions_paid_end_date;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS canceled_at;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS billing_ref;
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_status_check;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS status;
+ALTER TABLE subscriptions ALTER COLUMN plan_type DROP NOT NULL;
@@ -0,0 +1,21 @@
+-- 구독 상태: 업그레이드/해지/만료를 결제사 웹훅과 만료 작업이 기록
+-- active: 이용 중, canceled: 해지 예약 (end_date까지 유지), expired: 기간 종료로 free 전환
+UPDATE subscriptions SET plan_type = 'free' WHERE plan_type IS NULL;
+ALTER TABLE subscriptions ALTER COLUMN plan_type SET NOT NULL;
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active';
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_status_check;
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_status_check CHECK (status IN ('active', 'canceled', 'expired'));
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS billing_ref VARCHAR(255); -- 결제사 구독 ID
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS canceled_at TIMESTAMP;
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- 만료 작업: 기간이 끝난 유료 구독
+CREATE INDEX IF NOT EXISTS idx_subscriptions_paid_end_date ON subscriptions (end_date) WHERE plan_type <> 'free';
+
+-- 처리한 결제 웹훅 (같은 이벤트가 재전송되어도 한 번만 반영)
+CREATE TABLE IF NOT EXISTS billing_events (
+    event_id VARCHAR(255) PRIMARY KEY,
+    event_type VARCHAR(64) NOT NULL,
+    user_id INT REFERENCES users(id) ON DELETE SET NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
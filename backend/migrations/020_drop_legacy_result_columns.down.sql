@@ -0,0 +1,7 @@
+ALTER TABLE analysis_results
+    ADD COLUMN IF NOT EXISTS final_score INTEGER,
+    ADD COLUMN IF NOT EXISTS status VARCHAR(50);
+
+CREATE INDEX IF NOT EXISTS idx_analysis_results_video_id ON analysis_results(video_id);
+CREATE INDEX IF NOT EXISTS idx_analysis_results_status ON analysis_results(status);
+CREATE INDEX IF NOT EXISTS idx_analysis_results_created_at ON analysis_results(created_at DESC);
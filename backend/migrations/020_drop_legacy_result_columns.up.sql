@@ -0,0 +1,9 @@
+-- 002/003이 003의 ML 파이프라인 스키마를 위해 남긴 컬럼과 인덱스 정리
+-- (파이프라인 결과는 009부터 safety_score + 신호별 점수로 저장)
+DROP INDEX IF EXISTS idx_analysis_results_video_id;
+DROP INDEX IF EXISTS idx_analysis_results_status;
+DROP INDEX IF EXISTS idx_analysis_results_created_at;
+
+ALTER TABLE analysis_results
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS final_score;
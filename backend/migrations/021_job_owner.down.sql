@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_jobs_user;
+ALTER TABLE analysis_jobs DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,22 @@
+-- Job 소유자: 분석을 요청한 사용자 (익명/워치리스트 Job은 NULL)
+-- 취소 권한 확인에 사용
+ALTER TABLE analysis_jobs ADD COLUMN IF NOT EXISTS user_id INT REFERENCES users(id) ON DELETE SET NULL;
+
+-- 기존 행: 배치/업로드의 소유자, 없으면 Job에 연결된 기록의 사용자
+UPDATE analysis_jobs aj SET user_id = ab.user_id
+FROM analysis_batches ab
+WHERE aj.batch_id = ab.batch_id AND aj.user_id IS NULL;
+
+UPDATE analysis_jobs aj SET user_id = u.user_id
+FROM uploads u
+WHERE aj.upload_id = u.upload_id AND aj.user_id IS NULL;
+
+UPDATE analysis_jobs aj SET user_id = (
+    SELECT ah.user_id FROM analysis_history ah
+    WHERE ah.job_id = aj.job_id
+    ORDER BY ah.created_at
+    LIMIT 1
+)
+WHERE aj.user_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_user ON analysis_jobs(user_id);
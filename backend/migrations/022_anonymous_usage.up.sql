@@ -0,0 +1,9 @@
+-- 비회원 일일 사용량 (클라이언트 IP 단위, UTC 기준)
+-- 비회원은 usage_counters(user_id)로 집계할 수 없으므로 별도 테이블
+CREATE TABLE IF NOT EXISTS anonymous_usage (
+    client_ip VARCHAR(45) NOT NULL, -- IPv6 최대 길이
+    feature VARCHAR(32) NOT NULL,
+    day DATE NOT NULL,
+    used INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (client_ip, feature, day)
+);
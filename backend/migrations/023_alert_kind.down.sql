@@ -0,0 +1,7 @@
+-- 종류 구분이 없던 때의 고유 키로 되돌리기 위해, 채널 알림과 겹치는 가족 알림은 삭제
+DELETE FROM watch_alerts f
+USING watch_alerts w
+WHERE f.kind = 'family' AND w.kind = 'watch' AND f.user_id = w.user_id AND f.video_id = w.video_id;
+ALTER TABLE watch_alerts DROP CONSTRAINT IF EXISTS watch_alerts_user_id_kind_video_id_key;
+ALTER TABLE watch_alerts ADD CONSTRAINT watch_alerts_user_id_video_id_key UNIQUE (user_id, video_id);
+ALTER TABLE watch_alerts DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,6 @@
+-- 알림 종류: watch(구독 채널의 새 업로드), family(보호자가 있는 어르신이 직접 분석한 위험 영상)
+-- 같은 영상이라도 종류별로 따로 기록해야 채널 알림이 가족 알림을 가리지 않는다.
+ALTER TABLE watch_alerts ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'watch';
+ALTER TABLE watch_alerts DROP CONSTRAINT IF EXISTS watch_alerts_user_id_video_id_key;
+ALTER TABLE watch_alerts DROP CONSTRAINT IF EXISTS watch_alerts_user_id_kind_video_id_key;
+ALTER TABLE watch_alerts ADD CONSTRAINT watch_alerts_user_id_kind_video_id_key UNIQUE (user_id, kind, video_id);
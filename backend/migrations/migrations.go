@@ -0,0 +1,10 @@
+// Package migrations embeds the versioned SQL migrations so the server
+// binary can apply them without the files on disk.
+//
+// Files are named NNN_name.up.sql / NNN_name.down.sql.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -22,10 +22,11 @@ const (
 )
 
 type AnalysisRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoUrl      string                 `protobuf:"bytes,1,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
-	Options       *AnalysisOptions       `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	VideoUrl string                 `protobuf:"bytes,1,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	Options  *AnalysisOptions       `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,6 +75,7 @@ func (x *AnalysisRequest) GetOptions() *AnalysisOptions {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/analysis.proto.
 func (x *AnalysisRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
@@ -248,10 +250,11 @@ func (x *ProgressRequest) GetJobId() string {
 type ProgressEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "log", "progress", "complete", "error"
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "log", "progress", "verdict", "complete", "error"
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	Progress      int32                  `protobuf:"varint,4,opt,name=progress,proto3" json:"progress,omitempty"`
 	Timestamp     string                 `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SafetyScore   int32                  `protobuf:"varint,6,opt,name=safety_score,json=safetyScore,proto3" json:"safety_score,omitempty"` // "verdict" 이벤트: 라이브 모니터링 중 갱신된 점수
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -321,6 +324,13 @@ func (x *ProgressEvent) GetTimestamp() string {
 	return ""
 }
 
+func (x *ProgressEvent) GetSafetyScore() int32 {
+	if x != nil {
+		return x.SafetyScore
+	}
+	return 0
+}
+
 type ResultRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -489,6 +499,10 @@ type VideoMetadata struct {
 	Duration      int64                  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
 	ViewCount     int64                  `protobuf:"varint,5,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
 	PublishedAt   string                 `protobuf:"bytes,6,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelInfo   *ChannelInfo           `protobuf:"bytes,8,opt,name=channel_info,json=channelInfo,proto3" json:"channel_info,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,9,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Platform      string                 `protobuf:"bytes,10,opt,name=platform,proto3" json:"platform,omitempty"` // youtube, tiktok, instagram, facebook, navertv
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -565,6 +579,143 @@ func (x *VideoMetadata) GetPublishedAt() string {
 	return ""
 }
 
+func (x *VideoMetadata) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetChannelInfo() *ChannelInfo {
+	if x != nil {
+		return x.ChannelInfo
+	}
+	return nil
+}
+
+func (x *VideoMetadata) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+// 채널 평판 정보
+type ChannelInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId       string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CustomUrl       string                 `protobuf:"bytes,3,opt,name=custom_url,json=customUrl,proto3" json:"custom_url,omitempty"`
+	Country         string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	SubscriberCount int64                  `protobuf:"varint,5,opt,name=subscriber_count,json=subscriberCount,proto3" json:"subscriber_count,omitempty"`
+	VideoCount      int64                  `protobuf:"varint,6,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	Verified        bool                   `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RiskSignals     []string               `protobuf:"bytes,9,rep,name=risk_signals,json=riskSignals,proto3" json:"risk_signals,omitempty"` // 예: "신규 채널이 '국민은행'을(를) 사칭하는 것으로 의심됨"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChannelInfo) Reset() {
+	*x = ChannelInfo{}
+	mi := &file_proto_analysis_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInfo) ProtoMessage() {}
+
+func (x *ChannelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelInfo.ProtoReflect.Descriptor instead.
+func (*ChannelInfo) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChannelInfo) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelInfo) GetCustomUrl() string {
+	if x != nil {
+		return x.CustomUrl
+	}
+	return ""
+}
+
+func (x *ChannelInfo) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ChannelInfo) GetSubscriberCount() int64 {
+	if x != nil {
+		return x.SubscriberCount
+	}
+	return 0
+}
+
+func (x *ChannelInfo) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *ChannelInfo) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ChannelInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ChannelInfo) GetRiskSignals() []string {
+	if x != nil {
+		return x.RiskSignals
+	}
+	return nil
+}
+
 type Comment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Author        string                 `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
@@ -577,7 +728,7 @@ type Comment struct {
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_proto_analysis_proto_msgTypes[8]
+	mi := &file_proto_analysis_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -589,7 +740,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[8]
+	mi := &file_proto_analysis_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +753,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{8}
+	return file_proto_analysis_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Comment) GetAuthor() string {
@@ -642,7 +793,7 @@ type CancelRequest struct {
 
 func (x *CancelRequest) Reset() {
 	*x = CancelRequest{}
-	mi := &file_proto_analysis_proto_msgTypes[9]
+	mi := &file_proto_analysis_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +805,7 @@ func (x *CancelRequest) String() string {
 func (*CancelRequest) ProtoMessage() {}
 
 func (x *CancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[9]
+	mi := &file_proto_analysis_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +818,7 @@ func (x *CancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
 func (*CancelRequest) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{9}
+	return file_proto_analysis_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CancelRequest) GetJobId() string {
@@ -688,7 +839,7 @@ type CancelResponse struct {
 
 func (x *CancelResponse) Reset() {
 	*x = CancelResponse{}
-	mi := &file_proto_analysis_proto_msgTypes[10]
+	mi := &file_proto_analysis_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -700,7 +851,7 @@ func (x *CancelResponse) String() string {
 func (*CancelResponse) ProtoMessage() {}
 
 func (x *CancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[10]
+	mi := &file_proto_analysis_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +864,7 @@ func (x *CancelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
 func (*CancelResponse) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{10}
+	return file_proto_analysis_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CancelResponse) GetJobId() string {
@@ -746,7 +897,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_proto_analysis_proto_msgTypes[11]
+	mi := &file_proto_analysis_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -758,7 +909,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[11]
+	mi := &file_proto_analysis_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +922,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{11}
+	return file_proto_analysis_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LoginRequest) GetIdToken() string {
@@ -781,29 +932,32 @@ func (x *LoginRequest) GetIdToken() string {
 	return ""
 }
 
-type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // 서버가 발급한 JWT
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ProviderLoginRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Provider          string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                                            // "kakao", "naver", "google"
+	AuthorizationCode string                 `protobuf:"bytes,2,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code,omitempty"` // 카카오/네이버 인가 코드
+	RedirectUri       string                 `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`                   // 인가 요청에 사용한 redirect_uri (비우면 서버 설정값)
+	State             string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`                                                  // 네이버 필수
+	IdToken           string                 `protobuf:"bytes,5,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`                               // google
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
-	mi := &file_proto_analysis_proto_msgTypes[12]
+func (x *ProviderLoginRequest) Reset() {
+	*x = ProviderLoginRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *ProviderLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*ProviderLoginRequest) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[12]
+func (x *ProviderLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,91 +968,70 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ProviderLoginRequest.ProtoReflect.Descriptor instead.
+func (*ProviderLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LoginResponse) GetAccessToken() string {
+func (x *ProviderLoginRequest) GetProvider() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *LoginResponse) GetUser() *User {
+func (x *ProviderLoginRequest) GetAuthorizationCode() string {
 	if x != nil {
-		return x.User
+		return x.AuthorizationCode
 	}
-	return nil
-}
-
-type GetProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // JWT에서 추출하거나 프론트가 보낸 ID
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetProfileRequest) Reset() {
-	*x = GetProfileRequest{}
-	mi := &file_proto_analysis_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetProfileRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetProfileRequest) ProtoMessage() {}
-
-func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[13]
+func (x *ProviderLoginRequest) GetRedirectUri() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RedirectUri
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{13}
+func (x *ProviderLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (x *GetProfileRequest) GetUserId() string {
+func (x *ProviderLoginRequest) GetIdToken() string {
 	if x != nil {
-		return x.UserId
+		return x.IdToken
 	}
 	return ""
 }
 
-type UserProfileResponse struct {
+type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Subscription  *Subscription          `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // 서버가 발급한 JWT (짧은 유효 시간)
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 한 번 쓰면 교체됨, 응답의 새 토큰을 저장해야 함
+	ExpiresIn     int32                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`         // access_token 유효 시간 (초)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserProfileResponse) Reset() {
-	*x = UserProfileResponse{}
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
 	mi := &file_proto_analysis_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserProfileResponse) String() string {
+func (x *LoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfileResponse) ProtoMessage() {}
+func (*LoginResponse) ProtoMessage() {}
 
-func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_analysis_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -910,48 +1043,60 @@ func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfileResponse.ProtoReflect.Descriptor instead.
-func (*UserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
 	return file_proto_analysis_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UserProfileResponse) GetUser() *User {
+func (x *LoginResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetUser() *User {
 	if x != nil {
 		return x.User
 	}
 	return nil
 }
 
-func (x *UserProfileResponse) GetSubscription() *Subscription {
+func (x *LoginResponse) GetRefreshToken() string {
 	if x != nil {
-		return x.Subscription
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-type GetHistoryRequest struct {
+func (x *LoginResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type RefreshRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryRequest) Reset() {
-	*x = GetHistoryRequest{}
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
 	mi := &file_proto_analysis_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryRequest) String() string {
+func (x *RefreshRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryRequest) ProtoMessage() {}
+func (*RefreshRequest) ProtoMessage() {}
 
-func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_analysis_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -963,53 +1108,40 @@ func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
 	return file_proto_analysis_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetHistoryRequest) GetUserId() string {
+func (x *RefreshRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.UserId
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *GetHistoryRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetHistoryRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-type HistoryResponse struct {
+type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*HistoryItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 이 기기의 세션
+	AllDevices    bool                   `protobuf:"varint,2,opt,name=all_devices,json=allDevices,proto3" json:"all_devices,omitempty"`      // 모든 기기에서 로그아웃
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HistoryResponse) Reset() {
-	*x = HistoryResponse{}
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
 	mi := &file_proto_analysis_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HistoryResponse) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoryResponse) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_analysis_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1021,43 +1153,2922 @@ func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
-func (*HistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
 	return file_proto_analysis_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *HistoryResponse) GetItems() []*HistoryItem {
+func (x *LogoutRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Items
+		return x.RefreshToken
 	}
-	return nil
+	return ""
+}
+
+func (x *LogoutRequest) GetAllDevices() bool {
+	if x != nil {
+		return x.AllDevices
+	}
+	return false
+}
+
+type LogoutResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RevokedSessions int32                  `protobuf:"varint,1,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LogoutResponse) GetRevokedSessions() int32 {
+	if x != nil {
+		return x.RevokedSessions
+	}
+	return 0
+}
+
+type GetProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{18}
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *GetProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserProfileResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	User            *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Subscription    *Subscription          `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	LinkedProviders []string               `protobuf:"bytes,3,rep,name=linked_providers,json=linkedProviders,proto3" json:"linked_providers,omitempty"` // 연결된 로그인 계정 (google, kakao, naver)
+	Entitlements    *Entitlements          `protobuf:"bytes,4,opt,name=entitlements,proto3" json:"entitlements,omitempty"`                              // 현재 요금제의 이용 한도와 오늘 사용량
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserProfileResponse) Reset() {
+	*x = UserProfileResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfileResponse) ProtoMessage() {}
+
+func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfileResponse.ProtoReflect.Descriptor instead.
+func (*UserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserProfileResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserProfileResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *UserProfileResponse) GetLinkedProviders() []string {
+	if x != nil {
+		return x.LinkedProviders
+	}
+	return nil
+}
+
+func (x *UserProfileResponse) GetEntitlements() *Entitlements {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+// 요금제별 이용 한도 (만료된 구독은 free 기준)
+type Entitlements struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PlanType          string                 `protobuf:"bytes,1,opt,name=plan_type,json=planType,proto3" json:"plan_type,omitempty"`
+	AnalysesPerDay    int32                  `protobuf:"varint,2,opt,name=analyses_per_day,json=analysesPerDay,proto3" json:"analyses_per_day,omitempty"` // 0 = 무제한
+	AnalysesUsedToday int32                  `protobuf:"varint,3,opt,name=analyses_used_today,json=analysesUsedToday,proto3" json:"analyses_used_today,omitempty"`
+	UsageResetsAt     string                 `protobuf:"bytes,4,opt,name=usage_resets_at,json=usageResetsAt,proto3" json:"usage_resets_at,omitempty"` // 사용량 초기화 시각 (다음 UTC 자정, RFC3339)
+	MaxComments       int32                  `protobuf:"varint,5,opt,name=max_comments,json=maxComments,proto3" json:"max_comments,omitempty"`        // 0 = 제한 없음
+	Batch             bool                   `protobuf:"varint,6,opt,name=batch,proto3" json:"batch,omitempty"`                                       // 재생목록/채널 일괄 분석
+	Watchlist         bool                   `protobuf:"varint,7,opt,name=watchlist,proto3" json:"watchlist,omitempty"`                               // 채널 구독 알림
+	Priority          bool                   `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`                                 // 일괄 분석 동시 처리
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Entitlements) Reset() {
+	*x = Entitlements{}
+	mi := &file_proto_analysis_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entitlements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entitlements) ProtoMessage() {}
+
+func (x *Entitlements) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entitlements.ProtoReflect.Descriptor instead.
+func (*Entitlements) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Entitlements) GetPlanType() string {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
+func (x *Entitlements) GetAnalysesPerDay() int32 {
+	if x != nil {
+		return x.AnalysesPerDay
+	}
+	return 0
+}
+
+func (x *Entitlements) GetAnalysesUsedToday() int32 {
+	if x != nil {
+		return x.AnalysesUsedToday
+	}
+	return 0
+}
+
+func (x *Entitlements) GetUsageResetsAt() string {
+	if x != nil {
+		return x.UsageResetsAt
+	}
+	return ""
+}
+
+func (x *Entitlements) GetMaxComments() int32 {
+	if x != nil {
+		return x.MaxComments
+	}
+	return 0
+}
+
+func (x *Entitlements) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+func (x *Entitlements) GetWatchlist() bool {
+	if x != nil {
+		return x.Watchlist
+	}
+	return false
+}
+
+func (x *Entitlements) GetPriority() bool {
+	if x != nil {
+		return x.Priority
+	}
+	return false
+}
+
+type GetHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                           // 무시됨, page_token 사용
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 기본 10, 최대 100
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 이전 응답의 next_page_token (첫 페이지는 비움)
+	// 필터 (비어 있으면 적용 안 함)
+	MinScore      *int32 `protobuf:"varint,5,opt,name=min_score,json=minScore,proto3,oneof" json:"min_score,omitempty"` // 안전 점수 하한 (포함)
+	MaxScore      *int32 `protobuf:"varint,6,opt,name=max_score,json=maxScore,proto3,oneof" json:"max_score,omitempty"` // 안전 점수 상한 (포함)
+	From          string `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`                                // 분석 시각 하한 (포함), RFC3339 또는 YYYY-MM-DD
+	To            string `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`                                    // 분석 시각 상한 (제외), RFC3339 또는 YYYY-MM-DD
+	Verdict       string `protobuf:"bytes,9,opt,name=verdict,proto3" json:"verdict,omitempty"`                          // danger, caution, safe
+	SeniorId      int64  `protobuf:"varint,10,opt,name=senior_id,json=seniorId,proto3" json:"senior_id,omitempty"`      // 보호자가 연결된 어르신의 기록 조회 (0이면 내 기록)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{21}
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *GetHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *GetHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetMinScore() int32 {
+	if x != nil && x.MinScore != nil {
+		return *x.MinScore
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetMaxScore() int32 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetSeniorId() int64 {
+	if x != nil {
+		return x.SeniorId
+	}
+	return 0
+}
+
+type HistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*HistoryItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 마지막 페이지면 빈 문자열
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // 필터에 맞는 전체 항목 수
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryResponse) ProtoMessage() {}
+
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HistoryResponse) GetItems() []*HistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *HistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *HistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PictureUrl    string                 `protobuf:"bytes,4,opt,name=picture_url,json=pictureUrl,proto3" json:"picture_url,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"` // user, guardian, reviewer, admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_analysis_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetPictureUrl() string {
+	if x != nil {
+		return x.PictureUrl
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanType      string                 `protobuf:"bytes,1,opt,name=plan_type,json=planType,proto3" json:"plan_type,omitempty"` // 'free', 'pro'
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active, canceled (end_date까지 유지), expired
+	CanceledAt    string                 `protobuf:"bytes,5,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_proto_analysis_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Subscription) GetPlanType() string {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
+func (x *Subscription) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Subscription) GetCanceledAt() string {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return ""
+}
+
+type HistoryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	VideoTitle    string                 `protobuf:"bytes,2,opt,name=video_title,json=videoTitle,proto3" json:"video_title,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	AnalyzedAt    string                 `protobuf:"bytes,4,opt,name=analyzed_at,json=analyzedAt,proto3" json:"analyzed_at,omitempty"`
+	SafetyScore   int32                  `protobuf:"varint,5,opt,name=safety_score,json=safetyScore,proto3" json:"safety_score,omitempty"`
+	JobId         string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Verdict       string                 `protobuf:"bytes,7,opt,name=verdict,proto3" json:"verdict,omitempty"` // 결과가 아직 없으면 빈 문자열
+	JobStatus     string                 `protobuf:"bytes,8,opt,name=job_status,json=jobStatus,proto3" json:"job_status,omitempty"`
+	CheckCount    int32                  `protobuf:"varint,9,opt,name=check_count,json=checkCount,proto3" json:"check_count,omitempty"` // 같은 영상을 검사한 횟수 (항목은 영상당 하나, 최신 검사 기준)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryItem) Reset() {
+	*x = HistoryItem{}
+	mi := &file_proto_analysis_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryItem) ProtoMessage() {}
+
+func (x *HistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryItem.ProtoReflect.Descriptor instead.
+func (*HistoryItem) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HistoryItem) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *HistoryItem) GetVideoTitle() string {
+	if x != nil {
+		return x.VideoTitle
+	}
+	return ""
+}
+
+func (x *HistoryItem) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *HistoryItem) GetAnalyzedAt() string {
+	if x != nil {
+		return x.AnalyzedAt
+	}
+	return ""
+}
+
+func (x *HistoryItem) GetSafetyScore() int32 {
+	if x != nil {
+		return x.SafetyScore
+	}
+	return 0
+}
+
+func (x *HistoryItem) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *HistoryItem) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *HistoryItem) GetJobStatus() string {
+	if x != nil {
+		return x.JobStatus
+	}
+	return ""
+}
+
+func (x *HistoryItem) GetCheckCount() int32 {
+	if x != nil {
+		return x.CheckCount
+	}
+	return 0
+}
+
+// 기록 삭제: 사용자는 authorization 메타데이터의 JWT로 식별
+type DeleteHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHistoryRequest) Reset() {
+	*x = DeleteHistoryRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHistoryRequest) ProtoMessage() {}
+
+func (x *DeleteHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHistoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteHistoryRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type DeleteHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"` // 삭제할 항목이 없었으면 false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHistoryResponse) Reset() {
+	*x = DeleteHistoryResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHistoryResponse) ProtoMessage() {}
+
+func (x *DeleteHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHistoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteHistoryResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ClearHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHistoryRequest) Reset() {
+	*x = ClearHistoryRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryRequest) ProtoMessage() {}
+
+func (x *ClearHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ClearHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{28}
+}
+
+type ClearHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedCount  int32                  `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHistoryResponse) Reset() {
+	*x = ClearHistoryResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryResponse) ProtoMessage() {}
+
+func (x *ClearHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ClearHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ClearHistoryResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+type UploadURLRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Filename    string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`                          // 업로드할 파일명 (예: "video.mp4")
+	ContentType string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME 타입 (예: "video/mp4")
+	FileSize    int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`         // 파일 크기 (바이트)
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadURLRequest) Reset() {
+	*x = UploadURLRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadURLRequest) ProtoMessage() {}
+
+func (x *UploadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadURLRequest.ProtoReflect.Descriptor instead.
+func (*UploadURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UploadURLRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadURLRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadURLRequest) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *UploadURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UploadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`  // S3 Presigned URL
+	S3Key         string                 `protobuf:"bytes,2,opt,name=s3_key,json=s3Key,proto3" json:"s3_key,omitempty"`              // S3 객체 키 (경로)
+	ExpiresIn     int32                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // URL 만료 시간 (초)
+	UploadId      string                 `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`     // 추적용 고유 ID
+	JobId         string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`              // 업로드 분석 Job (GetResult로 결과 조회)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadURLResponse) Reset() {
+	*x = UploadURLResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadURLResponse) ProtoMessage() {}
+
+func (x *UploadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadURLResponse.ProtoReflect.Descriptor instead.
+func (*UploadURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UploadURLResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *UploadURLResponse) GetS3Key() string {
+	if x != nil {
+		return x.S3Key
+	}
+	return ""
+}
+
+func (x *UploadURLResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *UploadURLResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadURLResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type AnalysisResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"` // 조회할 video_id 또는 upload_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalysisResultRequest) Reset() {
+	*x = AnalysisResultRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalysisResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisResultRequest) ProtoMessage() {}
+
+func (x *AnalysisResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisResultRequest.ProtoReflect.Descriptor instead.
+func (*AnalysisResultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AnalysisResultRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type AnalysisResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	AudioScore    float32                `protobuf:"fixed32,2,opt,name=audio_score,json=audioScore,proto3" json:"audio_score,omitempty"`       // 0.0-1.0
+	VideoScore    float32                `protobuf:"fixed32,3,opt,name=video_score,json=videoScore,proto3" json:"video_score,omitempty"`       // 0.0-1.0
+	ContextScore  float32                `protobuf:"fixed32,4,opt,name=context_score,json=contextScore,proto3" json:"context_score,omitempty"` // 0.0-1.0
+	FinalScore    int32                  `protobuf:"varint,5,opt,name=final_score,json=finalScore,proto3" json:"final_score,omitempty"`        // 0-100
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                   // processing, completed, failed
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SafetyScore   int32                  `protobuf:"varint,9,opt,name=safety_score,json=safetyScore,proto3" json:"safety_score,omitempty"` // 0-100 (100 - final_score)
+	Verdict       string                 `protobuf:"bytes,10,opt,name=verdict,proto3" json:"verdict,omitempty"`                            // safe, caution, danger
+	JobId         string                 `protobuf:"bytes,11,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	UploadId      string                 `protobuf:"bytes,12,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Source        string                 `protobuf:"bytes,13,opt,name=source,proto3" json:"source,omitempty"` // gemini, pipeline, review
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalysisResultResponse) Reset() {
+	*x = AnalysisResultResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalysisResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisResultResponse) ProtoMessage() {}
+
+func (x *AnalysisResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisResultResponse.ProtoReflect.Descriptor instead.
+func (*AnalysisResultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AnalysisResultResponse) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *AnalysisResultResponse) GetAudioScore() float32 {
+	if x != nil {
+		return x.AudioScore
+	}
+	return 0
+}
+
+func (x *AnalysisResultResponse) GetVideoScore() float32 {
+	if x != nil {
+		return x.VideoScore
+	}
+	return 0
+}
+
+func (x *AnalysisResultResponse) GetContextScore() float32 {
+	if x != nil {
+		return x.ContextScore
+	}
+	return 0
+}
+
+func (x *AnalysisResultResponse) GetFinalScore() int32 {
+	if x != nil {
+		return x.FinalScore
+	}
+	return 0
+}
+
+func (x *AnalysisResultResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AnalysisResultResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AnalysisResultResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *AnalysisResultResponse) GetSafetyScore() int32 {
+	if x != nil {
+		return x.SafetyScore
+	}
+	return 0
+}
+
+func (x *AnalysisResultResponse) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *AnalysisResultResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AnalysisResultResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *AnalysisResultResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type BatchAnalysisRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Url       string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                               // 재생목록 또는 채널 URL
+	MaxVideos int32                  `protobuf:"varint,2,opt,name=max_videos,json=maxVideos,proto3" json:"max_videos,omitempty"` // 분석할 최대 영상 수 (서버 상한 적용)
+	Options   *AnalysisOptions       `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAnalysisRequest) Reset() {
+	*x = BatchAnalysisRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAnalysisRequest) ProtoMessage() {}
+
+func (x *BatchAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*BatchAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BatchAnalysisRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BatchAnalysisRequest) GetMaxVideos() int32 {
+	if x != nil {
+		return x.MaxVideos
+	}
+	return 0
+}
+
+func (x *BatchAnalysisRequest) GetOptions() *AnalysisOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *BatchAnalysisRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BatchAnalysisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // playlist, channel
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	TotalVideos   int32                  `protobuf:"varint,4,opt,name=total_videos,json=totalVideos,proto3" json:"total_videos,omitempty"`
+	JobIds        []string               `protobuf:"bytes,5,rep,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAnalysisResponse) Reset() {
+	*x = BatchAnalysisResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAnalysisResponse) ProtoMessage() {}
+
+func (x *BatchAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*BatchAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BatchAnalysisResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchAnalysisResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BatchAnalysisResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BatchAnalysisResponse) GetTotalVideos() int32 {
+	if x != nil {
+		return x.TotalVideos
+	}
+	return 0
+}
+
+func (x *BatchAnalysisResponse) GetJobIds() []string {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+func (x *BatchAnalysisResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchAnalysisResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProgressRequest) Reset() {
+	*x = BatchProgressRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProgressRequest) ProtoMessage() {}
+
+func (x *BatchProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProgressRequest.ProtoReflect.Descriptor instead.
+func (*BatchProgressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BatchProgressRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type BatchProgressEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "progress", "complete"
+	Completed     int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	JobId         string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // 방금 끝난 자식 Job
+	VideoId       string                 `protobuf:"bytes,7,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	SafetyScore   int32                  `protobuf:"varint,8,opt,name=safety_score,json=safetyScore,proto3" json:"safety_score,omitempty"`
+	Message       string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProgressEvent) Reset() {
+	*x = BatchProgressEvent{}
+	mi := &file_proto_analysis_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProgressEvent) ProtoMessage() {}
+
+func (x *BatchProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProgressEvent.ProtoReflect.Descriptor instead.
+func (*BatchProgressEvent) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchProgressEvent) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchProgressEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BatchProgressEvent) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *BatchProgressEvent) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchProgressEvent) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BatchProgressEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BatchProgressEvent) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *BatchProgressEvent) GetSafetyScore() int32 {
+	if x != nil {
+		return x.SafetyScore
+	}
+	return 0
+}
+
+func (x *BatchProgressEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchProgressEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type BatchResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResultRequest) Reset() {
+	*x = BatchResultRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResultRequest) ProtoMessage() {}
+
+func (x *BatchResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResultRequest.ProtoReflect.Descriptor instead.
+func (*BatchResultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchResultRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type BatchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SafetyScore   int32                  `protobuf:"varint,5,opt,name=safety_score,json=safetyScore,proto3" json:"safety_score,omitempty"`
+	Verdict       string                 `protobuf:"bytes,6,opt,name=verdict,proto3" json:"verdict,omitempty"` // danger, caution, safe (결과가 없으면 빈 값)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItem) Reset() {
+	*x = BatchItem{}
+	mi := &file_proto_analysis_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItem) ProtoMessage() {}
+
+func (x *BatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItem.ProtoReflect.Descriptor instead.
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchItem) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BatchItem) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *BatchItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BatchItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchItem) GetSafetyScore() int32 {
+	if x != nil {
+		return x.SafetyScore
+	}
+	return 0
+}
+
+func (x *BatchItem) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+type BatchRiskSummary struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AverageSafetyScore float32                `protobuf:"fixed32,1,opt,name=average_safety_score,json=averageSafetyScore,proto3" json:"average_safety_score,omitempty"`
+	MinSafetyScore     int32                  `protobuf:"varint,2,opt,name=min_safety_score,json=minSafetyScore,proto3" json:"min_safety_score,omitempty"`
+	DangerCount        int32                  `protobuf:"varint,3,opt,name=danger_count,json=dangerCount,proto3" json:"danger_count,omitempty"`
+	CautionCount       int32                  `protobuf:"varint,4,opt,name=caution_count,json=cautionCount,proto3" json:"caution_count,omitempty"`
+	SafeCount          int32                  `protobuf:"varint,5,opt,name=safe_count,json=safeCount,proto3" json:"safe_count,omitempty"`
+	RiskLevel          string                 `protobuf:"bytes,6,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"` // high, medium, low
+	ChannelInfo        *ChannelInfo           `protobuf:"bytes,7,opt,name=channel_info,json=channelInfo,proto3" json:"channel_info,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BatchRiskSummary) Reset() {
+	*x = BatchRiskSummary{}
+	mi := &file_proto_analysis_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRiskSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRiskSummary) ProtoMessage() {}
+
+func (x *BatchRiskSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRiskSummary.ProtoReflect.Descriptor instead.
+func (*BatchRiskSummary) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchRiskSummary) GetAverageSafetyScore() float32 {
+	if x != nil {
+		return x.AverageSafetyScore
+	}
+	return 0
+}
+
+func (x *BatchRiskSummary) GetMinSafetyScore() int32 {
+	if x != nil {
+		return x.MinSafetyScore
+	}
+	return 0
+}
+
+func (x *BatchRiskSummary) GetDangerCount() int32 {
+	if x != nil {
+		return x.DangerCount
+	}
+	return 0
+}
+
+func (x *BatchRiskSummary) GetCautionCount() int32 {
+	if x != nil {
+		return x.CautionCount
+	}
+	return 0
+}
+
+func (x *BatchRiskSummary) GetSafeCount() int32 {
+	if x != nil {
+		return x.SafeCount
+	}
+	return 0
+}
+
+func (x *BatchRiskSummary) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *BatchRiskSummary) GetChannelInfo() *ChannelInfo {
+	if x != nil {
+		return x.ChannelInfo
+	}
+	return nil
+}
+
+type BatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	SourceUrl     string                 `protobuf:"bytes,4,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Completed     int32                  `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed        int32                  `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	Items         []*BatchItem           `protobuf:"bytes,9,rep,name=items,proto3" json:"items,omitempty"`
+	Summary       *BatchRiskSummary      `protobuf:"bytes,10,opt,name=summary,proto3" json:"summary,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_proto_analysis_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchResult) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BatchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BatchResult) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *BatchResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BatchResult) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *BatchResult) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchResult) GetItems() []*BatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchResult) GetSummary() *BatchRiskSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *BatchResult) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BatchResult) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type AddWatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 무시됨 (JWT 사용자 기준)
+	ChannelUrl    string `protobuf:"bytes,2,opt,name=channel_url,json=channelUrl,proto3" json:"channel_url,omitempty"` // 채널 URL (/@handle, /channel/UC..., /c/name)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatchRequest) Reset() {
+	*x = AddWatchRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchRequest) ProtoMessage() {}
+
+func (x *AddWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchRequest.ProtoReflect.Descriptor instead.
+func (*AddWatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{42}
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *AddWatchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddWatchRequest) GetChannelUrl() string {
+	if x != nil {
+		return x.ChannelUrl
+	}
+	return ""
+}
+
+type WatchedChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	LastCheckedAt string                 `protobuf:"bytes,3,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchedChannel) Reset() {
+	*x = WatchedChannel{}
+	mi := &file_proto_analysis_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchedChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedChannel) ProtoMessage() {}
+
+func (x *WatchedChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedChannel.ProtoReflect.Descriptor instead.
+func (*WatchedChannel) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WatchedChannel) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *WatchedChannel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WatchedChannel) GetLastCheckedAt() string {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return ""
+}
+
+func (x *WatchedChannel) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type RemoveWatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
+	ChannelId     string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatchRequest) Reset() {
+	*x = RemoveWatchRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchRequest) ProtoMessage() {}
+
+func (x *RemoveWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{44}
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *RemoveWatchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveWatchRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type RemoveWatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       bool                   `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatchResponse) Reset() {
+	*x = RemoveWatchResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchResponse) ProtoMessage() {}
+
+func (x *RemoveWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoveWatchResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type ListWatchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchesRequest) Reset() {
+	*x = ListWatchesRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchesRequest) ProtoMessage() {}
+
+func (x *ListWatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{46}
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *ListWatchesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListWatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*WatchedChannel      `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchesResponse) Reset() {
+	*x = ListWatchesResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchesResponse) ProtoMessage() {}
+
+func (x *ListWatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListWatchesResponse) GetChannels() []*WatchedChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ListAlertsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
+	UnreadOnly    bool   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	SeniorId      int64  `protobuf:"varint,4,opt,name=senior_id,json=seniorId,proto3" json:"senior_id,omitempty"` // 보호자(guardian 역할)가 연결된 어르신의 알림 조회 (0이면 내 알림)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertsRequest) Reset() {
+	*x = ListAlertsRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsRequest) ProtoMessage() {}
+
+func (x *ListAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{48}
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *ListAlertsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAlertsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListAlertsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAlertsRequest) GetSeniorId() int64 {
+	if x != nil {
+		return x.SeniorId
+	}
+	return 0
+}
+
+type WatchAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       int64                  `protobuf:"varint,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	VideoTitle    string                 `protobuf:"bytes,5,opt,name=video_title,json=videoTitle,proto3" json:"video_title,omitempty"`
+	SafetyScore   int32                  `protobuf:"varint,6,opt,name=safety_score,json=safetyScore,proto3" json:"safety_score,omitempty"`
+	Verdict       string                 `protobuf:"bytes,7,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	Read          bool                   `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAlert) Reset() {
+	*x = WatchAlert{}
+	mi := &file_proto_analysis_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAlert) ProtoMessage() {}
+
+func (x *WatchAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAlert.ProtoReflect.Descriptor instead.
+func (*WatchAlert) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WatchAlert) GetAlertId() int64 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+func (x *WatchAlert) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *WatchAlert) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *WatchAlert) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *WatchAlert) GetVideoTitle() string {
+	if x != nil {
+		return x.VideoTitle
+	}
+	return ""
+}
+
+func (x *WatchAlert) GetSafetyScore() int32 {
+	if x != nil {
+		return x.SafetyScore
+	}
+	return 0
+}
+
+func (x *WatchAlert) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *WatchAlert) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *WatchAlert) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*WatchAlert          `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsResponse) ProtoMessage() {}
+
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListAlertsResponse) GetAlerts() []*WatchAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type MarkAlertsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/analysis.proto.
+	UserId        string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 무시됨 (JWT 사용자 기준)
+	AlertIds      []int64 `protobuf:"varint,2,rep,packed,name=alert_ids,json=alertIds,proto3" json:"alert_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAlertsReadRequest) Reset() {
+	*x = MarkAlertsReadRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAlertsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAlertsReadRequest) ProtoMessage() {}
+
+func (x *MarkAlertsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAlertsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAlertsReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{51}
+}
+
+// Deprecated: Marked as deprecated in proto/analysis.proto.
+func (x *MarkAlertsReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkAlertsReadRequest) GetAlertIds() []int64 {
+	if x != nil {
+		return x.AlertIds
+	}
+	return nil
+}
+
+type MarkAlertsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAlertsReadResponse) Reset() {
+	*x = MarkAlertsReadResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAlertsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAlertsReadResponse) ProtoMessage() {}
+
+func (x *MarkAlertsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAlertsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAlertsReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MarkAlertsReadResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type CreateFamilyInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // guardian(기본): 기록+알림, viewer: 기록만
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFamilyInviteRequest) Reset() {
+	*x = CreateFamilyInviteRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFamilyInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFamilyInviteRequest) ProtoMessage() {}
+
+func (x *CreateFamilyInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFamilyInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateFamilyInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateFamilyInviteRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type FamilyInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // 어르신에게 전달할 일회용 코드 (다시 조회할 수 없음)
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FamilyInvite) Reset() {
+	*x = FamilyInvite{}
+	mi := &file_proto_analysis_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FamilyInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FamilyInvite) ProtoMessage() {}
+
+func (x *FamilyInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FamilyInvite.ProtoReflect.Descriptor instead.
+func (*FamilyInvite) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FamilyInvite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FamilyInvite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *FamilyInvite) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ClaimFamilyInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimFamilyInviteRequest) Reset() {
+	*x = ClaimFamilyInviteRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimFamilyInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimFamilyInviteRequest) ProtoMessage() {}
+
+func (x *ClaimFamilyInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimFamilyInviteRequest.ProtoReflect.Descriptor instead.
+func (*ClaimFamilyInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ClaimFamilyInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ApproveFamilyLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        int64                  `protobuf:"varint,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"` // false면 거절
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFamilyLinkRequest) Reset() {
+	*x = ApproveFamilyLinkRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFamilyLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFamilyLinkRequest) ProtoMessage() {}
+
+func (x *ApproveFamilyLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFamilyLinkRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFamilyLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ApproveFamilyLinkRequest) GetLinkId() int64 {
+	if x != nil {
+		return x.LinkId
+	}
+	return 0
+}
+
+func (x *ApproveFamilyLinkRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type RevokeFamilyLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        int64                  `protobuf:"varint,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeFamilyLinkRequest) Reset() {
+	*x = RevokeFamilyLinkRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeFamilyLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeFamilyLinkRequest) ProtoMessage() {}
+
+func (x *RevokeFamilyLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeFamilyLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeFamilyLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RevokeFamilyLinkRequest) GetLinkId() int64 {
+	if x != nil {
+		return x.LinkId
+	}
+	return 0
+}
+
+type ListFamilyLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFamilyLinksRequest) Reset() {
+	*x = ListFamilyLinksRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFamilyLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFamilyLinksRequest) ProtoMessage() {}
+
+func (x *ListFamilyLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFamilyLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListFamilyLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{58}
+}
+
+type FamilyLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        int64                  `protobuf:"varint,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Guardian      *User                  `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	Senior        *User                  `protobuf:"bytes,3,opt,name=senior,proto3" json:"senior,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`     // guardian, viewer
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // pending, active, declined, revoked
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ApprovedAt    string                 `protobuf:"bytes,7,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FamilyLink) Reset() {
+	*x = FamilyLink{}
+	mi := &file_proto_analysis_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FamilyLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FamilyLink) ProtoMessage() {}
+
+func (x *FamilyLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type User struct {
+// Deprecated: Use FamilyLink.ProtoReflect.Descriptor instead.
+func (*FamilyLink) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FamilyLink) GetLinkId() int64 {
+	if x != nil {
+		return x.LinkId
+	}
+	return 0
+}
+
+func (x *FamilyLink) GetGuardian() *User {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+func (x *FamilyLink) GetSenior() *User {
+	if x != nil {
+		return x.Senior
+	}
+	return nil
+}
+
+func (x *FamilyLink) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *FamilyLink) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FamilyLink) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FamilyLink) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+type ListFamilyLinksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	PictureUrl    string                 `protobuf:"bytes,4,opt,name=picture_url,json=pictureUrl,proto3" json:"picture_url,omitempty"`
+	Links         []*FamilyLink          `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"` // 내가 보호자 또는 어르신인 연결
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_proto_analysis_proto_msgTypes[17]
+func (x *ListFamilyLinksResponse) Reset() {
+	*x = ListFamilyLinksResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *ListFamilyLinksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*ListFamilyLinksResponse) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[17]
+func (x *ListFamilyLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,63 +4079,363 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListFamilyLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListFamilyLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *User) GetId() int64 {
+func (x *ListFamilyLinksResponse) GetLinks() []*FamilyLink {
 	if x != nil {
-		return x.Id
+		return x.Links
+	}
+	return nil
+}
+
+type AdminGetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // user_id가 없으면 이메일로 조회
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetUserRequest) Reset() {
+	*x = AdminGetUserRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetUserRequest) ProtoMessage() {}
+
+func (x *AdminGetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AdminGetUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *User) GetEmail() string {
+func (x *AdminGetUserRequest) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *User) GetName() string {
+type AdminUser struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	User            *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	LinkedProviders []string               `protobuf:"bytes,2,rep,name=linked_providers,json=linkedProviders,proto3" json:"linked_providers,omitempty"`
+	Subscription    *Subscription          `protobuf:"bytes,3,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	mi := &file_proto_analysis_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUser.ProtoReflect.Descriptor instead.
+func (*AdminUser) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AdminUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AdminUser) GetLinkedProviders() []string {
+	if x != nil {
+		return x.LinkedProviders
+	}
+	return nil
+}
+
+func (x *AdminUser) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type SetUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleRequest) Reset() {
+	*x = SetUserRoleRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleRequest) ProtoMessage() {}
+
+func (x *SetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetUserRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// 업그레이드/다운그레이드/무상 지급 (결제 없이 요금제 지정)
+type SetSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanType      string                 `protobuf:"bytes,2,opt,name=plan_type,json=planType,proto3" json:"plan_type,omitempty"` // config plans에 있는 요금제, free면 즉시 종료
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`    // RFC3339, 비우면 기한 없음
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubscriptionRequest) Reset() {
+	*x = SetSubscriptionRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubscriptionRequest) ProtoMessage() {}
+
+func (x *SetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetSubscriptionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetSubscriptionRequest) GetPlanType() string {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
+func (x *SetSubscriptionRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type CancelSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Immediately   bool                   `protobuf:"varint,2,opt,name=immediately,proto3" json:"immediately,omitempty"` // true면 즉시 free로, 아니면 end_date까지 유지
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CancelSubscriptionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelSubscriptionRequest) GetImmediately() bool {
+	if x != nil {
+		return x.Immediately
+	}
+	return false
+}
+
+type ReanalyzeVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoUrl      string                 `protobuf:"bytes,1,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReanalyzeVideoRequest) Reset() {
+	*x = ReanalyzeVideoRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReanalyzeVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReanalyzeVideoRequest) ProtoMessage() {}
+
+func (x *ReanalyzeVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[66]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetPictureUrl() string {
+// Deprecated: Use ReanalyzeVideoRequest.ProtoReflect.Descriptor instead.
+func (*ReanalyzeVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ReanalyzeVideoRequest) GetVideoUrl() string {
 	if x != nil {
-		return x.PictureUrl
+		return x.VideoUrl
 	}
 	return ""
 }
 
-type Subscription struct {
+type OverrideVerdictRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanType      string                 `protobuf:"bytes,1,opt,name=plan_type,json=planType,proto3" json:"plan_type,omitempty"` // 'free', 'pro'
-	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Verdict       string                 `protobuf:"bytes,2,opt,name=verdict,proto3" json:"verdict,omitempty"` // safe, caution, danger
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Subscription) Reset() {
-	*x = Subscription{}
-	mi := &file_proto_analysis_proto_msgTypes[18]
+func (x *OverrideVerdictRequest) Reset() {
+	*x = OverrideVerdictRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Subscription) String() string {
+func (x *OverrideVerdictRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Subscription) ProtoMessage() {}
+func (*OverrideVerdictRequest) ProtoMessage() {}
 
-func (x *Subscription) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[18]
+func (x *OverrideVerdictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,59 +4446,61 @@ func (x *Subscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
-func (*Subscription) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use OverrideVerdictRequest.ProtoReflect.Descriptor instead.
+func (*OverrideVerdictRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Subscription) GetPlanType() string {
+func (x *OverrideVerdictRequest) GetJobId() string {
 	if x != nil {
-		return x.PlanType
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *Subscription) GetStartDate() string {
+func (x *OverrideVerdictRequest) GetVerdict() string {
 	if x != nil {
-		return x.StartDate
+		return x.Verdict
 	}
 	return ""
 }
 
-func (x *Subscription) GetEndDate() string {
+func (x *OverrideVerdictRequest) GetReason() string {
 	if x != nil {
-		return x.EndDate
+		return x.Reason
 	}
 	return ""
 }
 
-type HistoryItem struct {
+type APIKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	VideoTitle    string                 `protobuf:"bytes,2,opt,name=video_title,json=videoTitle,proto3" json:"video_title,omitempty"`
-	ThumbnailUrl  string                 `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	AnalyzedAt    string                 `protobuf:"bytes,4,opt,name=analyzed_at,json=analyzedAt,proto3" json:"analyzed_at,omitempty"`
-	SafetyScore   int32                  `protobuf:"varint,5,opt,name=safety_score,json=safetyScore,proto3" json:"safety_score,omitempty"`
-	JobId         string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	KeyId         int64                  `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`                            // 키 앞부분 (sg_xxxxxxxx)
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`                            // analysis:read, analysis:write
+	DailyQuota    int32                  `protobuf:"varint,5,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"` // 0 = 무제한
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt     string                 `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HistoryItem) Reset() {
-	*x = HistoryItem{}
-	mi := &file_proto_analysis_proto_msgTypes[19]
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_proto_analysis_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HistoryItem) String() string {
+func (x *APIKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoryItem) ProtoMessage() {}
+func (*APIKey) ProtoMessage() {}
 
-func (x *HistoryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[19]
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,78 +4511,91 @@ func (x *HistoryItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistoryItem.ProtoReflect.Descriptor instead.
-func (*HistoryItem) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *HistoryItem) GetVideoId() string {
+func (x *APIKey) GetKeyId() int64 {
 	if x != nil {
-		return x.VideoId
+		return x.KeyId
 	}
-	return ""
+	return 0
 }
 
-func (x *HistoryItem) GetVideoTitle() string {
+func (x *APIKey) GetName() string {
 	if x != nil {
-		return x.VideoTitle
+		return x.Name
 	}
 	return ""
 }
 
-func (x *HistoryItem) GetThumbnailUrl() string {
+func (x *APIKey) GetPrefix() string {
 	if x != nil {
-		return x.ThumbnailUrl
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *HistoryItem) GetAnalyzedAt() string {
+func (x *APIKey) GetScopes() []string {
 	if x != nil {
-		return x.AnalyzedAt
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-func (x *HistoryItem) GetSafetyScore() int32 {
+func (x *APIKey) GetDailyQuota() int32 {
 	if x != nil {
-		return x.SafetyScore
+		return x.DailyQuota
 	}
 	return 0
 }
 
-func (x *HistoryItem) GetJobId() string {
+func (x *APIKey) GetCreatedAt() string {
 	if x != nil {
-		return x.JobId
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type UploadURLRequest struct {
+func (x *APIKey) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+type CreateAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`                          // 업로드할 파일명 (예: "video.mp4")
-	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME 타입 (예: "video/mp4")
-	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`         // 파일 크기 (바이트)
-	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 업로드하는 사용자 ID
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	DailyQuota    int32                  `protobuf:"varint,3,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadURLRequest) Reset() {
-	*x = UploadURLRequest{}
-	mi := &file_proto_analysis_proto_msgTypes[20]
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadURLRequest) String() string {
+func (x *CreateAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadURLRequest) ProtoMessage() {}
+func (*CreateAPIKeyRequest) ProtoMessage() {}
 
-func (x *UploadURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[20]
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,64 +4606,55 @@ func (x *UploadURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadURLRequest.ProtoReflect.Descriptor instead.
-func (*UploadURLRequest) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UploadURLRequest) GetFilename() string {
+func (x *CreateAPIKeyRequest) GetName() string {
 	if x != nil {
-		return x.Filename
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UploadURLRequest) GetContentType() string {
+func (x *CreateAPIKeyRequest) GetScopes() []string {
 	if x != nil {
-		return x.ContentType
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadURLRequest) GetFileSize() int64 {
+func (x *CreateAPIKeyRequest) GetDailyQuota() int32 {
 	if x != nil {
-		return x.FileSize
+		return x.DailyQuota
 	}
 	return 0
 }
 
-func (x *UploadURLRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type UploadURLResponse struct {
+type CreateAPIKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`  // S3 Presigned URL
-	S3Key         string                 `protobuf:"bytes,2,opt,name=s3_key,json=s3Key,proto3" json:"s3_key,omitempty"`              // S3 객체 키 (경로)
-	ExpiresIn     int32                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // URL 만료 시간 (초)
-	UploadId      string                 `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`     // 추적용 고유 ID
+	Key           *APIKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // 전체 키, 발급 시 한 번만 제공 (x-api-key 메타데이터로 전송)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadURLResponse) Reset() {
-	*x = UploadURLResponse{}
-	mi := &file_proto_analysis_proto_msgTypes[21]
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadURLResponse) String() string {
+func (x *CreateAPIKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadURLResponse) ProtoMessage() {}
+func (*CreateAPIKeyResponse) ProtoMessage() {}
 
-func (x *UploadURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[21]
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,61 +4665,47 @@ func (x *UploadURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadURLResponse.ProtoReflect.Descriptor instead.
-func (*UploadURLResponse) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *UploadURLResponse) GetUploadUrl() string {
-	if x != nil {
-		return x.UploadUrl
-	}
-	return ""
-}
-
-func (x *UploadURLResponse) GetS3Key() string {
-	if x != nil {
-		return x.S3Key
-	}
-	return ""
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *UploadURLResponse) GetExpiresIn() int32 {
+func (x *CreateAPIKeyResponse) GetKey() *APIKey {
 	if x != nil {
-		return x.ExpiresIn
+		return x.Key
 	}
-	return 0
+	return nil
 }
 
-func (x *UploadURLResponse) GetUploadId() string {
+func (x *CreateAPIKeyResponse) GetSecret() string {
 	if x != nil {
-		return x.UploadId
+		return x.Secret
 	}
 	return ""
 }
 
-type AnalysisResultRequest struct {
+type RevokeAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"` // 조회할 video_id
+	KeyId         int64                  `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnalysisResultRequest) Reset() {
-	*x = AnalysisResultRequest{}
-	mi := &file_proto_analysis_proto_msgTypes[22]
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalysisResultRequest) String() string {
+func (x *RevokeAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalysisResultRequest) ProtoMessage() {}
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
 
-func (x *AnalysisResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[22]
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,47 +4716,39 @@ func (x *AnalysisResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalysisResultRequest.ProtoReflect.Descriptor instead.
-func (*AnalysisResultRequest) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *AnalysisResultRequest) GetVideoId() string {
+func (x *RevokeAPIKeyRequest) GetKeyId() int64 {
 	if x != nil {
-		return x.VideoId
+		return x.KeyId
 	}
-	return ""
+	return 0
 }
 
-type AnalysisResultResponse struct {
+type ListAPIKeysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	AudioScore    float32                `protobuf:"fixed32,2,opt,name=audio_score,json=audioScore,proto3" json:"audio_score,omitempty"`       // 0.0-1.0
-	VideoScore    float32                `protobuf:"fixed32,3,opt,name=video_score,json=videoScore,proto3" json:"video_score,omitempty"`       // 0.0-1.0
-	ContextScore  float32                `protobuf:"fixed32,4,opt,name=context_score,json=contextScore,proto3" json:"context_score,omitempty"` // 0.0-1.0
-	FinalScore    int32                  `protobuf:"varint,5,opt,name=final_score,json=finalScore,proto3" json:"final_score,omitempty"`        // 0-100
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                   // processing, completed, failed
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnalysisResultResponse) Reset() {
-	*x = AnalysisResultResponse{}
-	mi := &file_proto_analysis_proto_msgTypes[23]
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_proto_analysis_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalysisResultResponse) String() string {
+func (x *ListAPIKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalysisResultResponse) ProtoMessage() {}
+func (*ListAPIKeysRequest) ProtoMessage() {}
 
-func (x *AnalysisResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_analysis_proto_msgTypes[23]
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,76 +4759,64 @@ func (x *AnalysisResultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalysisResultResponse.ProtoReflect.Descriptor instead.
-func (*AnalysisResultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_analysis_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *AnalysisResultResponse) GetVideoId() string {
-	if x != nil {
-		return x.VideoId
-	}
-	return ""
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *AnalysisResultResponse) GetAudioScore() float32 {
-	if x != nil {
-		return x.AudioScore
-	}
-	return 0
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*APIKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnalysisResultResponse) GetVideoScore() float32 {
-	if x != nil {
-		return x.VideoScore
-	}
-	return 0
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_proto_analysis_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalysisResultResponse) GetContextScore() float32 {
-	if x != nil {
-		return x.ContextScore
-	}
-	return 0
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AnalysisResultResponse) GetFinalScore() int32 {
-	if x != nil {
-		return x.FinalScore
-	}
-	return 0
-}
+func (*ListAPIKeysResponse) ProtoMessage() {}
 
-func (x *AnalysisResultResponse) GetStatus() string {
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analysis_proto_msgTypes[73]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AnalysisResultResponse) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analysis_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *AnalysisResultResponse) GetUpdatedAt() string {
+func (x *ListAPIKeysResponse) GetKeys() []*APIKey {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Keys
 	}
-	return ""
+	return nil
 }
 
 var File_proto_analysis_proto protoreflect.FileDescriptor
 
 const file_proto_analysis_proto_rawDesc = "" +
 	"\n" +
-	"\x14proto/analysis.proto\x12\banalysis\"|\n" +
+	"\x14proto/analysis.proto\x12\banalysis\"\x80\x01\n" +
 	"\x0fAnalysisRequest\x12\x1b\n" +
 	"\tvideo_url\x18\x01 \x01(\tR\bvideoUrl\x123\n" +
-	"\aoptions\x18\x02 \x01(\v2\x19.analysis.AnalysisOptionsR\aoptions\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x8c\x01\n" +
+	"\aoptions\x18\x02 \x01(\v2\x19.analysis.AnalysisOptionsR\aoptions\x12\x1b\n" +
+	"\auser_id\x18\x03 \x01(\tB\x02\x18\x01R\x06userId\"\x8c\x01\n" +
 	"\x0fAnalysisOptions\x12 \n" +
 	"\vsensitivity\x18\x01 \x01(\x05R\vsensitivity\x12)\n" +
 	"\x10analyze_comments\x18\x02 \x01(\bR\x0fanalyzeComments\x12,\n" +
@@ -1543,13 +4826,14 @@ const file_proto_analysis_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\"(\n" +
 	"\x0fProgressRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x8e\x01\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xb1\x01\n" +
 	"\rProgressEvent\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
 	"\bprogress\x18\x04 \x01(\x05R\bprogress\x12\x1c\n" +
-	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\"&\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\x12!\n" +
+	"\fsafety_score\x18\x06 \x01(\x05R\vsafetyScore\"&\n" +
 	"\rResultRequest\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xf3\x02\n" +
 	"\x0eAnalysisResult\x12\x15\n" +
@@ -1566,7 +4850,7 @@ const file_proto_analysis_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12!\n" +
 	"\fcompleted_at\x18\n" +
-	" \x01(\tR\vcompletedAt\"\xbf\x01\n" +
+	" \x01(\tR\vcompletedAt\"\xd9\x02\n" +
 	"\rVideoMetadata\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
@@ -1574,7 +4858,27 @@ const file_proto_analysis_proto_rawDesc = "" +
 	"\bduration\x18\x04 \x01(\x03R\bduration\x12\x1d\n" +
 	"\n" +
 	"view_count\x18\x05 \x01(\x03R\tviewCount\x12!\n" +
-	"\fpublished_at\x18\x06 \x01(\tR\vpublishedAt\"_\n" +
+	"\fpublished_at\x18\x06 \x01(\tR\vpublishedAt\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\a \x01(\tR\tchannelId\x128\n" +
+	"\fchannel_info\x18\b \x01(\v2\x15.analysis.ChannelInfoR\vchannelInfo\x12#\n" +
+	"\rthumbnail_url\x18\t \x01(\tR\fthumbnailUrl\x12\x1a\n" +
+	"\bplatform\x18\n" +
+	" \x01(\tR\bplatform\"\xa5\x02\n" +
+	"\vChannelInfo\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"custom_url\x18\x03 \x01(\tR\tcustomUrl\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12)\n" +
+	"\x10subscriber_count\x18\x05 \x01(\x03R\x0fsubscriberCount\x12\x1f\n" +
+	"\vvideo_count\x18\x06 \x01(\x03R\n" +
+	"videoCount\x12\x1a\n" +
+	"\bverified\x18\a \x01(\bR\bverified\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12!\n" +
+	"\frisk_signals\x18\t \x03(\tR\vriskSignals\"_\n" +
 	"\aComment\x12\x16\n" +
 	"\x06author\x18\x01 \x01(\tR\x06author\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
@@ -1587,32 +4891,80 @@ const file_proto_analysis_proto_rawDesc = "" +
 	"\tcancelled\x18\x02 \x01(\bR\tcancelled\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\")\n" +
 	"\fLoginRequest\x12\x19\n" +
-	"\bid_token\x18\x01 \x01(\tR\aidToken\"V\n" +
+	"\bid_token\x18\x01 \x01(\tR\aidToken\"\xb5\x01\n" +
+	"\x14ProviderLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12-\n" +
+	"\x12authorization_code\x18\x02 \x01(\tR\x11authorizationCode\x12!\n" +
+	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x19\n" +
+	"\bid_token\x18\x05 \x01(\tR\aidToken\"\x9a\x01\n" +
 	"\rLoginResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\"\n" +
-	"\x04user\x18\x02 \x01(\v2\x0e.analysis.UserR\x04user\",\n" +
-	"\x11GetProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"u\n" +
+	"\x04user\x18\x02 \x01(\v2\x0e.analysis.UserR\x04user\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x05R\texpiresIn\"5\n" +
+	"\x0eRefreshRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"U\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x1f\n" +
+	"\vall_devices\x18\x02 \x01(\bR\n" +
+	"allDevices\";\n" +
+	"\x0eLogoutResponse\x12)\n" +
+	"\x10revoked_sessions\x18\x01 \x01(\x05R\x0frevokedSessions\"0\n" +
+	"\x11GetProfileRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\tB\x02\x18\x01R\x06userId\"\xdc\x01\n" +
 	"\x13UserProfileResponse\x12\"\n" +
 	"\x04user\x18\x01 \x01(\v2\x0e.analysis.UserR\x04user\x12:\n" +
-	"\fsubscription\x18\x02 \x01(\v2\x16.analysis.SubscriptionR\fsubscription\"]\n" +
-	"\x11GetHistoryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\">\n" +
+	"\fsubscription\x18\x02 \x01(\v2\x16.analysis.SubscriptionR\fsubscription\x12)\n" +
+	"\x10linked_providers\x18\x03 \x03(\tR\x0flinkedProviders\x12:\n" +
+	"\fentitlements\x18\x04 \x01(\v2\x16.analysis.EntitlementsR\fentitlements\"\xa0\x02\n" +
+	"\fEntitlements\x12\x1b\n" +
+	"\tplan_type\x18\x01 \x01(\tR\bplanType\x12(\n" +
+	"\x10analyses_per_day\x18\x02 \x01(\x05R\x0eanalysesPerDay\x12.\n" +
+	"\x13analyses_used_today\x18\x03 \x01(\x05R\x11analysesUsedToday\x12&\n" +
+	"\x0fusage_resets_at\x18\x04 \x01(\tR\rusageResetsAt\x12!\n" +
+	"\fmax_comments\x18\x05 \x01(\x05R\vmaxComments\x12\x14\n" +
+	"\x05batch\x18\x06 \x01(\bR\x05batch\x12\x1c\n" +
+	"\twatchlist\x18\a \x01(\bR\twatchlist\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\bR\bpriority\"\xbf\x02\n" +
+	"\x11GetHistoryRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\tB\x02\x18\x01R\x06userId\x12\x16\n" +
+	"\x04page\x18\x02 \x01(\x05B\x02\x18\x01R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12 \n" +
+	"\tmin_score\x18\x05 \x01(\x05H\x00R\bminScore\x88\x01\x01\x12 \n" +
+	"\tmax_score\x18\x06 \x01(\x05H\x01R\bmaxScore\x88\x01\x01\x12\x12\n" +
+	"\x04from\x18\a \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\b \x01(\tR\x02to\x12\x18\n" +
+	"\averdict\x18\t \x01(\tR\averdict\x12\x1b\n" +
+	"\tsenior_id\x18\n" +
+	" \x01(\x03R\bseniorIdB\f\n" +
+	"\n" +
+	"_min_scoreB\f\n" +
+	"\n" +
+	"_max_score\"\x87\x01\n" +
 	"\x0fHistoryResponse\x12+\n" +
-	"\x05items\x18\x01 \x03(\v2\x15.analysis.HistoryItemR\x05items\"a\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.analysis.HistoryItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"u\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
 	"\vpicture_url\x18\x04 \x01(\tR\n" +
-	"pictureUrl\"e\n" +
+	"pictureUrl\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\"\x9e\x01\n" +
 	"\fSubscription\x12\x1b\n" +
 	"\tplan_type\x18\x01 \x01(\tR\bplanType\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x03 \x01(\tR\aendDate\"\xc9\x01\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
+	"\vcanceled_at\x18\x05 \x01(\tR\n" +
+	"canceledAt\"\xa3\x02\n" +
 	"\vHistoryItem\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1f\n" +
 	"\vvideo_title\x18\x02 \x01(\tR\n" +
@@ -1621,21 +4973,34 @@ const file_proto_analysis_proto_rawDesc = "" +
 	"\vanalyzed_at\x18\x04 \x01(\tR\n" +
 	"analyzedAt\x12!\n" +
 	"\fsafety_score\x18\x05 \x01(\x05R\vsafetyScore\x12\x15\n" +
-	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\x87\x01\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\x12\x18\n" +
+	"\averdict\x18\a \x01(\tR\averdict\x12\x1d\n" +
+	"\n" +
+	"job_status\x18\b \x01(\tR\tjobStatus\x12\x1f\n" +
+	"\vcheck_count\x18\t \x01(\x05R\n" +
+	"checkCount\"1\n" +
+	"\x14DeleteHistoryRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"1\n" +
+	"\x15DeleteHistoryResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"\x15\n" +
+	"\x13ClearHistoryRequest\";\n" +
+	"\x14ClearHistoryResponse\x12#\n" +
+	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount\"\x8b\x01\n" +
 	"\x10UploadURLRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
-	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\"\x85\x01\n" +
+	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x1b\n" +
+	"\auser_id\x18\x04 \x01(\tB\x02\x18\x01R\x06userId\"\x9c\x01\n" +
 	"\x11UploadURLResponse\x12\x1d\n" +
 	"\n" +
 	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x15\n" +
 	"\x06s3_key\x18\x02 \x01(\tR\x05s3Key\x12\x1d\n" +
 	"\n" +
 	"expires_in\x18\x03 \x01(\x05R\texpiresIn\x12\x1b\n" +
-	"\tupload_id\x18\x04 \x01(\tR\buploadId\"2\n" +
+	"\tupload_id\x18\x04 \x01(\tR\buploadId\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\"2\n" +
 	"\x15AnalysisResultRequest\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"\x91\x02\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"\x9a\x03\n" +
 	"\x16AnalysisResultResponse\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1f\n" +
 	"\vaudio_score\x18\x02 \x01(\x02R\n" +
@@ -1649,17 +5014,240 @@ const file_proto_analysis_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt2\xa3\x05\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12!\n" +
+	"\fsafety_score\x18\t \x01(\x05R\vsafetyScore\x12\x18\n" +
+	"\averdict\x18\n" +
+	" \x01(\tR\averdict\x12\x15\n" +
+	"\x06job_id\x18\v \x01(\tR\x05jobId\x12\x1b\n" +
+	"\tupload_id\x18\f \x01(\tR\buploadId\x12\x16\n" +
+	"\x06source\x18\r \x01(\tR\x06source\"\x99\x01\n" +
+	"\x14BatchAnalysisRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"max_videos\x18\x02 \x01(\x05R\tmaxVideos\x123\n" +
+	"\aoptions\x18\x03 \x01(\v2\x19.analysis.AnalysisOptionsR\aoptions\x12\x1b\n" +
+	"\auser_id\x18\x04 \x01(\tB\x02\x18\x01R\x06userId\"\xca\x01\n" +
+	"\x15BatchAnalysisResponse\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12!\n" +
+	"\ftotal_videos\x18\x04 \x01(\x05R\vtotalVideos\x12\x17\n" +
+	"\ajob_ids\x18\x05 \x03(\tR\x06jobIds\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"1\n" +
+	"\x14BatchProgressRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\"\x9c\x02\n" +
+	"\x12BatchProgressEvent\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\x12\x19\n" +
+	"\bvideo_id\x18\a \x01(\tR\avideoId\x12!\n" +
+	"\fsafety_score\x18\b \x01(\x05R\vsafetyScore\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x12\x1c\n" +
+	"\ttimestamp\x18\n" +
+	" \x01(\tR\ttimestamp\"/\n" +
+	"\x12BatchResultRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\"\xa8\x01\n" +
+	"\tBatchItem\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12!\n" +
+	"\fsafety_score\x18\x05 \x01(\x05R\vsafetyScore\x12\x18\n" +
+	"\averdict\x18\x06 \x01(\tR\averdict\"\xae\x02\n" +
+	"\x10BatchRiskSummary\x120\n" +
+	"\x14average_safety_score\x18\x01 \x01(\x02R\x12averageSafetyScore\x12(\n" +
+	"\x10min_safety_score\x18\x02 \x01(\x05R\x0eminSafetyScore\x12!\n" +
+	"\fdanger_count\x18\x03 \x01(\x05R\vdangerCount\x12#\n" +
+	"\rcaution_count\x18\x04 \x01(\x05R\fcautionCount\x12\x1d\n" +
+	"\n" +
+	"safe_count\x18\x05 \x01(\x05R\tsafeCount\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x06 \x01(\tR\triskLevel\x128\n" +
+	"\fchannel_info\x18\a \x01(\v2\x15.analysis.ChannelInfoR\vchannelInfo\"\xf8\x02\n" +
+	"\vBatchResult\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x04 \x01(\tR\tsourceUrl\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tcompleted\x18\a \x01(\x05R\tcompleted\x12\x16\n" +
+	"\x06failed\x18\b \x01(\x05R\x06failed\x12)\n" +
+	"\x05items\x18\t \x03(\v2\x13.analysis.BatchItemR\x05items\x124\n" +
+	"\asummary\x18\n" +
+	" \x01(\v2\x1a.analysis.BatchRiskSummaryR\asummary\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\f \x01(\tR\vcompletedAt\"O\n" +
+	"\x0fAddWatchRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\tB\x02\x18\x01R\x06userId\x12\x1f\n" +
+	"\vchannel_url\x18\x02 \x01(\tR\n" +
+	"channelUrl\"\x8c\x01\n" +
+	"\x0eWatchedChannel\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12&\n" +
+	"\x0flast_checked_at\x18\x03 \x01(\tR\rlastCheckedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"P\n" +
+	"\x12RemoveWatchRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\tB\x02\x18\x01R\x06userId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"/\n" +
+	"\x13RemoveWatchResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\bR\aremoved\"1\n" +
+	"\x12ListWatchesRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\tB\x02\x18\x01R\x06userId\"K\n" +
+	"\x13ListWatchesResponse\x124\n" +
+	"\bchannels\x18\x01 \x03(\v2\x18.analysis.WatchedChannelR\bchannels\"\x84\x01\n" +
+	"\x11ListAlertsRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\tB\x02\x18\x01R\x06userId\x12\x1f\n" +
+	"\vunread_only\x18\x02 \x01(\bR\n" +
+	"unreadOnly\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\tsenior_id\x18\x04 \x01(\x03R\bseniorId\"\x89\x02\n" +
+	"\n" +
+	"WatchAlert\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\x03R\aalertId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\tR\avideoId\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\x12\x1f\n" +
+	"\vvideo_title\x18\x05 \x01(\tR\n" +
+	"videoTitle\x12!\n" +
+	"\fsafety_score\x18\x06 \x01(\x05R\vsafetyScore\x12\x18\n" +
+	"\averdict\x18\a \x01(\tR\averdict\x12\x12\n" +
+	"\x04read\x18\b \x01(\bR\x04read\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"B\n" +
+	"\x12ListAlertsResponse\x12,\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x14.analysis.WatchAlertR\x06alerts\"Q\n" +
+	"\x15MarkAlertsReadRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\tB\x02\x18\x01R\x06userId\x12\x1b\n" +
+	"\talert_ids\x18\x02 \x03(\x03R\balertIds\"2\n" +
+	"\x16MarkAlertsReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\"/\n" +
+	"\x19CreateFamilyInviteRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\"U\n" +
+	"\fFamilyInvite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\".\n" +
+	"\x18ClaimFamilyInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"M\n" +
+	"\x18ApproveFamilyLinkRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\x03R\x06linkId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\"2\n" +
+	"\x17RevokeFamilyLinkRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\x03R\x06linkId\"\x18\n" +
+	"\x16ListFamilyLinksRequest\"\xe5\x01\n" +
+	"\n" +
+	"FamilyLink\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\x03R\x06linkId\x12*\n" +
+	"\bguardian\x18\x02 \x01(\v2\x0e.analysis.UserR\bguardian\x12&\n" +
+	"\x06senior\x18\x03 \x01(\v2\x0e.analysis.UserR\x06senior\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vapproved_at\x18\a \x01(\tR\n" +
+	"approvedAt\"E\n" +
+	"\x17ListFamilyLinksResponse\x12*\n" +
+	"\x05links\x18\x01 \x03(\v2\x14.analysis.FamilyLinkR\x05links\"D\n" +
+	"\x13AdminGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"\x96\x01\n" +
+	"\tAdminUser\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.analysis.UserR\x04user\x12)\n" +
+	"\x10linked_providers\x18\x02 \x03(\tR\x0flinkedProviders\x12:\n" +
+	"\fsubscription\x18\x03 \x01(\v2\x16.analysis.SubscriptionR\fsubscription\"A\n" +
+	"\x12SetUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"i\n" +
+	"\x16SetSubscriptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tplan_type\x18\x02 \x01(\tR\bplanType\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"V\n" +
+	"\x19CancelSubscriptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\vimmediately\x18\x02 \x01(\bR\vimmediately\"4\n" +
+	"\x15ReanalyzeVideoRequest\x12\x1b\n" +
+	"\tvideo_url\x18\x01 \x01(\tR\bvideoUrl\"a\n" +
+	"\x16OverrideVerdictRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x18\n" +
+	"\averdict\x18\x02 \x01(\tR\averdict\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xe4\x01\n" +
+	"\x06APIKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\x03R\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1f\n" +
+	"\vdaily_quota\x18\x05 \x01(\x05R\n" +
+	"dailyQuota\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\a \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tR\trevokedAt\"b\n" +
+	"\x13CreateAPIKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x1f\n" +
+	"\vdaily_quota\x18\x03 \x01(\x05R\n" +
+	"dailyQuota\"R\n" +
+	"\x14CreateAPIKeyResponse\x12\"\n" +
+	"\x03key\x18\x01 \x01(\v2\x10.analysis.APIKeyR\x03key\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\",\n" +
+	"\x13RevokeAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\x03R\x05keyId\"\x14\n" +
+	"\x12ListAPIKeysRequest\";\n" +
+	"\x13ListAPIKeysResponse\x12$\n" +
+	"\x04keys\x18\x01 \x03(\v2\x10.analysis.APIKeyR\x04keys2\x93\x10\n" +
 	"\x0fAnalysisService\x12F\n" +
 	"\rStartAnalysis\x12\x19.analysis.AnalysisRequest\x1a\x1a.analysis.AnalysisResponse\x12F\n" +
 	"\x0eStreamProgress\x12\x19.analysis.ProgressRequest\x1a\x17.analysis.ProgressEvent0\x01\x12>\n" +
 	"\tGetResult\x12\x17.analysis.ResultRequest\x1a\x18.analysis.AnalysisResult\x12C\n" +
 	"\x0eCancelAnalysis\x12\x17.analysis.CancelRequest\x1a\x18.analysis.CancelResponse\x12B\n" +
 	"\x0fLoginWithGoogle\x12\x16.analysis.LoginRequest\x1a\x17.analysis.LoginResponse\x12L\n" +
+	"\x11LoginWithProvider\x12\x1e.analysis.ProviderLoginRequest\x1a\x17.analysis.LoginResponse\x12<\n" +
+	"\aRefresh\x12\x18.analysis.RefreshRequest\x1a\x17.analysis.LoginResponse\x12;\n" +
+	"\x06Logout\x12\x17.analysis.LogoutRequest\x1a\x18.analysis.LogoutResponse\x12L\n" +
 	"\x0eGetUserProfile\x12\x1b.analysis.GetProfileRequest\x1a\x1d.analysis.UserProfileResponse\x12H\n" +
-	"\x0eGetUserHistory\x12\x1b.analysis.GetHistoryRequest\x1a\x19.analysis.HistoryResponse\x12G\n" +
+	"\x0eGetUserHistory\x12\x1b.analysis.GetHistoryRequest\x1a\x19.analysis.HistoryResponse\x12T\n" +
+	"\x11DeleteHistoryItem\x12\x1e.analysis.DeleteHistoryRequest\x1a\x1f.analysis.DeleteHistoryResponse\x12M\n" +
+	"\fClearHistory\x12\x1d.analysis.ClearHistoryRequest\x1a\x1e.analysis.ClearHistoryResponse\x12G\n" +
 	"\fGetUploadURL\x12\x1a.analysis.UploadURLRequest\x1a\x1b.analysis.UploadURLResponse\x12V\n" +
-	"\x11GetAnalysisResult\x12\x1f.analysis.AnalysisResultRequest\x1a .analysis.AnalysisResultResponseB=Z;github.com/vanillaturtlechips/silver-guardian/backend/protob\x06proto3"
+	"\x11GetAnalysisResult\x12\x1f.analysis.AnalysisResultRequest\x1a .analysis.AnalysisResultResponse\x12U\n" +
+	"\x12StartBatchAnalysis\x12\x1e.analysis.BatchAnalysisRequest\x1a\x1f.analysis.BatchAnalysisResponse\x12U\n" +
+	"\x13StreamBatchProgress\x12\x1e.analysis.BatchProgressRequest\x1a\x1c.analysis.BatchProgressEvent0\x01\x12E\n" +
+	"\x0eGetBatchResult\x12\x1c.analysis.BatchResultRequest\x1a\x15.analysis.BatchResult\x12?\n" +
+	"\bAddWatch\x12\x19.analysis.AddWatchRequest\x1a\x18.analysis.WatchedChannel\x12J\n" +
+	"\vRemoveWatch\x12\x1c.analysis.RemoveWatchRequest\x1a\x1d.analysis.RemoveWatchResponse\x12J\n" +
+	"\vListWatches\x12\x1c.analysis.ListWatchesRequest\x1a\x1d.analysis.ListWatchesResponse\x12G\n" +
+	"\n" +
+	"ListAlerts\x12\x1b.analysis.ListAlertsRequest\x1a\x1c.analysis.ListAlertsResponse\x12S\n" +
+	"\x0eMarkAlertsRead\x12\x1f.analysis.MarkAlertsReadRequest\x1a .analysis.MarkAlertsReadResponse\x12Q\n" +
+	"\x12CreateFamilyInvite\x12#.analysis.CreateFamilyInviteRequest\x1a\x16.analysis.FamilyInvite\x12M\n" +
+	"\x11ClaimFamilyInvite\x12\".analysis.ClaimFamilyInviteRequest\x1a\x14.analysis.FamilyLink\x12M\n" +
+	"\x11ApproveFamilyLink\x12\".analysis.ApproveFamilyLinkRequest\x1a\x14.analysis.FamilyLink\x12K\n" +
+	"\x10RevokeFamilyLink\x12!.analysis.RevokeFamilyLinkRequest\x1a\x14.analysis.FamilyLink\x12V\n" +
+	"\x0fListFamilyLinks\x12 .analysis.ListFamilyLinksRequest\x1a!.analysis.ListFamilyLinksResponse2\xab\x05\n" +
+	"\fAdminService\x12=\n" +
+	"\aGetUser\x12\x1d.analysis.AdminGetUserRequest\x1a\x13.analysis.AdminUser\x12@\n" +
+	"\vSetUserRole\x12\x1c.analysis.SetUserRoleRequest\x1a\x13.analysis.AdminUser\x12M\n" +
+	"\x0eReanalyzeVideo\x12\x1f.analysis.ReanalyzeVideoRequest\x1a\x1a.analysis.AnalysisResponse\x12U\n" +
+	"\x0fOverrideVerdict\x12 .analysis.OverrideVerdictRequest\x1a .analysis.AnalysisResultResponse\x12M\n" +
+	"\fCreateAPIKey\x12\x1d.analysis.CreateAPIKeyRequest\x1a\x1e.analysis.CreateAPIKeyResponse\x12?\n" +
+	"\fRevokeAPIKey\x12\x1d.analysis.RevokeAPIKeyRequest\x1a\x10.analysis.APIKey\x12J\n" +
+	"\vListAPIKeys\x12\x1c.analysis.ListAPIKeysRequest\x1a\x1d.analysis.ListAPIKeysResponse\x12H\n" +
+	"\x0fSetSubscription\x12 .analysis.SetSubscriptionRequest\x1a\x13.analysis.AdminUser\x12N\n" +
+	"\x12CancelSubscription\x12#.analysis.CancelSubscriptionRequest\x1a\x13.analysis.AdminUserB=Z;github.com/vanillaturtlechips/silver-guardian/backend/protob\x06proto3"
 
 var (
 	file_proto_analysis_proto_rawDescOnce sync.Once
@@ -1673,64 +5261,183 @@ func file_proto_analysis_proto_rawDescGZIP() []byte {
 	return file_proto_analysis_proto_rawDescData
 }
 
-var file_proto_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_proto_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_proto_analysis_proto_goTypes = []any{
-	(*AnalysisRequest)(nil),        // 0: analysis.AnalysisRequest
-	(*AnalysisOptions)(nil),        // 1: analysis.AnalysisOptions
-	(*AnalysisResponse)(nil),       // 2: analysis.AnalysisResponse
-	(*ProgressRequest)(nil),        // 3: analysis.ProgressRequest
-	(*ProgressEvent)(nil),          // 4: analysis.ProgressEvent
-	(*ResultRequest)(nil),          // 5: analysis.ResultRequest
-	(*AnalysisResult)(nil),         // 6: analysis.AnalysisResult
-	(*VideoMetadata)(nil),          // 7: analysis.VideoMetadata
-	(*Comment)(nil),                // 8: analysis.Comment
-	(*CancelRequest)(nil),          // 9: analysis.CancelRequest
-	(*CancelResponse)(nil),         // 10: analysis.CancelResponse
-	(*LoginRequest)(nil),           // 11: analysis.LoginRequest
-	(*LoginResponse)(nil),          // 12: analysis.LoginResponse
-	(*GetProfileRequest)(nil),      // 13: analysis.GetProfileRequest
-	(*UserProfileResponse)(nil),    // 14: analysis.UserProfileResponse
-	(*GetHistoryRequest)(nil),      // 15: analysis.GetHistoryRequest
-	(*HistoryResponse)(nil),        // 16: analysis.HistoryResponse
-	(*User)(nil),                   // 17: analysis.User
-	(*Subscription)(nil),           // 18: analysis.Subscription
-	(*HistoryItem)(nil),            // 19: analysis.HistoryItem
-	(*UploadURLRequest)(nil),       // 20: analysis.UploadURLRequest
-	(*UploadURLResponse)(nil),      // 21: analysis.UploadURLResponse
-	(*AnalysisResultRequest)(nil),  // 22: analysis.AnalysisResultRequest
-	(*AnalysisResultResponse)(nil), // 23: analysis.AnalysisResultResponse
+	(*AnalysisRequest)(nil),           // 0: analysis.AnalysisRequest
+	(*AnalysisOptions)(nil),           // 1: analysis.AnalysisOptions
+	(*AnalysisResponse)(nil),          // 2: analysis.AnalysisResponse
+	(*ProgressRequest)(nil),           // 3: analysis.ProgressRequest
+	(*ProgressEvent)(nil),             // 4: analysis.ProgressEvent
+	(*ResultRequest)(nil),             // 5: analysis.ResultRequest
+	(*AnalysisResult)(nil),            // 6: analysis.AnalysisResult
+	(*VideoMetadata)(nil),             // 7: analysis.VideoMetadata
+	(*ChannelInfo)(nil),               // 8: analysis.ChannelInfo
+	(*Comment)(nil),                   // 9: analysis.Comment
+	(*CancelRequest)(nil),             // 10: analysis.CancelRequest
+	(*CancelResponse)(nil),            // 11: analysis.CancelResponse
+	(*LoginRequest)(nil),              // 12: analysis.LoginRequest
+	(*ProviderLoginRequest)(nil),      // 13: analysis.ProviderLoginRequest
+	(*LoginResponse)(nil),             // 14: analysis.LoginResponse
+	(*RefreshRequest)(nil),            // 15: analysis.RefreshRequest
+	(*LogoutRequest)(nil),             // 16: analysis.LogoutRequest
+	(*LogoutResponse)(nil),            // 17: analysis.LogoutResponse
+	(*GetProfileRequest)(nil),         // 18: analysis.GetProfileRequest
+	(*UserProfileResponse)(nil),       // 19: analysis.UserProfileResponse
+	(*Entitlements)(nil),              // 20: analysis.Entitlements
+	(*GetHistoryRequest)(nil),         // 21: analysis.GetHistoryRequest
+	(*HistoryResponse)(nil),           // 22: analysis.HistoryResponse
+	(*User)(nil),                      // 23: analysis.User
+	(*Subscription)(nil),              // 24: analysis.Subscription
+	(*HistoryItem)(nil),               // 25: analysis.HistoryItem
+	(*DeleteHistoryRequest)(nil),      // 26: analysis.DeleteHistoryRequest
+	(*DeleteHistoryResponse)(nil),     // 27: analysis.DeleteHistoryResponse
+	(*ClearHistoryRequest)(nil),       // 28: analysis.ClearHistoryRequest
+	(*ClearHistoryResponse)(nil),      // 29: analysis.ClearHistoryResponse
+	(*UploadURLRequest)(nil),          // 30: analysis.UploadURLRequest
+	(*UploadURLResponse)(nil),         // 31: analysis.UploadURLResponse
+	(*AnalysisResultRequest)(nil),     // 32: analysis.AnalysisResultRequest
+	(*AnalysisResultResponse)(nil),    // 33: analysis.AnalysisResultResponse
+	(*BatchAnalysisRequest)(nil),      // 34: analysis.BatchAnalysisRequest
+	(*BatchAnalysisResponse)(nil),     // 35: analysis.BatchAnalysisResponse
+	(*BatchProgressRequest)(nil),      // 36: analysis.BatchProgressRequest
+	(*BatchProgressEvent)(nil),        // 37: analysis.BatchProgressEvent
+	(*BatchResultRequest)(nil),        // 38: analysis.BatchResultRequest
+	(*BatchItem)(nil),                 // 39: analysis.BatchItem
+	(*BatchRiskSummary)(nil),          // 40: analysis.BatchRiskSummary
+	(*BatchResult)(nil),               // 41: analysis.BatchResult
+	(*AddWatchRequest)(nil),           // 42: analysis.AddWatchRequest
+	(*WatchedChannel)(nil),            // 43: analysis.WatchedChannel
+	(*RemoveWatchRequest)(nil),        // 44: analysis.RemoveWatchRequest
+	(*RemoveWatchResponse)(nil),       // 45: analysis.RemoveWatchResponse
+	(*ListWatchesRequest)(nil),        // 46: analysis.ListWatchesRequest
+	(*ListWatchesResponse)(nil),       // 47: analysis.ListWatchesResponse
+	(*ListAlertsRequest)(nil),         // 48: analysis.ListAlertsRequest
+	(*WatchAlert)(nil),                // 49: analysis.WatchAlert
+	(*ListAlertsResponse)(nil),        // 50: analysis.ListAlertsResponse
+	(*MarkAlertsReadRequest)(nil),     // 51: analysis.MarkAlertsReadRequest
+	(*MarkAlertsReadResponse)(nil),    // 52: analysis.MarkAlertsReadResponse
+	(*CreateFamilyInviteRequest)(nil), // 53: analysis.CreateFamilyInviteRequest
+	(*FamilyInvite)(nil),              // 54: analysis.FamilyInvite
+	(*ClaimFamilyInviteRequest)(nil),  // 55: analysis.ClaimFamilyInviteRequest
+	(*ApproveFamilyLinkRequest)(nil),  // 56: analysis.ApproveFamilyLinkRequest
+	(*RevokeFamilyLinkRequest)(nil),   // 57: analysis.RevokeFamilyLinkRequest
+	(*ListFamilyLinksRequest)(nil),    // 58: analysis.ListFamilyLinksRequest
+	(*FamilyLink)(nil),                // 59: analysis.FamilyLink
+	(*ListFamilyLinksResponse)(nil),   // 60: analysis.ListFamilyLinksResponse
+	(*AdminGetUserRequest)(nil),       // 61: analysis.AdminGetUserRequest
+	(*AdminUser)(nil),                 // 62: analysis.AdminUser
+	(*SetUserRoleRequest)(nil),        // 63: analysis.SetUserRoleRequest
+	(*SetSubscriptionRequest)(nil),    // 64: analysis.SetSubscriptionRequest
+	(*CancelSubscriptionRequest)(nil), // 65: analysis.CancelSubscriptionRequest
+	(*ReanalyzeVideoRequest)(nil),     // 66: analysis.ReanalyzeVideoRequest
+	(*OverrideVerdictRequest)(nil),    // 67: analysis.OverrideVerdictRequest
+	(*APIKey)(nil),                    // 68: analysis.APIKey
+	(*CreateAPIKeyRequest)(nil),       // 69: analysis.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),      // 70: analysis.CreateAPIKeyResponse
+	(*RevokeAPIKeyRequest)(nil),       // 71: analysis.RevokeAPIKeyRequest
+	(*ListAPIKeysRequest)(nil),        // 72: analysis.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),       // 73: analysis.ListAPIKeysResponse
 }
 var file_proto_analysis_proto_depIdxs = []int32{
 	1,  // 0: analysis.AnalysisRequest.options:type_name -> analysis.AnalysisOptions
 	7,  // 1: analysis.AnalysisResult.metadata:type_name -> analysis.VideoMetadata
-	8,  // 2: analysis.AnalysisResult.top_comments:type_name -> analysis.Comment
-	17, // 3: analysis.LoginResponse.user:type_name -> analysis.User
-	17, // 4: analysis.UserProfileResponse.user:type_name -> analysis.User
-	18, // 5: analysis.UserProfileResponse.subscription:type_name -> analysis.Subscription
-	19, // 6: analysis.HistoryResponse.items:type_name -> analysis.HistoryItem
-	0,  // 7: analysis.AnalysisService.StartAnalysis:input_type -> analysis.AnalysisRequest
-	3,  // 8: analysis.AnalysisService.StreamProgress:input_type -> analysis.ProgressRequest
-	5,  // 9: analysis.AnalysisService.GetResult:input_type -> analysis.ResultRequest
-	9,  // 10: analysis.AnalysisService.CancelAnalysis:input_type -> analysis.CancelRequest
-	11, // 11: analysis.AnalysisService.LoginWithGoogle:input_type -> analysis.LoginRequest
-	13, // 12: analysis.AnalysisService.GetUserProfile:input_type -> analysis.GetProfileRequest
-	15, // 13: analysis.AnalysisService.GetUserHistory:input_type -> analysis.GetHistoryRequest
-	20, // 14: analysis.AnalysisService.GetUploadURL:input_type -> analysis.UploadURLRequest
-	22, // 15: analysis.AnalysisService.GetAnalysisResult:input_type -> analysis.AnalysisResultRequest
-	2,  // 16: analysis.AnalysisService.StartAnalysis:output_type -> analysis.AnalysisResponse
-	4,  // 17: analysis.AnalysisService.StreamProgress:output_type -> analysis.ProgressEvent
-	6,  // 18: analysis.AnalysisService.GetResult:output_type -> analysis.AnalysisResult
-	10, // 19: analysis.AnalysisService.CancelAnalysis:output_type -> analysis.CancelResponse
-	12, // 20: analysis.AnalysisService.LoginWithGoogle:output_type -> analysis.LoginResponse
-	14, // 21: analysis.AnalysisService.GetUserProfile:output_type -> analysis.UserProfileResponse
-	16, // 22: analysis.AnalysisService.GetUserHistory:output_type -> analysis.HistoryResponse
-	21, // 23: analysis.AnalysisService.GetUploadURL:output_type -> analysis.UploadURLResponse
-	23, // 24: analysis.AnalysisService.GetAnalysisResult:output_type -> analysis.AnalysisResultResponse
-	16, // [16:25] is the sub-list for method output_type
-	7,  // [7:16] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	9,  // 2: analysis.AnalysisResult.top_comments:type_name -> analysis.Comment
+	8,  // 3: analysis.VideoMetadata.channel_info:type_name -> analysis.ChannelInfo
+	23, // 4: analysis.LoginResponse.user:type_name -> analysis.User
+	23, // 5: analysis.UserProfileResponse.user:type_name -> analysis.User
+	24, // 6: analysis.UserProfileResponse.subscription:type_name -> analysis.Subscription
+	20, // 7: analysis.UserProfileResponse.entitlements:type_name -> analysis.Entitlements
+	25, // 8: analysis.HistoryResponse.items:type_name -> analysis.HistoryItem
+	1,  // 9: analysis.BatchAnalysisRequest.options:type_name -> analysis.AnalysisOptions
+	8,  // 10: analysis.BatchRiskSummary.channel_info:type_name -> analysis.ChannelInfo
+	39, // 11: analysis.BatchResult.items:type_name -> analysis.BatchItem
+	40, // 12: analysis.BatchResult.summary:type_name -> analysis.BatchRiskSummary
+	43, // 13: analysis.ListWatchesResponse.channels:type_name -> analysis.WatchedChannel
+	49, // 14: analysis.ListAlertsResponse.alerts:type_name -> analysis.WatchAlert
+	23, // 15: analysis.FamilyLink.guardian:type_name -> analysis.User
+	23, // 16: analysis.FamilyLink.senior:type_name -> analysis.User
+	59, // 17: analysis.ListFamilyLinksResponse.links:type_name -> analysis.FamilyLink
+	23, // 18: analysis.AdminUser.user:type_name -> analysis.User
+	24, // 19: analysis.AdminUser.subscription:type_name -> analysis.Subscription
+	68, // 20: analysis.CreateAPIKeyResponse.key:type_name -> analysis.APIKey
+	68, // 21: analysis.ListAPIKeysResponse.keys:type_name -> analysis.APIKey
+	0,  // 22: analysis.AnalysisService.StartAnalysis:input_type -> analysis.AnalysisRequest
+	3,  // 23: analysis.AnalysisService.StreamProgress:input_type -> analysis.ProgressRequest
+	5,  // 24: analysis.AnalysisService.GetResult:input_type -> analysis.ResultRequest
+	10, // 25: analysis.AnalysisService.CancelAnalysis:input_type -> analysis.CancelRequest
+	12, // 26: analysis.AnalysisService.LoginWithGoogle:input_type -> analysis.LoginRequest
+	13, // 27: analysis.AnalysisService.LoginWithProvider:input_type -> analysis.ProviderLoginRequest
+	15, // 28: analysis.AnalysisService.Refresh:input_type -> analysis.RefreshRequest
+	16, // 29: analysis.AnalysisService.Logout:input_type -> analysis.LogoutRequest
+	18, // 30: analysis.AnalysisService.GetUserProfile:input_type -> analysis.GetProfileRequest
+	21, // 31: analysis.AnalysisService.GetUserHistory:input_type -> analysis.GetHistoryRequest
+	26, // 32: analysis.AnalysisService.DeleteHistoryItem:input_type -> analysis.DeleteHistoryRequest
+	28, // 33: analysis.AnalysisService.ClearHistory:input_type -> analysis.ClearHistoryRequest
+	30, // 34: analysis.AnalysisService.GetUploadURL:input_type -> analysis.UploadURLRequest
+	32, // 35: analysis.AnalysisService.GetAnalysisResult:input_type -> analysis.AnalysisResultRequest
+	34, // 36: analysis.AnalysisService.StartBatchAnalysis:input_type -> analysis.BatchAnalysisRequest
+	36, // 37: analysis.AnalysisService.StreamBatchProgress:input_type -> analysis.BatchProgressRequest
+	38, // 38: analysis.AnalysisService.GetBatchResult:input_type -> analysis.BatchResultRequest
+	42, // 39: analysis.AnalysisService.AddWatch:input_type -> analysis.AddWatchRequest
+	44, // 40: analysis.AnalysisService.RemoveWatch:input_type -> analysis.RemoveWatchRequest
+	46, // 41: analysis.AnalysisService.ListWatches:input_type -> analysis.ListWatchesRequest
+	48, // 42: analysis.AnalysisService.ListAlerts:input_type -> analysis.ListAlertsRequest
+	51, // 43: analysis.AnalysisService.MarkAlertsRead:input_type -> analysis.MarkAlertsReadRequest
+	53, // 44: analysis.AnalysisService.CreateFamilyInvite:input_type -> analysis.CreateFamilyInviteRequest
+	55, // 45: analysis.AnalysisService.ClaimFamilyInvite:input_type -> analysis.ClaimFamilyInviteRequest
+	56, // 46: analysis.AnalysisService.ApproveFamilyLink:input_type -> analysis.ApproveFamilyLinkRequest
+	57, // 47: analysis.AnalysisService.RevokeFamilyLink:input_type -> analysis.RevokeFamilyLinkRequest
+	58, // 48: analysis.AnalysisService.ListFamilyLinks:input_type -> analysis.ListFamilyLinksRequest
+	61, // 49: analysis.AdminService.GetUser:input_type -> analysis.AdminGetUserRequest
+	63, // 50: analysis.AdminService.SetUserRole:input_type -> analysis.SetUserRoleRequest
+	66, // 51: analysis.AdminService.ReanalyzeVideo:input_type -> analysis.ReanalyzeVideoRequest
+	67, // 52: analysis.AdminService.OverrideVerdict:input_type -> analysis.OverrideVerdictRequest
+	69, // 53: analysis.AdminService.CreateAPIKey:input_type -> analysis.CreateAPIKeyRequest
+	71, // 54: analysis.AdminService.RevokeAPIKey:input_type -> analysis.RevokeAPIKeyRequest
+	72, // 55: analysis.AdminService.ListAPIKeys:input_type -> analysis.ListAPIKeysRequest
+	64, // 56: analysis.AdminService.SetSubscription:input_type -> analysis.SetSubscriptionRequest
+	65, // 57: analysis.AdminService.CancelSubscription:input_type -> analysis.CancelSubscriptionRequest
+	2,  // 58: analysis.AnalysisService.StartAnalysis:output_type -> analysis.AnalysisResponse
+	4,  // 59: analysis.AnalysisService.StreamProgress:output_type -> analysis.ProgressEvent
+	6,  // 60: analysis.AnalysisService.GetResult:output_type -> analysis.AnalysisResult
+	11, // 61: analysis.AnalysisService.CancelAnalysis:output_type -> analysis.CancelResponse
+	14, // 62: analysis.AnalysisService.LoginWithGoogle:output_type -> analysis.LoginResponse
+	14, // 63: analysis.AnalysisService.LoginWithProvider:output_type -> analysis.LoginResponse
+	14, // 64: analysis.AnalysisService.Refresh:output_type -> analysis.LoginResponse
+	17, // 65: analysis.AnalysisService.Logout:output_type -> analysis.LogoutResponse
+	19, // 66: analysis.AnalysisService.GetUserProfile:output_type -> analysis.UserProfileResponse
+	22, // 67: analysis.AnalysisService.GetUserHistory:output_type -> analysis.HistoryResponse
+	27, // 68: analysis.AnalysisService.DeleteHistoryItem:output_type -> analysis.DeleteHistoryResponse
+	29, // 69: analysis.AnalysisService.ClearHistory:output_type -> analysis.ClearHistoryResponse
+	31, // 70: analysis.AnalysisService.GetUploadURL:output_type -> analysis.UploadURLResponse
+	33, // 71: analysis.AnalysisService.GetAnalysisResult:output_type -> analysis.AnalysisResultResponse
+	35, // 72: analysis.AnalysisService.StartBatchAnalysis:output_type -> analysis.BatchAnalysisResponse
+	37, // 73: analysis.AnalysisService.StreamBatchProgress:output_type -> analysis.BatchProgressEvent
+	41, // 74: analysis.AnalysisService.GetBatchResult:output_type -> analysis.BatchResult
+	43, // 75: analysis.AnalysisService.AddWatch:output_type -> analysis.WatchedChannel
+	45, // 76: analysis.AnalysisService.RemoveWatch:output_type -> analysis.RemoveWatchResponse
+	47, // 77: analysis.AnalysisService.ListWatches:output_type -> analysis.ListWatchesResponse
+	50, // 78: analysis.AnalysisService.ListAlerts:output_type -> analysis.ListAlertsResponse
+	52, // 79: analysis.AnalysisService.MarkAlertsRead:output_type -> analysis.MarkAlertsReadResponse
+	54, // 80: analysis.AnalysisService.CreateFamilyInvite:output_type -> analysis.FamilyInvite
+	59, // 81: analysis.AnalysisService.ClaimFamilyInvite:output_type -> analysis.FamilyLink
+	59, // 82: analysis.AnalysisService.ApproveFamilyLink:output_type -> analysis.FamilyLink
+	59, // 83: analysis.AnalysisService.RevokeFamilyLink:output_type -> analysis.FamilyLink
+	60, // 84: analysis.AnalysisService.ListFamilyLinks:output_type -> analysis.ListFamilyLinksResponse
+	62, // 85: analysis.AdminService.GetUser:output_type -> analysis.AdminUser
+	62, // 86: analysis.AdminService.SetUserRole:output_type -> analysis.AdminUser
+	2,  // 87: analysis.AdminService.ReanalyzeVideo:output_type -> analysis.AnalysisResponse
+	33, // 88: analysis.AdminService.OverrideVerdict:output_type -> analysis.AnalysisResultResponse
+	70, // 89: analysis.AdminService.CreateAPIKey:output_type -> analysis.CreateAPIKeyResponse
+	68, // 90: analysis.AdminService.RevokeAPIKey:output_type -> analysis.APIKey
+	73, // 91: analysis.AdminService.ListAPIKeys:output_type -> analysis.ListAPIKeysResponse
+	62, // 92: analysis.AdminService.SetSubscription:output_type -> analysis.AdminUser
+	62, // 93: analysis.AdminService.CancelSubscription:output_type -> analysis.AdminUser
+	58, // [58:94] is the sub-list for method output_type
+	22, // [22:58] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_proto_analysis_proto_init() }
@@ -1738,15 +5445,16 @@ func file_proto_analysis_proto_init() {
 	if File_proto_analysis_proto != nil {
 		return
 	}
+	file_proto_analysis_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_analysis_proto_rawDesc), len(file_proto_analysis_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   74,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_analysis_proto_goTypes,
 		DependencyIndexes: file_proto_analysis_proto_depIdxs,
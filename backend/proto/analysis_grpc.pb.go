@@ -19,15 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AnalysisService_StartAnalysis_FullMethodName     = "/analysis.AnalysisService/StartAnalysis"
-	AnalysisService_StreamProgress_FullMethodName    = "/analysis.AnalysisService/StreamProgress"
-	AnalysisService_GetResult_FullMethodName         = "/analysis.AnalysisService/GetResult"
-	AnalysisService_CancelAnalysis_FullMethodName    = "/analysis.AnalysisService/CancelAnalysis"
-	AnalysisService_LoginWithGoogle_FullMethodName   = "/analysis.AnalysisService/LoginWithGoogle"
-	AnalysisService_GetUserProfile_FullMethodName    = "/analysis.AnalysisService/GetUserProfile"
-	AnalysisService_GetUserHistory_FullMethodName    = "/analysis.AnalysisService/GetUserHistory"
-	AnalysisService_GetUploadURL_FullMethodName      = "/analysis.AnalysisService/GetUploadURL"
-	AnalysisService_GetAnalysisResult_FullMethodName = "/analysis.AnalysisService/GetAnalysisResult"
+	AnalysisService_StartAnalysis_FullMethodName       = "/analysis.AnalysisService/StartAnalysis"
+	AnalysisService_StreamProgress_FullMethodName      = "/analysis.AnalysisService/StreamProgress"
+	AnalysisService_GetResult_FullMethodName           = "/analysis.AnalysisService/GetResult"
+	AnalysisService_CancelAnalysis_FullMethodName      = "/analysis.AnalysisService/CancelAnalysis"
+	AnalysisService_LoginWithGoogle_FullMethodName     = "/analysis.AnalysisService/LoginWithGoogle"
+	AnalysisService_LoginWithProvider_FullMethodName   = "/analysis.AnalysisService/LoginWithProvider"
+	AnalysisService_Refresh_FullMethodName             = "/analysis.AnalysisService/Refresh"
+	AnalysisService_Logout_FullMethodName              = "/analysis.AnalysisService/Logout"
+	AnalysisService_GetUserProfile_FullMethodName      = "/analysis.AnalysisService/GetUserProfile"
+	AnalysisService_GetUserHistory_FullMethodName      = "/analysis.AnalysisService/GetUserHistory"
+	AnalysisService_DeleteHistoryItem_FullMethodName   = "/analysis.AnalysisService/DeleteHistoryItem"
+	AnalysisService_ClearHistory_FullMethodName        = "/analysis.AnalysisService/ClearHistory"
+	AnalysisService_GetUploadURL_FullMethodName        = "/analysis.AnalysisService/GetUploadURL"
+	AnalysisService_GetAnalysisResult_FullMethodName   = "/analysis.AnalysisService/GetAnalysisResult"
+	AnalysisService_StartBatchAnalysis_FullMethodName  = "/analysis.AnalysisService/StartBatchAnalysis"
+	AnalysisService_StreamBatchProgress_FullMethodName = "/analysis.AnalysisService/StreamBatchProgress"
+	AnalysisService_GetBatchResult_FullMethodName      = "/analysis.AnalysisService/GetBatchResult"
+	AnalysisService_AddWatch_FullMethodName            = "/analysis.AnalysisService/AddWatch"
+	AnalysisService_RemoveWatch_FullMethodName         = "/analysis.AnalysisService/RemoveWatch"
+	AnalysisService_ListWatches_FullMethodName         = "/analysis.AnalysisService/ListWatches"
+	AnalysisService_ListAlerts_FullMethodName          = "/analysis.AnalysisService/ListAlerts"
+	AnalysisService_MarkAlertsRead_FullMethodName      = "/analysis.AnalysisService/MarkAlertsRead"
+	AnalysisService_CreateFamilyInvite_FullMethodName  = "/analysis.AnalysisService/CreateFamilyInvite"
+	AnalysisService_ClaimFamilyInvite_FullMethodName   = "/analysis.AnalysisService/ClaimFamilyInvite"
+	AnalysisService_ApproveFamilyLink_FullMethodName   = "/analysis.AnalysisService/ApproveFamilyLink"
+	AnalysisService_RevokeFamilyLink_FullMethodName    = "/analysis.AnalysisService/RevokeFamilyLink"
+	AnalysisService_ListFamilyLinks_FullMethodName     = "/analysis.AnalysisService/ListFamilyLinks"
 )
 
 // AnalysisServiceClient is the client API for AnalysisService service.
@@ -46,12 +64,33 @@ type AnalysisServiceClient interface {
 	CancelAnalysis(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
 	// Auth & User 기능을 위한 RPC 추가 ---
 	LoginWithGoogle(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	LoginWithProvider(ctx context.Context, in *ProviderLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	GetUserProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*UserProfileResponse, error)
 	GetUserHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	DeleteHistoryItem(ctx context.Context, in *DeleteHistoryRequest, opts ...grpc.CallOption) (*DeleteHistoryResponse, error)
+	ClearHistory(ctx context.Context, in *ClearHistoryRequest, opts ...grpc.CallOption) (*ClearHistoryResponse, error)
 	// S3 Presigned URL 발급 ---
 	GetUploadURL(ctx context.Context, in *UploadURLRequest, opts ...grpc.CallOption) (*UploadURLResponse, error)
 	// 분석 결과 조회 (video_id 기반) ---
 	GetAnalysisResult(ctx context.Context, in *AnalysisResultRequest, opts ...grpc.CallOption) (*AnalysisResultResponse, error)
+	// 재생목록/채널 일괄 분석 ---
+	StartBatchAnalysis(ctx context.Context, in *BatchAnalysisRequest, opts ...grpc.CallOption) (*BatchAnalysisResponse, error)
+	StreamBatchProgress(ctx context.Context, in *BatchProgressRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchProgressEvent], error)
+	GetBatchResult(ctx context.Context, in *BatchResultRequest, opts ...grpc.CallOption) (*BatchResult, error)
+	// 채널 구독 (새 업로드 자동 분석 및 알림) ---
+	AddWatch(ctx context.Context, in *AddWatchRequest, opts ...grpc.CallOption) (*WatchedChannel, error)
+	RemoveWatch(ctx context.Context, in *RemoveWatchRequest, opts ...grpc.CallOption) (*RemoveWatchResponse, error)
+	ListWatches(ctx context.Context, in *ListWatchesRequest, opts ...grpc.CallOption) (*ListWatchesResponse, error)
+	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
+	MarkAlertsRead(ctx context.Context, in *MarkAlertsReadRequest, opts ...grpc.CallOption) (*MarkAlertsReadResponse, error)
+	// 가족 연결 (보호자가 어르신의 기록/위험 알림을 함께 확인) ---
+	CreateFamilyInvite(ctx context.Context, in *CreateFamilyInviteRequest, opts ...grpc.CallOption) (*FamilyInvite, error)
+	ClaimFamilyInvite(ctx context.Context, in *ClaimFamilyInviteRequest, opts ...grpc.CallOption) (*FamilyLink, error)
+	ApproveFamilyLink(ctx context.Context, in *ApproveFamilyLinkRequest, opts ...grpc.CallOption) (*FamilyLink, error)
+	RevokeFamilyLink(ctx context.Context, in *RevokeFamilyLinkRequest, opts ...grpc.CallOption) (*FamilyLink, error)
+	ListFamilyLinks(ctx context.Context, in *ListFamilyLinksRequest, opts ...grpc.CallOption) (*ListFamilyLinksResponse, error)
 }
 
 type analysisServiceClient struct {
@@ -121,6 +160,36 @@ func (c *analysisServiceClient) LoginWithGoogle(ctx context.Context, in *LoginRe
 	return out, nil
 }
 
+func (c *analysisServiceClient) LoginWithProvider(ctx context.Context, in *ProviderLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_LoginWithProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_Refresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analysisServiceClient) GetUserProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*UserProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserProfileResponse)
@@ -141,6 +210,26 @@ func (c *analysisServiceClient) GetUserHistory(ctx context.Context, in *GetHisto
 	return out, nil
 }
 
+func (c *analysisServiceClient) DeleteHistoryItem(ctx context.Context, in *DeleteHistoryRequest, opts ...grpc.CallOption) (*DeleteHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHistoryResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_DeleteHistoryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) ClearHistory(ctx context.Context, in *ClearHistoryRequest, opts ...grpc.CallOption) (*ClearHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearHistoryResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_ClearHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analysisServiceClient) GetUploadURL(ctx context.Context, in *UploadURLRequest, opts ...grpc.CallOption) (*UploadURLResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadURLResponse)
@@ -161,6 +250,145 @@ func (c *analysisServiceClient) GetAnalysisResult(ctx context.Context, in *Analy
 	return out, nil
 }
 
+func (c *analysisServiceClient) StartBatchAnalysis(ctx context.Context, in *BatchAnalysisRequest, opts ...grpc.CallOption) (*BatchAnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAnalysisResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_StartBatchAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) StreamBatchProgress(ctx context.Context, in *BatchProgressRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchProgressEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AnalysisService_ServiceDesc.Streams[1], AnalysisService_StreamBatchProgress_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchProgressRequest, BatchProgressEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnalysisService_StreamBatchProgressClient = grpc.ServerStreamingClient[BatchProgressEvent]
+
+func (c *analysisServiceClient) GetBatchResult(ctx context.Context, in *BatchResultRequest, opts ...grpc.CallOption) (*BatchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResult)
+	err := c.cc.Invoke(ctx, AnalysisService_GetBatchResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) AddWatch(ctx context.Context, in *AddWatchRequest, opts ...grpc.CallOption) (*WatchedChannel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchedChannel)
+	err := c.cc.Invoke(ctx, AnalysisService_AddWatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) RemoveWatch(ctx context.Context, in *RemoveWatchRequest, opts ...grpc.CallOption) (*RemoveWatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWatchResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_RemoveWatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) ListWatches(ctx context.Context, in *ListWatchesRequest, opts ...grpc.CallOption) (*ListWatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWatchesResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_ListWatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertsResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_ListAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) MarkAlertsRead(ctx context.Context, in *MarkAlertsReadRequest, opts ...grpc.CallOption) (*MarkAlertsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAlertsReadResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_MarkAlertsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) CreateFamilyInvite(ctx context.Context, in *CreateFamilyInviteRequest, opts ...grpc.CallOption) (*FamilyInvite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FamilyInvite)
+	err := c.cc.Invoke(ctx, AnalysisService_CreateFamilyInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) ClaimFamilyInvite(ctx context.Context, in *ClaimFamilyInviteRequest, opts ...grpc.CallOption) (*FamilyLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FamilyLink)
+	err := c.cc.Invoke(ctx, AnalysisService_ClaimFamilyInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) ApproveFamilyLink(ctx context.Context, in *ApproveFamilyLinkRequest, opts ...grpc.CallOption) (*FamilyLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FamilyLink)
+	err := c.cc.Invoke(ctx, AnalysisService_ApproveFamilyLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) RevokeFamilyLink(ctx context.Context, in *RevokeFamilyLinkRequest, opts ...grpc.CallOption) (*FamilyLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FamilyLink)
+	err := c.cc.Invoke(ctx, AnalysisService_RevokeFamilyLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) ListFamilyLinks(ctx context.Context, in *ListFamilyLinksRequest, opts ...grpc.CallOption) (*ListFamilyLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFamilyLinksResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_ListFamilyLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalysisServiceServer is the server API for AnalysisService service.
 // All implementations must embed UnimplementedAnalysisServiceServer
 // for forward compatibility.
@@ -177,12 +405,33 @@ type AnalysisServiceServer interface {
 	CancelAnalysis(context.Context, *CancelRequest) (*CancelResponse, error)
 	// Auth & User 기능을 위한 RPC 추가 ---
 	LoginWithGoogle(context.Context, *LoginRequest) (*LoginResponse, error)
+	LoginWithProvider(context.Context, *ProviderLoginRequest) (*LoginResponse, error)
+	Refresh(context.Context, *RefreshRequest) (*LoginResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
 	GetUserProfile(context.Context, *GetProfileRequest) (*UserProfileResponse, error)
 	GetUserHistory(context.Context, *GetHistoryRequest) (*HistoryResponse, error)
+	DeleteHistoryItem(context.Context, *DeleteHistoryRequest) (*DeleteHistoryResponse, error)
+	ClearHistory(context.Context, *ClearHistoryRequest) (*ClearHistoryResponse, error)
 	// S3 Presigned URL 발급 ---
 	GetUploadURL(context.Context, *UploadURLRequest) (*UploadURLResponse, error)
 	// 분석 결과 조회 (video_id 기반) ---
 	GetAnalysisResult(context.Context, *AnalysisResultRequest) (*AnalysisResultResponse, error)
+	// 재생목록/채널 일괄 분석 ---
+	StartBatchAnalysis(context.Context, *BatchAnalysisRequest) (*BatchAnalysisResponse, error)
+	StreamBatchProgress(*BatchProgressRequest, grpc.ServerStreamingServer[BatchProgressEvent]) error
+	GetBatchResult(context.Context, *BatchResultRequest) (*BatchResult, error)
+	// 채널 구독 (새 업로드 자동 분석 및 알림) ---
+	AddWatch(context.Context, *AddWatchRequest) (*WatchedChannel, error)
+	RemoveWatch(context.Context, *RemoveWatchRequest) (*RemoveWatchResponse, error)
+	ListWatches(context.Context, *ListWatchesRequest) (*ListWatchesResponse, error)
+	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
+	MarkAlertsRead(context.Context, *MarkAlertsReadRequest) (*MarkAlertsReadResponse, error)
+	// 가족 연결 (보호자가 어르신의 기록/위험 알림을 함께 확인) ---
+	CreateFamilyInvite(context.Context, *CreateFamilyInviteRequest) (*FamilyInvite, error)
+	ClaimFamilyInvite(context.Context, *ClaimFamilyInviteRequest) (*FamilyLink, error)
+	ApproveFamilyLink(context.Context, *ApproveFamilyLinkRequest) (*FamilyLink, error)
+	RevokeFamilyLink(context.Context, *RevokeFamilyLinkRequest) (*FamilyLink, error)
+	ListFamilyLinks(context.Context, *ListFamilyLinksRequest) (*ListFamilyLinksResponse, error)
 	mustEmbedUnimplementedAnalysisServiceServer()
 }
 
@@ -208,18 +457,72 @@ func (UnimplementedAnalysisServiceServer) CancelAnalysis(context.Context, *Cance
 func (UnimplementedAnalysisServiceServer) LoginWithGoogle(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LoginWithGoogle not implemented")
 }
+func (UnimplementedAnalysisServiceServer) LoginWithProvider(context.Context, *ProviderLoginRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LoginWithProvider not implemented")
+}
+func (UnimplementedAnalysisServiceServer) Refresh(context.Context, *RefreshRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedAnalysisServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedAnalysisServiceServer) GetUserProfile(context.Context, *GetProfileRequest) (*UserProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserProfile not implemented")
 }
 func (UnimplementedAnalysisServiceServer) GetUserHistory(context.Context, *GetHistoryRequest) (*HistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserHistory not implemented")
 }
+func (UnimplementedAnalysisServiceServer) DeleteHistoryItem(context.Context, *DeleteHistoryRequest) (*DeleteHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteHistoryItem not implemented")
+}
+func (UnimplementedAnalysisServiceServer) ClearHistory(context.Context, *ClearHistoryRequest) (*ClearHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearHistory not implemented")
+}
 func (UnimplementedAnalysisServiceServer) GetUploadURL(context.Context, *UploadURLRequest) (*UploadURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUploadURL not implemented")
 }
 func (UnimplementedAnalysisServiceServer) GetAnalysisResult(context.Context, *AnalysisResultRequest) (*AnalysisResultResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAnalysisResult not implemented")
 }
+func (UnimplementedAnalysisServiceServer) StartBatchAnalysis(context.Context, *BatchAnalysisRequest) (*BatchAnalysisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartBatchAnalysis not implemented")
+}
+func (UnimplementedAnalysisServiceServer) StreamBatchProgress(*BatchProgressRequest, grpc.ServerStreamingServer[BatchProgressEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamBatchProgress not implemented")
+}
+func (UnimplementedAnalysisServiceServer) GetBatchResult(context.Context, *BatchResultRequest) (*BatchResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBatchResult not implemented")
+}
+func (UnimplementedAnalysisServiceServer) AddWatch(context.Context, *AddWatchRequest) (*WatchedChannel, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddWatch not implemented")
+}
+func (UnimplementedAnalysisServiceServer) RemoveWatch(context.Context, *RemoveWatchRequest) (*RemoveWatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveWatch not implemented")
+}
+func (UnimplementedAnalysisServiceServer) ListWatches(context.Context, *ListWatchesRequest) (*ListWatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWatches not implemented")
+}
+func (UnimplementedAnalysisServiceServer) ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedAnalysisServiceServer) MarkAlertsRead(context.Context, *MarkAlertsReadRequest) (*MarkAlertsReadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkAlertsRead not implemented")
+}
+func (UnimplementedAnalysisServiceServer) CreateFamilyInvite(context.Context, *CreateFamilyInviteRequest) (*FamilyInvite, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFamilyInvite not implemented")
+}
+func (UnimplementedAnalysisServiceServer) ClaimFamilyInvite(context.Context, *ClaimFamilyInviteRequest) (*FamilyLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimFamilyInvite not implemented")
+}
+func (UnimplementedAnalysisServiceServer) ApproveFamilyLink(context.Context, *ApproveFamilyLinkRequest) (*FamilyLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveFamilyLink not implemented")
+}
+func (UnimplementedAnalysisServiceServer) RevokeFamilyLink(context.Context, *RevokeFamilyLinkRequest) (*FamilyLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeFamilyLink not implemented")
+}
+func (UnimplementedAnalysisServiceServer) ListFamilyLinks(context.Context, *ListFamilyLinksRequest) (*ListFamilyLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFamilyLinks not implemented")
+}
 func (UnimplementedAnalysisServiceServer) mustEmbedUnimplementedAnalysisServiceServer() {}
 func (UnimplementedAnalysisServiceServer) testEmbeddedByValue()                         {}
 
@@ -324,6 +627,60 @@ func _AnalysisService_LoginWithGoogle_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalysisService_LoginWithProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).LoginWithProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_LoginWithProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).LoginWithProvider(ctx, req.(*ProviderLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AnalysisService_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProfileRequest)
 	if err := dec(in); err != nil {
@@ -360,6 +717,42 @@ func _AnalysisService_GetUserHistory_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalysisService_DeleteHistoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).DeleteHistoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_DeleteHistoryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).DeleteHistoryItem(ctx, req.(*DeleteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_ClearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).ClearHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_ClearHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).ClearHistory(ctx, req.(*ClearHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AnalysisService_GetUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadURLRequest)
 	if err := dec(in); err != nil {
@@ -396,45 +789,340 @@ func _AnalysisService_GetAnalysisResult_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-// AnalysisService_ServiceDesc is the grpc.ServiceDesc for AnalysisService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AnalysisService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "analysis.AnalysisService",
-	HandlerType: (*AnalysisServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StartAnalysis",
-			Handler:    _AnalysisService_StartAnalysis_Handler,
-		},
-		{
-			MethodName: "GetResult",
-			Handler:    _AnalysisService_GetResult_Handler,
-		},
-		{
-			MethodName: "CancelAnalysis",
-			Handler:    _AnalysisService_CancelAnalysis_Handler,
-		},
-		{
-			MethodName: "LoginWithGoogle",
-			Handler:    _AnalysisService_LoginWithGoogle_Handler,
-		},
-		{
-			MethodName: "GetUserProfile",
-			Handler:    _AnalysisService_GetUserProfile_Handler,
-		},
-		{
-			MethodName: "GetUserHistory",
-			Handler:    _AnalysisService_GetUserHistory_Handler,
-		},
-		{
-			MethodName: "GetUploadURL",
-			Handler:    _AnalysisService_GetUploadURL_Handler,
-		},
-		{
-			MethodName: "GetAnalysisResult",
+func _AnalysisService_StartBatchAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).StartBatchAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_StartBatchAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).StartBatchAnalysis(ctx, req.(*BatchAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_StreamBatchProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AnalysisServiceServer).StreamBatchProgress(m, &grpc.GenericServerStream[BatchProgressRequest, BatchProgressEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnalysisService_StreamBatchProgressServer = grpc.ServerStreamingServer[BatchProgressEvent]
+
+func _AnalysisService_GetBatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).GetBatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_GetBatchResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).GetBatchResult(ctx, req.(*BatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_AddWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).AddWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_AddWatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).AddWatch(ctx, req.(*AddWatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_RemoveWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).RemoveWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_RemoveWatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).RemoveWatch(ctx, req.(*RemoveWatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_ListWatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).ListWatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_ListWatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).ListWatches(ctx, req.(*ListWatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_ListAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).ListAlerts(ctx, req.(*ListAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_MarkAlertsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAlertsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).MarkAlertsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_MarkAlertsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).MarkAlertsRead(ctx, req.(*MarkAlertsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_CreateFamilyInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFamilyInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).CreateFamilyInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_CreateFamilyInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).CreateFamilyInvite(ctx, req.(*CreateFamilyInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_ClaimFamilyInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimFamilyInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).ClaimFamilyInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_ClaimFamilyInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).ClaimFamilyInvite(ctx, req.(*ClaimFamilyInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_ApproveFamilyLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFamilyLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).ApproveFamilyLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_ApproveFamilyLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).ApproveFamilyLink(ctx, req.(*ApproveFamilyLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_RevokeFamilyLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeFamilyLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).RevokeFamilyLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_RevokeFamilyLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).RevokeFamilyLink(ctx, req.(*RevokeFamilyLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_ListFamilyLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFamilyLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).ListFamilyLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_ListFamilyLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).ListFamilyLinks(ctx, req.(*ListFamilyLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnalysisService_ServiceDesc is the grpc.ServiceDesc for AnalysisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnalysisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "analysis.AnalysisService",
+	HandlerType: (*AnalysisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartAnalysis",
+			Handler:    _AnalysisService_StartAnalysis_Handler,
+		},
+		{
+			MethodName: "GetResult",
+			Handler:    _AnalysisService_GetResult_Handler,
+		},
+		{
+			MethodName: "CancelAnalysis",
+			Handler:    _AnalysisService_CancelAnalysis_Handler,
+		},
+		{
+			MethodName: "LoginWithGoogle",
+			Handler:    _AnalysisService_LoginWithGoogle_Handler,
+		},
+		{
+			MethodName: "LoginWithProvider",
+			Handler:    _AnalysisService_LoginWithProvider_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _AnalysisService_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AnalysisService_Logout_Handler,
+		},
+		{
+			MethodName: "GetUserProfile",
+			Handler:    _AnalysisService_GetUserProfile_Handler,
+		},
+		{
+			MethodName: "GetUserHistory",
+			Handler:    _AnalysisService_GetUserHistory_Handler,
+		},
+		{
+			MethodName: "DeleteHistoryItem",
+			Handler:    _AnalysisService_DeleteHistoryItem_Handler,
+		},
+		{
+			MethodName: "ClearHistory",
+			Handler:    _AnalysisService_ClearHistory_Handler,
+		},
+		{
+			MethodName: "GetUploadURL",
+			Handler:    _AnalysisService_GetUploadURL_Handler,
+		},
+		{
+			MethodName: "GetAnalysisResult",
 			Handler:    _AnalysisService_GetAnalysisResult_Handler,
 		},
+		{
+			MethodName: "StartBatchAnalysis",
+			Handler:    _AnalysisService_StartBatchAnalysis_Handler,
+		},
+		{
+			MethodName: "GetBatchResult",
+			Handler:    _AnalysisService_GetBatchResult_Handler,
+		},
+		{
+			MethodName: "AddWatch",
+			Handler:    _AnalysisService_AddWatch_Handler,
+		},
+		{
+			MethodName: "RemoveWatch",
+			Handler:    _AnalysisService_RemoveWatch_Handler,
+		},
+		{
+			MethodName: "ListWatches",
+			Handler:    _AnalysisService_ListWatches_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _AnalysisService_ListAlerts_Handler,
+		},
+		{
+			MethodName: "MarkAlertsRead",
+			Handler:    _AnalysisService_MarkAlertsRead_Handler,
+		},
+		{
+			MethodName: "CreateFamilyInvite",
+			Handler:    _AnalysisService_CreateFamilyInvite_Handler,
+		},
+		{
+			MethodName: "ClaimFamilyInvite",
+			Handler:    _AnalysisService_ClaimFamilyInvite_Handler,
+		},
+		{
+			MethodName: "ApproveFamilyLink",
+			Handler:    _AnalysisService_ApproveFamilyLink_Handler,
+		},
+		{
+			MethodName: "RevokeFamilyLink",
+			Handler:    _AnalysisService_RevokeFamilyLink_Handler,
+		},
+		{
+			MethodName: "ListFamilyLinks",
+			Handler:    _AnalysisService_ListFamilyLinks_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -442,6 +1130,425 @@ var AnalysisService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _AnalysisService_StreamProgress_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamBatchProgress",
+			Handler:       _AnalysisService_StreamBatchProgress_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/analysis.proto",
+}
+
+const (
+	AdminService_GetUser_FullMethodName            = "/analysis.AdminService/GetUser"
+	AdminService_SetUserRole_FullMethodName        = "/analysis.AdminService/SetUserRole"
+	AdminService_ReanalyzeVideo_FullMethodName     = "/analysis.AdminService/ReanalyzeVideo"
+	AdminService_OverrideVerdict_FullMethodName    = "/analysis.AdminService/OverrideVerdict"
+	AdminService_CreateAPIKey_FullMethodName       = "/analysis.AdminService/CreateAPIKey"
+	AdminService_RevokeAPIKey_FullMethodName       = "/analysis.AdminService/RevokeAPIKey"
+	AdminService_ListAPIKeys_FullMethodName        = "/analysis.AdminService/ListAPIKeys"
+	AdminService_SetSubscription_FullMethodName    = "/analysis.AdminService/SetSubscription"
+	AdminService_CancelSubscription_FullMethodName = "/analysis.AdminService/CancelSubscription"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 운영 작업 (역할별 권한은 인터셉터가 확인: reviewer, admin)
+type AdminServiceClient interface {
+	GetUser(ctx context.Context, in *AdminGetUserRequest, opts ...grpc.CallOption) (*AdminUser, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*AdminUser, error)
+	ReanalyzeVideo(ctx context.Context, in *ReanalyzeVideoRequest, opts ...grpc.CallOption) (*AnalysisResponse, error)
+	OverrideVerdict(ctx context.Context, in *OverrideVerdictRequest, opts ...grpc.CallOption) (*AnalysisResultResponse, error)
+	// 파트너 API 키 (admin)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// 구독 관리 (admin). 결제로 인한 변경은 결제사 웹훅(/webhooks/billing)이 반영
+	SetSubscription(ctx context.Context, in *SetSubscriptionRequest, opts ...grpc.CallOption) (*AdminUser, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*AdminUser, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetUser(ctx context.Context, in *AdminGetUserRequest, opts ...grpc.CallOption) (*AdminUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUser)
+	err := c.cc.Invoke(ctx, AdminService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*AdminUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUser)
+	err := c.cc.Invoke(ctx, AdminService_SetUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReanalyzeVideo(ctx context.Context, in *ReanalyzeVideoRequest, opts ...grpc.CallOption) (*AnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalysisResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReanalyzeVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) OverrideVerdict(ctx context.Context, in *OverrideVerdictRequest, opts ...grpc.CallOption) (*AnalysisResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalysisResultResponse)
+	err := c.cc.Invoke(ctx, AdminService_OverrideVerdict_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, AdminService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetSubscription(ctx context.Context, in *SetSubscriptionRequest, opts ...grpc.CallOption) (*AdminUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUser)
+	err := c.cc.Invoke(ctx, AdminService_SetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*AdminUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUser)
+	err := c.cc.Invoke(ctx, AdminService_CancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// 운영 작업 (역할별 권한은 인터셉터가 확인: reviewer, admin)
+type AdminServiceServer interface {
+	GetUser(context.Context, *AdminGetUserRequest) (*AdminUser, error)
+	SetUserRole(context.Context, *SetUserRoleRequest) (*AdminUser, error)
+	ReanalyzeVideo(context.Context, *ReanalyzeVideoRequest) (*AnalysisResponse, error)
+	OverrideVerdict(context.Context, *OverrideVerdictRequest) (*AnalysisResultResponse, error)
+	// 파트너 API 키 (admin)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKey, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// 구독 관리 (admin). 결제로 인한 변경은 결제사 웹훅(/webhooks/billing)이 반영
+	SetSubscription(context.Context, *SetSubscriptionRequest) (*AdminUser, error)
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*AdminUser, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) GetUser(context.Context, *AdminGetUserRequest) (*AdminUser, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedAdminServiceServer) SetUserRole(context.Context, *SetUserRoleRequest) (*AdminUser, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedAdminServiceServer) ReanalyzeVideo(context.Context, *ReanalyzeVideoRequest) (*AnalysisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReanalyzeVideo not implemented")
+}
+func (UnimplementedAdminServiceServer) OverrideVerdict(context.Context, *OverrideVerdictRequest) (*AnalysisResultResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OverrideVerdict not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKey, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) SetSubscription(context.Context, *SetSubscriptionRequest) (*AdminUser, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSubscription not implemented")
+}
+func (UnimplementedAdminServiceServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*AdminUser, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUser(ctx, req.(*AdminGetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserRole(ctx, req.(*SetUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReanalyzeVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReanalyzeVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReanalyzeVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReanalyzeVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReanalyzeVideo(ctx, req.(*ReanalyzeVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_OverrideVerdict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideVerdictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).OverrideVerdict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_OverrideVerdict_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).OverrideVerdict(ctx, req.(*OverrideVerdictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetSubscription(ctx, req.(*SetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelSubscription(ctx, req.(*CancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "analysis.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _AdminService_GetUser_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _AdminService_SetUserRole_Handler,
+		},
+		{
+			MethodName: "ReanalyzeVideo",
+			Handler:    _AdminService_ReanalyzeVideo_Handler,
+		},
+		{
+			MethodName: "OverrideVerdict",
+			Handler:    _AdminService_OverrideVerdict_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _AdminService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _AdminService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _AdminService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "SetSubscription",
+			Handler:    _AdminService_SetSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _AdminService_CancelSubscription_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/analysis.proto",
 }
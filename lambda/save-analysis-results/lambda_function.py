@@ -1,5 +1,6 @@
 import json
 import os
+import uuid
 import psycopg2
 from datetime import datetime
 
@@ -38,38 +39,60 @@ def lambda_handler(event, context):
         )
         cur = conn.cursor()
         
-        # S3 키에서 video_id 추출 (uploads/user123/uuid/video.mp4 -> uuid)
-        video_id = extract_video_id(key)
-        
-        # analysis_results 테이블에 저장
+        # S3 키에서 upload_id 추출 (uploads/user123/uuid/video.mp4 -> uuid)
+        upload_id = extract_upload_id(key)
+
+        # 업로드 기록 (GetUploadURL에서 이미 만들어졌으면 그대로 사용)
+        cur.execute("""
+            INSERT INTO uploads (upload_id, s3_bucket, s3_key)
+            VALUES (%s, %s, %s)
+            ON CONFLICT DO NOTHING
+        """, (upload_id, bucket, key))
+        cur.execute("SELECT upload_id FROM uploads WHERE s3_key = %s", (key,))
+        upload_id = cur.fetchone()[0]
+
+        # 업로드의 분석 Job (없으면 생성)
+        cur.execute("""
+            SELECT job_id FROM analysis_jobs
+            WHERE upload_id = %s
+            ORDER BY created_at DESC LIMIT 1
+        """, (upload_id,))
+        row = cur.fetchone()
+        if row:
+            job_id = row[0]
+        else:
+            job_id = str(uuid.uuid4())
+            cur.execute("""
+                INSERT INTO analysis_jobs (job_id, upload_id, user_id, status, started_at)
+                SELECT %s, upload_id, user_id, 'processing', NOW()
+                FROM uploads WHERE upload_id = %s
+            """, (job_id, upload_id))
+
+        # analysis_results 테이블에 Job 단위로 저장 (safety_score = 100 - 위험도)
         cur.execute("""
-            INSERT INTO analysis_results 
-            (video_id, s3_bucket, s3_key, audio_score, video_score, context_score, 
-             final_score, status, created_at)
-            VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s)
-            ON CONFLICT (video_id) 
-            DO UPDATE SET
-                audio_score = EXCLUDED.audio_score,
-                video_score = EXCLUDED.video_score,
-                context_score = EXCLUDED.context_score,
-                final_score = EXCLUDED.final_score,
-                status = EXCLUDED.status,
-                updated_at = NOW()
-            RETURNING id
+            INSERT INTO analysis_results
+            (job_id, upload_id, source, safety_score, audio_score, video_score, context_score,
+             created_at, updated_at)
+            VALUES (%s, %s, 'pipeline', %s, %s, %s, %s, %s, NOW())
+            RETURNING result_id
         """, (
-            video_id,
-            bucket,
-            key,
+            job_id,
+            upload_id,
+            100 - final_score,
             audio_score,
             video_score,
             context_score,
-            final_score,
-            'completed',
             timestamp or datetime.utcnow().isoformat()
         ))
-        
+
         result_id = cur.fetchone()[0]
-        
+
+        cur.execute("""
+            UPDATE analysis_jobs
+            SET status = 'completed', progress = 100, completed_at = NOW()
+            WHERE job_id = %s
+        """, (job_id,))
+
         conn.commit()
         cur.close()
         conn.close()
@@ -77,7 +100,8 @@ def lambda_handler(event, context):
         return {
             'statusCode': 200,
             'result_id': result_id,
-            'video_id': video_id,
+            'job_id': str(job_id),
+            'upload_id': str(upload_id),
             'final_score': final_score,
             'status': 'success'
         }
@@ -91,12 +115,16 @@ def lambda_handler(event, context):
         }
 
 
-def extract_video_id(s3_key):
+def extract_upload_id(s3_key):
     """
-    S3 키에서 video_id 추출
+    S3 키에서 upload_id 추출
     uploads/user123/uuid/video.mp4 -> uuid
+    UUID 형식이 아니면 키에서 결정적으로 만든 UUID를 사용
     """
     parts = s3_key.split('/')
     if len(parts) >= 3:
-        return parts[2]  # uuid
-    return s3_key.replace('/', '-')
+        try:
+            return str(uuid.UUID(parts[2]))
+        except ValueError:
+            pass
+    return str(uuid.uuid5(uuid.NAMESPACE_URL, s3_key))
@@ -41,4 +41,8 @@ output "eventbridge_rule_name" {
 output "cloudwatch_log_group" {
   description = "CloudWatch Log Group for S3 Events"
   value       = aws_cloudwatch_log_group.s3_events.name
-}
\ No newline at end of file
+}
+output "thumbnails_cdn_url" {
+  description = "Base URL for mirrored thumbnails (youtube.thumbnail_base_url)"
+  value       = "https://${aws_cloudfront_distribution.thumbnails.domain_name}"
+}
@@ -57,3 +57,65 @@ resource "aws_s3_bucket_notification" "uploads_notification" {
   bucket      = aws_s3_bucket.uploads.id
   eventbridge = true
 }
+
+# 미러링한 썸네일 제공 (버킷은 비공개, CloudFront만 thumbnails/ 읽기 허용)
+resource "aws_cloudfront_origin_access_control" "thumbnails" {
+  name                              = "silver-guardian-thumbnails"
+  origin_access_control_origin_type = "s3"
+  signing_behavior                  = "always"
+  signing_protocol                  = "sigv4"
+}
+
+resource "aws_cloudfront_distribution" "thumbnails" {
+  enabled = true
+  comment = "Silver Guardian mirrored thumbnails"
+
+  origin {
+    domain_name              = aws_s3_bucket.uploads.bucket_regional_domain_name
+    origin_id                = "uploads"
+    origin_access_control_id = aws_cloudfront_origin_access_control.thumbnails.id
+  }
+
+  # thumbnails/ 외의 경로(업로드 영상 등)는 노출하지 않음
+  default_cache_behavior {
+    target_origin_id       = "uploads"
+    viewer_protocol_policy = "redirect-to-https"
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    cache_policy_id        = "658327ea-f89d-4fab-a63d-7e88639e58f6" # Managed-CachingOptimized
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+
+  tags = {
+    Name        = "Silver Guardian Thumbnails"
+    Environment = "production"
+    Project     = "silver-guardian"
+  }
+}
+
+resource "aws_s3_bucket_policy" "uploads_thumbnails" {
+  bucket = aws_s3_bucket.uploads.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "CloudFrontReadThumbnails"
+      Effect    = "Allow"
+      Principal = { Service = "cloudfront.amazonaws.com" }
+      Action    = "s3:GetObject"
+      Resource  = "${aws_s3_bucket.uploads.arn}/thumbnails/*"
+      Condition = {
+        StringEquals = { "AWS:SourceArn" = aws_cloudfront_distribution.thumbnails.arn }
+      }
+    }]
+  })
+}